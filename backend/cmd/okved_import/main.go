@@ -0,0 +1,126 @@
+// Команда okved_import загружает классификатор ОКВЭД из CSV-файла в ppo.activity_fields.
+// Запускается из каталога backend, чтобы найти config.yml.local:
+//
+//	go run ./cmd/okved_import -file okved.csv
+//
+// Повторный импорт обновляет названия и иерархию уже загруженных позиций, не трогая их веса.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"ppo/domain"
+	"ppo/internal/config"
+	"ppo/internal/services/activity_field"
+	"ppo/internal/storage/postgres"
+	loggerPackage "ppo/pkg/logger"
+	"ppo/pkg/okved"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/shopspring/decimal"
+)
+
+func newConn(ctx context.Context, cfg *config.Database) (pool *pgxpool.Pool, err error) {
+	connStr := fmt.Sprintf("%s://%s:%s@%s:%s/%s", cfg.Driver, cfg.User, cfg.Password,
+		cfg.Host, cfg.Port, cfg.Name)
+
+	pool, err = pgxpool.New(ctx, connStr)
+	if err != nil {
+		return nil, fmt.Errorf("подключение к БД: %w", err)
+	}
+
+	err = pool.Ping(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("пинг БД: %w", err)
+	}
+
+	return pool, nil
+}
+
+// importEntries сохраняет позиции классификатора по порядку. Новые позиции наследуют вес родителя,
+// новые разделы получают вес rootCost.
+func importEntries(ctx context.Context, svc domain.IActivityFieldService, entries []*okved.Entry, rootCost decimal.Decimal) (err error) {
+	saved := make(map[string]*domain.ActivityField, len(entries))
+	for _, entry := range entries {
+		field := &domain.ActivityField{
+			Code:        entry.Code,
+			Name:        entry.Name,
+			Description: entry.Description,
+			Cost:        rootCost,
+		}
+
+		if entry.ParentCode != "" {
+			parent, ok := saved[entry.ParentCode]
+			if !ok {
+				return fmt.Errorf("импорт позиции %s: родительская позиция %s не импортирована", entry.Code, entry.ParentCode)
+			}
+			field.ParentID = parent.ID
+			field.Cost = parent.Cost
+		}
+
+		err = svc.UpsertByCode(ctx, field)
+		if err != nil {
+			return fmt.Errorf("импорт позиции %s: %w", entry.Code, err)
+		}
+
+		saved[entry.Code] = field
+	}
+
+	return nil
+}
+
+func main() {
+	filePath := flag.String("file", "", "путь к CSV-файлу классификатора (код;наименование[;описание])")
+	cost := flag.String("cost", "1", "вес новых разделов классификатора")
+	flag.Parse()
+
+	if *filePath == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	rootCost, err := decimal.NewFromString(*cost)
+	if err != nil {
+		log.Fatalln("разбор веса разделов:", err)
+	}
+
+	cfg, err := config.ReadConfig()
+	if err != nil {
+		log.Fatalln(err)
+	}
+
+	f, err := os.Open(*filePath)
+	if err != nil {
+		log.Fatalln("открытие файла классификатора:", err)
+	}
+	defer f.Close()
+
+	entries, err := okved.Parse(f)
+	if err != nil {
+		log.Fatalln("разбор файла классификатора:", err)
+	}
+
+	ctx := context.Background()
+	pool, err := newConn(ctx, &cfg.Database)
+	if err != nil {
+		log.Fatalln(err)
+	}
+	defer pool.Close()
+
+	logger := loggerPackage.NewLogger(cfg.Logger.Level, os.Stderr)
+	svc := activity_field.NewService(
+		postgres.NewActivityFieldRepository(pool),
+		postgres.NewCompanyRepository(pool),
+		logger,
+	)
+
+	err = importEntries(ctx, svc, entries, rootCost)
+	if err != nil {
+		log.Fatalln(err)
+	}
+
+	fmt.Printf("импортировано позиций: %d\n", len(entries))
+}
@@ -4,30 +4,44 @@ import (
 	"context"
 
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 )
 
+// ActivityField - узел классификатора сфер деятельности (ОКВЭД): раздел, класс, подкласс, группа и т.д.
+// ParentID пуст у корневых узлов, Code пуст у сфер, добавленных не из классификатора.
 type ActivityField struct {
 	ID          uuid.UUID
+	ParentID    uuid.UUID
+	Code        string
 	Name        string
 	Description string
-	Cost        float32
+	Cost        decimal.Decimal
+}
+
+type ActivityFieldNode struct {
+	*ActivityField
+	Children []*ActivityFieldNode
 }
 
 type IActivityFieldRepository interface {
 	Create(context.Context, *ActivityField) error
+	UpsertByCode(context.Context, *ActivityField) error
 	DeleteById(context.Context, uuid.UUID) error
 	Update(context.Context, *ActivityField) error
 	GetById(context.Context, uuid.UUID) (*ActivityField, error)
-	GetMaxCost(context.Context) (float32, error)
+	GetSubtreeIds(context.Context, uuid.UUID) ([]uuid.UUID, error)
+	GetMaxCost(context.Context) (decimal.Decimal, error)
 	GetAll(context.Context, int, bool) ([]*ActivityField, int, error)
 }
 
 type IActivityFieldService interface {
 	Create(context.Context, *ActivityField) error
+	UpsertByCode(context.Context, *ActivityField) error
 	DeleteById(context.Context, uuid.UUID) error
 	Update(context.Context, *ActivityField) error
 	GetById(context.Context, uuid.UUID) (*ActivityField, error)
-	GetCostByCompanyId(context.Context, uuid.UUID) (float32, error)
-	GetMaxCost(context.Context) (float32, error)
+	GetTree(context.Context, uuid.UUID) ([]*ActivityFieldNode, error)
+	GetCostByCompanyId(context.Context, uuid.UUID) (decimal.Decimal, error)
+	GetMaxCost(context.Context) (decimal.Decimal, error)
 	GetAll(context.Context, int, bool) ([]*ActivityField, int, error)
 }
@@ -0,0 +1,51 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var ErrApiKeyScope = errors.New("API-ключ не дает доступа к этому ресурсу")
+
+// Области действия API-ключей: каждая открывает ключу одну группу маршрутов. Права владельца
+// ключа (его роль) при этом продолжают проверяться как обычно.
+const (
+	ScopeFinancials = "financials"
+	ScopeCompanies  = "companies"
+	ScopeContacts   = "contacts"
+	ScopeReports    = "reports"
+)
+
+var ApiKeyScopes = []string{ScopeFinancials, ScopeCompanies, ScopeContacts, ScopeReports}
+
+// ApiKey - персональный ключ для машинного доступа; в БД хранится только его хэш.
+// Prefix - начало ключа, по которому владелец отличает ключи в списке.
+type ApiKey struct {
+	ID         uuid.UUID
+	UserID     uuid.UUID
+	Name       string
+	Prefix     string
+	Hash       string
+	Scopes     []string
+	ExpiresAt  time.Time // нулевое значение - бессрочный ключ
+	CreatedAt  time.Time
+	LastUsedAt time.Time
+}
+
+type IApiKeyRepository interface {
+	Create(context.Context, *ApiKey) error
+	GetByHash(context.Context, string) (*ApiKey, error)
+	GetByUserId(context.Context, uuid.UUID) ([]*ApiKey, error)
+	Revoke(context.Context, uuid.UUID, uuid.UUID) error
+	Touch(context.Context, uuid.UUID) error
+}
+
+type IApiKeyService interface {
+	Create(context.Context, *ApiKey) (string, error)
+	GetByUserId(context.Context, uuid.UUID) ([]*ApiKey, error)
+	Revoke(context.Context, uuid.UUID, uuid.UUID) error
+	Authenticate(context.Context, string) (*ApiKey, *UserAuth, error)
+}
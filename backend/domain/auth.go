@@ -14,11 +14,23 @@ type UserAuth struct {
 }
 
 type IAuthRepository interface {
-	Register(context.Context, *UserAuth) error
+	Register(context.Context, *UserAuth, *User) error
 	GetByUsername(context.Context, string) (*UserAuth, error)
+	GetById(context.Context, uuid.UUID) (*UserAuth, error)
+	UpdatePassword(context.Context, uuid.UUID, string) error
 }
 
 type IAuthService interface {
-	Login(context.Context, *UserAuth) (string, error)
-	Register(context.Context, *UserAuth) error
+	Login(context.Context, *UserAuth, string) (*LoginResult, error)
+	VerifyMfa(context.Context, string, string) (*TokenPair, error)
+	Register(context.Context, *UserAuth, *User) error
+	Refresh(context.Context, string) (*TokenPair, error)
+	Logout(context.Context, uuid.UUID) error
+	IsSessionActive(context.Context, uuid.UUID) (bool, error)
+	ChangePassword(context.Context, uuid.UUID, string, string) error
+	RequestPasswordReset(context.Context, string) error
+	ResetPassword(context.Context, string, string) error
+	EnrollMfa(context.Context, uuid.UUID) (*MfaEnrollment, error)
+	ConfirmMfa(context.Context, uuid.UUID, string) ([]string, error)
+	DisableMfa(context.Context, uuid.UUID, string) error
 }
@@ -2,21 +2,42 @@ package domain
 
 import (
 	"context"
+	"errors"
 	"github.com/google/uuid"
 )
 
+var ErrCompanyNotFound = errors.New("компания не найдена")
+
 type Company struct {
-	ID              uuid.UUID
-	OwnerID         uuid.UUID
-	ActivityFieldId uuid.UUID
-	Name            string
-	City            string
+	ID      uuid.UUID
+	OwnerID uuid.UUID
+	// ActivityFieldId - основная сфера деятельности: по ней считается рейтинг.
+	// SecondaryActivityFieldIds - дополнительные сферы, nil при обновлении означает "не менять"
+	ActivityFieldId           uuid.UUID
+	SecondaryActivityFieldIds []uuid.UUID
+	Name                      string
+	City                      string
+	// INN, OGRN (у индивидуального предпринимателя - ОГРНИП) и KPP - реквизиты из ЕГРЮЛ/ЕГРИП;
+	// KPP есть только у юридических лиц
+	INN  string
+	OGRN string
+	KPP  string
+}
+
+// ActivityFieldIds возвращает все сферы деятельности компании, основная - первая.
+func (c *Company) ActivityFieldIds() []uuid.UUID {
+	ids := make([]uuid.UUID, 0, len(c.SecondaryActivityFieldIds)+1)
+	ids = append(ids, c.ActivityFieldId)
+
+	return append(ids, c.SecondaryActivityFieldIds...)
 }
 
 type ICompanyRepository interface {
 	Create(context.Context, *Company) error
 	GetById(context.Context, uuid.UUID) (*Company, error)
+	GetByINN(context.Context, string) (*Company, error)
 	GetByOwnerId(context.Context, uuid.UUID, int, bool) ([]*Company, int, error)
+	GetByActivityFieldIds(context.Context, []uuid.UUID, int) ([]*Company, int, error)
 	GetAll(context.Context, int) ([]*Company, error)
 	Update(context.Context, *Company) error
 	DeleteById(context.Context, uuid.UUID) error
@@ -25,8 +46,15 @@ type ICompanyRepository interface {
 type ICompanyService interface {
 	Create(context.Context, *Company) error
 	GetById(context.Context, uuid.UUID) (*Company, error)
+	GetByINN(context.Context, string) (*Company, error)
 	GetByOwnerId(context.Context, uuid.UUID, int, bool) ([]*Company, int, error)
+	GetByActivityFieldTree(context.Context, uuid.UUID, int) ([]*Company, int, error)
 	GetAll(context.Context, int) ([]*Company, error)
 	Update(context.Context, *Company, uuid.UUID) error
 	DeleteById(context.Context, uuid.UUID, uuid.UUID) error
+	GetOwners(context.Context, uuid.UUID) ([]*CompanyOwner, error)
+	GetOwnerships(context.Context, uuid.UUID) ([]*CompanyOwner, error)
+	InviteOwner(context.Context, *CompanyOwner) error
+	AcceptOwnership(context.Context, uuid.UUID, uuid.UUID) error
+	SetOwnerShare(context.Context, *CompanyOwner, uuid.UUID) error
 }
@@ -0,0 +1,44 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+var (
+	ErrSharesExceed = errors.New("сумма долей владельцев компании превышает 100%")
+
+	MaxOwnerShare = decimal.NewFromInt(100)
+)
+
+// CompanyOwner - доля пользователя в компании в процентах. Доля приглашенного совладельца
+// резервируется сразу, но в отчетах и рейтинге учитывается только после принятия приглашения.
+// Управляет компанией по-прежнему ее основной владелец (Company.OwnerID).
+type CompanyOwner struct {
+	CompanyID  uuid.UUID
+	UserID     uuid.UUID
+	Share      decimal.Decimal
+	InvitedBy  uuid.UUID
+	AcceptedAt time.Time
+}
+
+func (o *CompanyOwner) Accepted() bool {
+	return !o.AcceptedAt.IsZero()
+}
+
+// Weight - доля в виде множителя для показателей компании.
+func (o *CompanyOwner) Weight() decimal.Decimal {
+	return o.Share.Div(MaxOwnerShare)
+}
+
+type ICompanyOwnerRepository interface {
+	GetByCompanyId(context.Context, uuid.UUID) ([]*CompanyOwner, error)
+	GetAcceptedByUserId(context.Context, uuid.UUID) ([]*CompanyOwner, error)
+	Invite(context.Context, *CompanyOwner) error
+	Accept(context.Context, uuid.UUID, uuid.UUID) error
+	SetShare(context.Context, uuid.UUID, uuid.UUID, decimal.Decimal) error
+}
@@ -0,0 +1,46 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	TransferPending  = "pending"
+	TransferAccepted = "accepted"
+	TransferDeclined = "declined"
+	TransferExpired  = "expired"
+)
+
+// CompanyTransfer - передача компании другому предпринимателю. Передача завершается, когда
+// получатель ее принимает; непринятая в срок передача истекает. Принятые передачи
+// образуют историю владельцев компании.
+type CompanyTransfer struct {
+	ID         uuid.UUID
+	CompanyID  uuid.UUID
+	FromUserID uuid.UUID
+	ToUserID   uuid.UUID
+	Status     string
+	CreatedAt  time.Time
+	ExpiresAt  time.Time
+	ResolvedAt time.Time
+}
+
+type ICompanyTransferRepository interface {
+	Create(context.Context, *CompanyTransfer) error
+	GetById(context.Context, uuid.UUID) (*CompanyTransfer, error)
+	GetByCompanyId(context.Context, uuid.UUID) ([]*CompanyTransfer, error)
+	GetPendingByUserId(context.Context, uuid.UUID) ([]*CompanyTransfer, error)
+	Accept(context.Context, uuid.UUID, uuid.UUID) error
+	Decline(context.Context, uuid.UUID, uuid.UUID) error
+}
+
+type ICompanyTransferService interface {
+	Initiate(context.Context, *CompanyTransfer) error
+	GetHistory(context.Context, uuid.UUID) ([]*CompanyTransfer, error)
+	GetIncoming(context.Context, uuid.UUID) ([]*CompanyTransfer, error)
+	Accept(context.Context, uuid.UUID, uuid.UUID) error
+	Decline(context.Context, uuid.UUID, uuid.UUID) error
+}
@@ -2,23 +2,36 @@ package domain
 
 import (
 	"context"
+	"fmt"
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"time"
 )
 
 type FinancialReport struct {
 	ID        uuid.UUID
 	CompanyID uuid.UUID
-	Revenue   float32
-	Costs     float32
+	Revenue   decimal.Decimal
+	Costs     decimal.Decimal
 	Year      int
 	Quarter   int
+	// VerifiedBy и VerifiedAt заполнены, если отчет проверен бухгалтером; изменение отчета снимает отметку
+	VerifiedBy uuid.UUID
+	VerifiedAt time.Time
+}
+
+// FinancialReportFilter - параметры выборки отчетов всех компаний; пустые поля не учитываются.
+type FinancialReportFilter struct {
+	CompanyID      uuid.UUID
+	Period         *Period
+	OnlyUnverified bool
 }
 
 type FinancialReportByPeriod struct {
 	Reports []FinancialReport
 	Period  *Period
-	Taxes   float32
-	TaxLoad float32
+	Taxes   decimal.Decimal
+	TaxLoad decimal.Decimal
 }
 
 type Period struct {
@@ -28,25 +41,89 @@ type Period struct {
 	EndQuarter   int
 }
 
-func (r *FinancialReportByPeriod) Revenue() (sum float32) {
+// Способы выбора периода, если он не указан явно: предыдущий календарный год
+// или четыре последних завершенных квартала.
+const (
+	PeriodPrevYear     = "prev-year"
+	PeriodTrailingYear = "trailing-year"
+)
+
+func (p *Period) Validate() (err error) {
+	if p.StartQuarter < 1 || p.StartQuarter > 4 || p.EndQuarter < 1 || p.EndQuarter > 4 {
+		return fmt.Errorf("номер квартала должен быть от 1 до 4")
+	}
+
+	if p.StartYear > p.EndYear || (p.StartYear == p.EndYear && p.StartQuarter > p.EndQuarter) {
+		return fmt.Errorf("дата конца периода должна быть позже даты начала")
+	}
+
+	return nil
+}
+
+// Previous - период такой же длины, непосредственно предшествующий p.
+func (p *Period) Previous() *Period {
+	// кварталы нумеруются подряд с нулевого года
+	start := p.StartYear*4 + p.StartQuarter - 1
+	end := p.EndYear*4 + p.EndQuarter - 1
+	prevEnd := start - 1
+	prevStart := prevEnd - (end - start)
+
+	return &Period{
+		StartYear:    prevStart / 4,
+		StartQuarter: prevStart%4 + 1,
+		EndYear:      prevEnd / 4,
+		EndQuarter:   prevEnd%4 + 1,
+	}
+}
+
+// LastCompletedQuarter возвращает год и номер последнего квартала, завершенного к моменту now.
+func LastCompletedQuarter(now time.Time) (year, quarter int) {
+	year = now.Year()
+	quarter = (int(now.Month()) - 1) / 3
+	if quarter < 1 {
+		year--
+		quarter = 4
+	}
+
+	return year, quarter
+}
+
+// TrailingYear - период из четырех кварталов, последний из которых - квартал quarter года year.
+func TrailingYear(year, quarter int) *Period {
+	startYear := year - 1
+	startQuarter := quarter + 1
+	if startQuarter > 4 {
+		startYear++
+		startQuarter = 1
+	}
+
+	return &Period{
+		StartYear:    startYear,
+		EndYear:      year,
+		StartQuarter: startQuarter,
+		EndQuarter:   quarter,
+	}
+}
+
+func (r *FinancialReportByPeriod) Revenue() (sum decimal.Decimal) {
 	for _, rep := range r.Reports {
-		sum += rep.Revenue
+		sum = sum.Add(rep.Revenue)
 	}
 
 	return sum
 }
 
-func (r *FinancialReportByPeriod) Costs() (sum float32) {
+func (r *FinancialReportByPeriod) Costs() (sum decimal.Decimal) {
 	for _, rep := range r.Reports {
-		sum += rep.Costs
+		sum = sum.Add(rep.Costs)
 	}
 
 	return sum
 }
 
-func (r *FinancialReportByPeriod) Profit() (sum float32) {
+func (r *FinancialReportByPeriod) Profit() (sum decimal.Decimal) {
 	for _, rep := range r.Reports {
-		sum += rep.Revenue - rep.Costs
+		sum = sum.Add(rep.Revenue.Sub(rep.Costs))
 	}
 
 	return sum
@@ -56,7 +133,9 @@ type IFinancialReportRepository interface {
 	Create(context.Context, *FinancialReport) error
 	GetById(context.Context, uuid.UUID) (*FinancialReport, error)
 	GetByCompany(context.Context, uuid.UUID, *Period) (*FinancialReportByPeriod, error)
+	GetAll(context.Context, *FinancialReportFilter, int) ([]*FinancialReport, int, error)
 	Update(context.Context, *FinancialReport) error
+	Verify(context.Context, uuid.UUID, uuid.UUID) error
 	DeleteById(context.Context, uuid.UUID) error
 }
 
@@ -65,6 +144,8 @@ type IFinancialReportService interface {
 	CreateByPeriod(context.Context, *FinancialReportByPeriod) error
 	GetById(context.Context, uuid.UUID) (*FinancialReport, error)
 	GetByCompany(context.Context, uuid.UUID, *Period) (*FinancialReportByPeriod, error)
+	GetAll(context.Context, *FinancialReportFilter, int) ([]*FinancialReport, int, error)
 	Update(context.Context, *FinancialReport, uuid.UUID) error
+	Verify(context.Context, uuid.UUID, uuid.UUID) error
 	DeleteById(context.Context, uuid.UUID, uuid.UUID) error
 }
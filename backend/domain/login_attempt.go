@@ -0,0 +1,26 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+var (
+	ErrInvalidCredentials = errors.New("неверное имя пользователя или пароль")
+	ErrLoginLocked        = errors.New("слишком много неудачных попыток входа, повторите позже")
+)
+
+// LoginAttempts - счётчик неудачных попыток входа по ключу (учётной записи или IP-адресу).
+type LoginAttempts struct {
+	Key         string
+	Failures    int
+	LockedUntil time.Time
+}
+
+type ILoginAttemptRepository interface {
+	Get(context.Context, string) (*LoginAttempts, error)
+	RegisterFailure(context.Context, string, time.Time) (int, error)
+	Lock(context.Context, string, time.Time) error
+	Reset(context.Context, string) error
+}
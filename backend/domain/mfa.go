@@ -0,0 +1,40 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var ErrInvalidMfaCode = errors.New("неверный код подтверждения")
+
+// UserMfa - TOTP-секрет пользователя. Пока ConfirmedAt пуст, подключение не завершено
+// и при входе второй фактор не запрашивается.
+type UserMfa struct {
+	UserID      uuid.UUID
+	Secret      string
+	ConfirmedAt time.Time
+}
+
+type MfaEnrollment struct {
+	Secret string
+	URI    string
+}
+
+// LoginResult - итог проверки пароля: либо токены, либо, если включена двухфакторная
+// аутентификация, токен ожидания второго фактора.
+type LoginResult struct {
+	Tokens   *TokenPair
+	MfaToken string
+}
+
+type IMfaRepository interface {
+	GetByUserId(context.Context, uuid.UUID) (*UserMfa, error)
+	Save(context.Context, *UserMfa) error
+	Confirm(context.Context, uuid.UUID, []string) error
+	UseStep(context.Context, uuid.UUID, int64) (bool, error)
+	UseRecoveryCode(context.Context, uuid.UUID, string) (bool, error)
+	Delete(context.Context, uuid.UUID) error
+}
@@ -0,0 +1,14 @@
+package domain
+
+import "context"
+
+// Notification - сообщение пользователю; Recipient - имя пользователя, которому оно адресовано.
+type Notification struct {
+	Recipient string
+	Subject   string
+	Body      string
+}
+
+type INotifier interface {
+	Notify(context.Context, *Notification) error
+}
@@ -0,0 +1,21 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// PasswordResetToken - одноразовый токен сброса пароля; в БД хранится только его хэш.
+type PasswordResetToken struct {
+	ID        uuid.UUID
+	UserID    uuid.UUID
+	Hash      string
+	ExpiresAt time.Time
+}
+
+type IPasswordResetRepository interface {
+	Create(context.Context, *PasswordResetToken) error
+	Use(context.Context, string) (*PasswordResetToken, error)
+}
@@ -0,0 +1,101 @@
+package domain
+
+import (
+	"errors"
+
+	"github.com/shopspring/decimal"
+)
+
+// UserRatingData - показатели предпринимателя за период, по которым вычисляется рейтинг.
+// Cost - вес сферы деятельности наиболее прибыльной компании, не задан, если прибыльных компаний нет.
+type UserRatingData struct {
+	User    *User
+	Revenue decimal.Decimal
+	Profit  decimal.Decimal
+	Cost    decimal.NullDecimal
+	Reviews ReviewStats
+}
+
+// Стратегии вычисления рейтинга; стратегия по умолчанию задается в конфиге.
+const (
+	RatingStrategyCurrent         = "current"
+	RatingStrategyRevenueWeighted = "revenue-weighted"
+	RatingStrategyGrowth          = "growth"
+	RatingStrategyReviewWeighted  = "review-weighted"
+)
+
+var ErrUnknownRatingStrategy = errors.New("неизвестная стратегия вычисления рейтинга")
+
+// RatingInput - данные, по которым стратегия вычисляет рейтинг предпринимателя за период. PrevRevenue -
+// выручка за такой же по длине предшествующий период, MaxRevenue - наибольшая выручка предпринимателя
+// за период, MaxCost - наибольший вес сферы деятельности. PrevRevenue, MaxRevenue и Reviews загружаются,
+// только если они нужны стратегии (см. RatingRequirements), иначе остаются нулевыми.
+type RatingInput struct {
+	Revenue     decimal.Decimal
+	Profit      decimal.Decimal
+	PrevRevenue decimal.Decimal
+	Cost        decimal.Decimal
+	MaxCost     decimal.Decimal
+	MaxRevenue  decimal.Decimal
+	Reviews     ReviewStats
+}
+
+// RatingRequirements - данные, которые нужны стратегии помимо общих для всех стратегий выручки,
+// прибыли и веса сферы деятельности.
+type RatingRequirements struct {
+	PrevRevenue bool
+	MaxRevenue  bool
+	Reviews     bool
+}
+
+// RatingStrategy вычисляет составляющие рейтинга: вклад веса сферы деятельности наиболее прибыльной
+// компании и вклад показателей деятельности предпринимателя; рейтинг - их сумма.
+type RatingStrategy interface {
+	Name() string
+	Requirements() RatingRequirements
+	Components(*RatingInput) (decimal.Decimal, decimal.Decimal)
+}
+
+// RatingBreakdown - рейтинг предпринимателя, вычисленный стратегией Strategy, с его составляющими. Рейтинг
+// равен сумме CostContribution - вклада веса сферы деятельности наиболее прибыльной компании (Company,
+// доля в ней - Share), и ProfitabilityContribution - вклада показателей деятельности, которые учитывает
+// стратегия (для стратегии по умолчанию - рентабельности компаний предпринимателя с учетом долей).
+// Если прибыльных компаний нет, Company не задана, а рейтинг равен нулю.
+type RatingBreakdown struct {
+	Strategy                  string
+	Period                    *Period
+	Company                   *Company
+	Share                     decimal.Decimal
+	Cost                      decimal.Decimal
+	MaxCost                   decimal.Decimal
+	Revenue                   decimal.Decimal
+	Profit                    decimal.Decimal
+	CostContribution          float32
+	ProfitabilityContribution float32
+	Rating                    float32
+}
+
+type UserRating struct {
+	User   *User
+	Rating float32
+}
+
+// ActivityFieldInfluence - влияние предпринимателя в сфере деятельности за период. Revenue - выручка его компаний
+// в этой сфере с учетом долей владения, TotalRevenue - выручка всех компаний сферы; Rank - место среди
+// совладельцев компаний сферы по выручке, Participants - их число. Учитываются и дополнительные сферы компаний.
+type ActivityFieldInfluence struct {
+	ActivityField *ActivityField
+	Revenue       decimal.Decimal
+	TotalRevenue  decimal.Decimal
+	Rank          int
+	Participants  int
+}
+
+// Share - доля предпринимателя в выручке сферы деятельности в процентах.
+func (i *ActivityFieldInfluence) Share() decimal.Decimal {
+	if i.TotalRevenue.IsZero() {
+		return decimal.Zero
+	}
+
+	return i.Revenue.Mul(decimal.NewFromInt(100)).Div(i.TotalRevenue).Round(2)
+}
@@ -0,0 +1,66 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// RatingSnapshot - рейтинг предпринимателя и его составляющие, сохраненные по итогам квартала Quarter
+// года Year. Рейтинг вычисляется стратегией Strategy за четыре квартала, последний из которых - Quarter
+// (см. TrailingYear). CompanyID не задан, если прибыльных компаний не было.
+type RatingSnapshot struct {
+	UserID                    uuid.UUID
+	Year                      int
+	Quarter                   int
+	Strategy                  string
+	CompanyID                 uuid.UUID
+	Share                     decimal.Decimal
+	Cost                      decimal.Decimal
+	MaxCost                   decimal.Decimal
+	Revenue                   decimal.Decimal
+	Profit                    decimal.Decimal
+	CostContribution          float32
+	ProfitabilityContribution float32
+	Rating                    float32
+	CreatedAt                 time.Time
+}
+
+func NewRatingSnapshot(userId uuid.UUID, year, quarter int, breakdown *RatingBreakdown) *RatingSnapshot {
+	snapshot := &RatingSnapshot{
+		UserID:                    userId,
+		Year:                      year,
+		Quarter:                   quarter,
+		Strategy:                  breakdown.Strategy,
+		Share:                     breakdown.Share,
+		Cost:                      breakdown.Cost,
+		MaxCost:                   breakdown.MaxCost,
+		Revenue:                   breakdown.Revenue,
+		Profit:                    breakdown.Profit,
+		CostContribution:          breakdown.CostContribution,
+		ProfitabilityContribution: breakdown.ProfitabilityContribution,
+		Rating:                    breakdown.Rating,
+	}
+
+	if breakdown.Company != nil {
+		snapshot.CompanyID = breakdown.Company.ID
+	}
+
+	return snapshot
+}
+
+type IRatingSnapshotRepository interface {
+	Save(context.Context, *RatingSnapshot) error
+	GetByUserId(context.Context, uuid.UUID, *Period, string) ([]*RatingSnapshot, error)
+	GetLastCompletedQuarter(context.Context, string) (int, int, error)
+	CompleteQuarter(context.Context, int, int, string) error
+}
+
+type IRatingSnapshotService interface {
+	Save(context.Context, *RatingSnapshot) error
+	GetByUserId(context.Context, uuid.UUID, *Period, string) ([]*RatingSnapshot, error)
+	GetLastCompletedQuarter(context.Context, string) (int, int, error)
+	CompleteQuarter(context.Context, int, int, string) error
+}
@@ -0,0 +1,63 @@
+package domain
+
+import (
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestActivityFieldInfluence_Share(t *testing.T) {
+	testCases := []struct {
+		name      string
+		influence *ActivityFieldInfluence
+		expected  decimal.Decimal
+	}{
+		{
+			name: "доля в выручке сферы",
+			influence: &ActivityFieldInfluence{
+				Revenue:      decimal.NewFromInt(250),
+				TotalRevenue: decimal.NewFromInt(1000),
+			},
+			expected: decimal.NewFromInt(25),
+		},
+		{
+			name: "вся выручка сферы",
+			influence: &ActivityFieldInfluence{
+				Revenue:      decimal.NewFromInt(1000),
+				TotalRevenue: decimal.NewFromInt(1000),
+			},
+			expected: decimal.NewFromInt(100),
+		},
+		{
+			name: "округление до сотых",
+			influence: &ActivityFieldInfluence{
+				Revenue:      decimal.NewFromInt(1),
+				TotalRevenue: decimal.NewFromInt(3),
+			},
+			expected: decimal.RequireFromString("33.33"),
+		},
+		{
+			name: "округление вверх",
+			influence: &ActivityFieldInfluence{
+				Revenue:      decimal.NewFromInt(2),
+				TotalRevenue: decimal.NewFromInt(3),
+			},
+			expected: decimal.RequireFromString("66.67"),
+		},
+		{
+			name: "нет выручки в сфере",
+			influence: &ActivityFieldInfluence{
+				Revenue:      decimal.Zero,
+				TotalRevenue: decimal.Zero,
+			},
+			expected: decimal.Zero,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			share := tc.influence.Share()
+
+			require.True(t, tc.expected.Equal(share), "ожидалось %s, получено %s", tc.expected, share)
+		})
+	}
+}
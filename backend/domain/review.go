@@ -0,0 +1,45 @@
+package domain
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+)
+
+// ErrReviewNotFound - отзыва нет или он оставлен о другом предпринимателе.
+var ErrReviewNotFound = errors.New("отзыв о предпринимателе не найден")
+
+type Review struct {
+	ID          uuid.UUID
+	TargetID    uuid.UUID
+	ReviewerID  uuid.UUID
+	Pros        string
+	Cons        string
+	Description string
+	Rating      int
+}
+
+type ReviewStats struct {
+	Count         int
+	AverageRating float32
+}
+
+type IReviewRepository interface {
+	Create(context.Context, *Review) error
+	GetById(context.Context, uuid.UUID) (*Review, error)
+	GetByTarget(context.Context, uuid.UUID, int) ([]*Review, int, error)
+	GetStatsByTarget(context.Context, uuid.UUID) (*ReviewStats, error)
+	ExistsByReviewer(context.Context, uuid.UUID, uuid.UUID) (bool, error)
+	Update(context.Context, *Review) error
+	DeleteById(context.Context, uuid.UUID) error
+}
+
+type IReviewService interface {
+	Create(context.Context, *Review) error
+	GetById(context.Context, uuid.UUID) (*Review, error)
+	GetByTarget(context.Context, uuid.UUID, int) ([]*Review, int, error)
+	GetStatsByTarget(context.Context, uuid.UUID) (*ReviewStats, error)
+	Update(context.Context, *Review, uuid.UUID) error
+	DeleteById(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) error
+}
@@ -0,0 +1,45 @@
+package domain
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+const (
+	RoleAdmin      = "admin"
+	RoleUser       = "user"
+	RoleAccountant = "accountant"
+	RoleEditor     = "editor"
+)
+
+// Разрешения, которые проверяются на маршрутах. Набор разрешений каждой роли хранится в БД.
+const (
+	// PermManageBusiness - ведение собственных компаний, отчетов, контактов, навыков и отзывов
+	PermManageBusiness       = "business.manage"
+	PermManageUsers          = "users.manage"
+	PermManageRoles          = "roles.manage"
+	PermManageSkills         = "skills.manage"
+	PermManageActivityFields = "activity_fields.manage"
+	PermManageTaxSchedules   = "tax_schedules.manage"
+	PermViewAllReports       = "reports.view_all"
+	PermVerifyReports        = "reports.verify"
+)
+
+type Role struct {
+	Name        string
+	Description string
+	Permissions []string
+}
+
+type IRoleRepository interface {
+	GetAll(context.Context) ([]*Role, error)
+	GetByName(context.Context, string) (*Role, error)
+	SetUserRole(context.Context, uuid.UUID, string) error
+}
+
+type IRoleService interface {
+	GetAll(context.Context) ([]*Role, error)
+	HasPermission(context.Context, string, string) (bool, error)
+	AssignRole(context.Context, uuid.UUID, string, uuid.UUID) error
+}
@@ -0,0 +1,34 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type TokenPair struct {
+	AccessToken  string
+	RefreshToken string
+}
+
+// RefreshToken - refresh-токен сессии; в БД хранится только его хэш. Все токены сессии образуют
+// семейство: при обновлении выдаётся новый токен, а предъявленный помечается использованным.
+type RefreshToken struct {
+	ID        uuid.UUID
+	SessionID uuid.UUID
+	UserID    uuid.UUID
+	Hash      string
+	ExpiresAt time.Time
+	Used      bool
+}
+
+type ISessionRepository interface {
+	Create(context.Context, uuid.UUID) (uuid.UUID, error)
+	IsActive(context.Context, uuid.UUID) (bool, error)
+	Revoke(context.Context, uuid.UUID) error
+	RevokeByUserId(context.Context, uuid.UUID) error
+	CreateRefreshToken(context.Context, *RefreshToken) error
+	GetRefreshTokenByHash(context.Context, string) (*RefreshToken, error)
+	UseRefreshToken(context.Context, uuid.UUID) (bool, error)
+}
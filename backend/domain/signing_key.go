@@ -0,0 +1,22 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// SigningKey - ключ подписи JWT в хранилище. Ключ без RetiredAt используется для подписи,
+// выведенный из оборота ключ остается пригодным для проверки токенов до ExpiresAt.
+type SigningKey struct {
+	ID         string
+	Algorithm  string
+	PrivateKey []byte
+	CreatedAt  time.Time
+	RetiredAt  time.Time
+	ExpiresAt  time.Time
+}
+
+type ISigningKeyRepository interface {
+	GetValid(context.Context) ([]*SigningKey, error)
+	Rotate(context.Context, *SigningKey, time.Time, time.Time) (bool, error)
+}
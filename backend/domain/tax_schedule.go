@@ -0,0 +1,54 @@
+package domain
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+const DefaultTaxRegime = "general"
+
+// TaxBracket - ступень прогрессивной шкалы: ставка Rate (в процентах) применяется к прибыли
+// меньше UpperBound. Нулевая верхняя граница означает, что ступень не ограничена сверху.
+type TaxBracket struct {
+	UpperBound decimal.Decimal
+	Rate       decimal.Decimal
+}
+
+// TaxSchedule - налоговая шкала режима Regime, действующая начиная с года Year
+// и до года, с которого действует следующая шкала того же режима.
+type TaxSchedule struct {
+	ID       uuid.UUID
+	Year     int
+	Regime   string
+	Brackets []TaxBracket
+}
+
+func (s *TaxSchedule) Rate(profit decimal.Decimal) decimal.Decimal {
+	for _, bracket := range s.Brackets {
+		if bracket.UpperBound.IsZero() || profit.LessThan(bracket.UpperBound) {
+			return bracket.Rate
+		}
+	}
+
+	return decimal.Zero
+}
+
+type ITaxScheduleRepository interface {
+	Create(context.Context, *TaxSchedule) error
+	GetById(context.Context, uuid.UUID) (*TaxSchedule, error)
+	GetByYear(context.Context, int, string) (*TaxSchedule, error)
+	GetAll(context.Context) ([]*TaxSchedule, error)
+	Update(context.Context, *TaxSchedule) error
+	DeleteById(context.Context, uuid.UUID) error
+}
+
+type ITaxScheduleService interface {
+	Create(context.Context, *TaxSchedule) error
+	GetById(context.Context, uuid.UUID) (*TaxSchedule, error)
+	GetByYear(context.Context, int, string) (*TaxSchedule, error)
+	GetAll(context.Context) ([]*TaxSchedule, error)
+	Update(context.Context, *TaxSchedule) error
+	DeleteById(context.Context, uuid.UUID) error
+}
@@ -2,11 +2,17 @@ package domain
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 )
 
+var ErrUserNotFound = errors.New("пользователь не найден")
+
 type User struct {
 	ID       uuid.UUID
 	Username string
@@ -17,11 +23,63 @@ type User struct {
 	Role     string
 }
 
+// ValidateProfile проверяет, что заполнены все данные, без которых карточка предпринимателя
+// не показывается в поиске.
+func (u *User) ValidateProfile() (err error) {
+	if u.Gender != "m" && u.Gender != "w" {
+		return fmt.Errorf("неизвестный пол")
+	}
+
+	if u.City == "" {
+		return fmt.Errorf("должно быть указано название города")
+	}
+
+	if u.Birthday.IsZero() {
+		return fmt.Errorf("должна быть указана дата рождения")
+	}
+
+	if u.FullName == "" {
+		return fmt.Errorf("должны быть указаны ФИО")
+	}
+
+	if len(strings.Split(u.FullName, " ")) != 3 {
+		return fmt.Errorf("некорректное количество слов (должны быть фамилия, имя и отчество)")
+	}
+
+	return nil
+}
+
+const (
+	SortByName    = "name"
+	SortByRating  = "rating"
+	SortByRevenue = "revenue"
+)
+
+// UserFilter - параметры поиска предпринимателей; пустые поля не учитываются.
+// Name ищется полнотекстово по ФИО, ActivityFieldId и SkillId отбирают владельцев компаний
+// указанной сферы деятельности и обладателей навыка соответственно.
+type UserFilter struct {
+	City            string
+	Gender          string
+	MinAge          int
+	MaxAge          int
+	ActivityFieldId uuid.UUID
+	SkillId         uuid.UUID
+	Name            string
+	SortBy          string
+	// Period - период, выручка за который учитывается при сортировке по выручке
+	Period *Period
+}
+
 type IUserRepository interface {
 	Create(context.Context, *User) error
 	GetByUsername(context.Context, string) (*User, error)
 	GetById(context.Context, uuid.UUID) (*User, error)
-	GetAll(context.Context, int) ([]*User, int, error)
+	GetAll(context.Context, *UserFilter, int) ([]*User, int, error)
+	GetRatingData(context.Context, *Period, *UserFilter) ([]*UserRatingData, error)
+	GetEntrepreneurIds(context.Context) ([]uuid.UUID, error)
+	GetMaxRevenue(context.Context, *Period) (decimal.Decimal, error)
+	GetInfluence(context.Context, uuid.UUID, *Period) ([]*ActivityFieldInfluence, error)
 	Update(context.Context, *User) error
 	DeleteById(context.Context, uuid.UUID) error
 }
@@ -30,7 +88,11 @@ type IUserService interface {
 	Create(context.Context, *User) error
 	GetByUsername(context.Context, string) (*User, error)
 	GetById(context.Context, uuid.UUID) (*User, error)
-	GetAll(context.Context, int) ([]*User, int, error)
+	GetAll(context.Context, *UserFilter, int) ([]*User, int, error)
+	GetRatingData(context.Context, *Period, *UserFilter) ([]*UserRatingData, error)
+	GetEntrepreneurIds(context.Context) ([]uuid.UUID, error)
+	GetMaxRevenue(context.Context, *Period) (decimal.Decimal, error)
+	GetInfluence(context.Context, uuid.UUID, *Period) ([]*ActivityFieldInfluence, error)
 	Update(context.Context, *User) error
 	DeleteById(context.Context, uuid.UUID) error
 }
@@ -6,7 +6,11 @@ import (
 )
 
 type IInteractor interface {
+	ResolvePeriod(string) (*Period, error)
+	ResolveStrategy(string) (string, error)
 	GetMostProfitableCompany(context.Context, *Period, []*Company) (*Company, error)
-	CalculateUserRating(context.Context, uuid.UUID) (float32, error)
+	CalculateUserRating(context.Context, uuid.UUID, *Period, string) (*RatingBreakdown, error)
+	GetRanking(context.Context, *UserFilter, *Period, int, string) ([]*UserRating, int, error)
 	GetUserFinancialReport(context.Context, uuid.UUID, *Period) (*FinancialReportByPeriod, error)
+	GetUserInfluence(context.Context, uuid.UUID, *Period) ([]*ActivityFieldInfluence, error)
 }
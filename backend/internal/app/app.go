@@ -4,11 +4,21 @@ import (
 	"ppo/domain"
 	"ppo/internal/config"
 	"ppo/internal/interactors/user_activity_field"
+	"ppo/internal/keyring"
+	"ppo/internal/notifier"
+	"ppo/internal/rating_snapshotter"
 	"ppo/internal/services/activity_field"
+	"ppo/internal/services/api_key"
 	"ppo/internal/services/auth"
 	"ppo/internal/services/company"
+	"ppo/internal/services/company_transfer"
 	"ppo/internal/services/contact"
 	"ppo/internal/services/fin_report"
+	"ppo/internal/services/rating_snapshot"
+	"ppo/internal/services/review"
+	"ppo/internal/services/role"
+	"ppo/internal/services/skill"
+	"ppo/internal/services/tax_schedule"
 	"ppo/internal/services/user"
 	"ppo/internal/storage/postgres"
 	"ppo/pkg/base"
@@ -25,7 +35,16 @@ type App struct {
 	ConSvc      domain.IContactsService
 	ActFieldSvc domain.IActivityFieldService
 	CompSvc     domain.ICompanyService
+	SkillSvc    domain.ISkillService
+	ReviewSvc   domain.IReviewService
+	TaxSvc      domain.ITaxScheduleService
+	RoleSvc     domain.IRoleService
+	ApiKeySvc   domain.IApiKeyService
+	TransferSvc domain.ICompanyTransferService
+	SnapshotSvc domain.IRatingSnapshotService
 	Interactor  domain.IInteractor
+	Snapshotter *rating_snapshotter.Snapshotter
+	Keys        *keyring.Keyring
 	Config      config.Config
 }
 
@@ -36,16 +55,68 @@ func NewApp(db *pgxpool.Pool, cfg *config.Config, log logger.ILogger) *App {
 	conRepo := postgres.NewContactRepository(db)
 	actFieldRepo := postgres.NewActivityFieldRepository(db)
 	compRepo := postgres.NewCompanyRepository(db)
+	skillRepo := postgres.NewSkillRepository(db)
+	reviewRepo := postgres.NewReviewRepository(db)
+	taxRepo := postgres.NewTaxScheduleRepository(db)
+	sessionRepo := postgres.NewSessionRepository(db)
+	resetRepo := postgres.NewPasswordResetRepository(db)
+	attemptRepo := postgres.NewLoginAttemptRepository(db)
+	roleRepo := postgres.NewRoleRepository(db)
+	signingKeyRepo := postgres.NewSigningKeyRepository(db)
+	mfaRepo := postgres.NewMfaRepository(db)
+	apiKeyRepo := postgres.NewApiKeyRepository(db)
+	ownerRepo := postgres.NewCompanyOwnerRepository(db)
+	transferRepo := postgres.NewCompanyTransferRepository(db)
+	snapshotRepo := postgres.NewRatingSnapshotRepository(db)
 
+	clock := base.NewSystemClock()
 	crypto := base.NewHashCrypto()
+	notify := notifier.NewFileNotifier(cfg.Notifier.FilePath)
+	keys := keyring.NewKeyring(
+		signingKeyRepo,
+		cfg.Server.Jwt.Algorithm,
+		cfg.Server.Jwt.RotationPeriod,
+		cfg.Server.AccessTokenTTL,
+		log,
+	)
 
-	authSvc := auth.NewService(authRepo, crypto, cfg.Server.JwtKey, log)
+	authSvc := auth.NewService(
+		authRepo,
+		sessionRepo,
+		resetRepo,
+		attemptRepo,
+		mfaRepo,
+		crypto,
+		notify,
+		keys,
+		cfg.Server.AccessTokenTTL,
+		cfg.Server.RefreshTokenTTL,
+		log,
+	)
 	userSvc := user.NewService(userRepo, compRepo, actFieldRepo, log)
-	finSvc := fin_report.NewService(finRepo, compRepo, log)
+	finSvc := fin_report.NewService(finRepo, compRepo, ownerRepo, log)
 	conSvc := contact.NewService(conRepo, log)
 	actFieldSvc := activity_field.NewService(actFieldRepo, compRepo, log)
-	compSvc := company.NewService(compRepo, actFieldRepo, log)
-	interactor := user_activity_field.NewInteractor(userSvc, actFieldSvc, compSvc, finSvc, log)
+	compSvc := company.NewService(compRepo, actFieldRepo, ownerRepo, log)
+	skillSvc := skill.NewService(skillRepo, userRepo, log)
+	reviewSvc := review.NewService(reviewRepo, userRepo, log)
+	taxSvc := tax_schedule.NewService(taxRepo, log)
+	roleSvc := role.NewService(roleRepo, sessionRepo, log)
+	apiKeySvc := api_key.NewService(apiKeyRepo, authRepo, log)
+	transferSvc := company_transfer.NewService(transferRepo, compRepo, log)
+	snapshotSvc := rating_snapshot.NewService(snapshotRepo, log)
+	interactor := user_activity_field.NewInteractor(
+		userSvc,
+		actFieldSvc,
+		compSvc,
+		finSvc,
+		taxSvc,
+		reviewSvc,
+		clock,
+		cfg.Rating.DefaultStrategy,
+		log,
+	)
+	snapshotter := rating_snapshotter.NewSnapshotter(interactor, userSvc, snapshotSvc, clock, log)
 
 	return &App{
 		Logger:      log,
@@ -55,7 +126,16 @@ func NewApp(db *pgxpool.Pool, cfg *config.Config, log logger.ILogger) *App {
 		ConSvc:      conSvc,
 		ActFieldSvc: actFieldSvc,
 		CompSvc:     compSvc,
+		SkillSvc:    skillSvc,
+		ReviewSvc:   reviewSvc,
+		TaxSvc:      taxSvc,
+		RoleSvc:     roleSvc,
+		ApiKeySvc:   apiKeySvc,
+		TransferSvc: transferSvc,
+		SnapshotSvc: snapshotSvc,
 		Interactor:  interactor,
+		Snapshotter: snapshotter,
+		Keys:        keys,
 		Config:      *cfg,
 	}
 }
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"gopkg.in/yaml.v3"
 	"os"
+	"time"
 )
 
 const (
@@ -11,12 +12,36 @@ const (
 	MaxContacts = 5
 )
 
+// Jwt - параметры подписи токенов. Ключи генерируются и хранятся в БД, ключ подписи
+// заменяется раз в RotationPeriod.
+type Jwt struct {
+	Algorithm      string        `yaml:"algorithm"`
+	RotationPeriod time.Duration `yaml:"rotation_period"`
+}
+
+// Cookie - параметры cookie браузерной сессии. Secure отключается только для локальной
+// разработки по http, SameSite принимает значения strict, lax и none.
+type Cookie struct {
+	Secure   bool   `yaml:"secure"`
+	SameSite string `yaml:"same_site"`
+}
+
+// Cors - origin`ы фронтенда, которым разрешены запросы из браузера; запросы отправляются вместе
+// с cookie сессии, поэтому разрешать любые origin`ы нельзя.
+type Cors struct {
+	AllowedOrigins []string `yaml:"allowed_origins"`
+}
+
 type Server struct {
-	JwtKey      string `yaml:"jwt_key"`
-	ServerHost  string `yaml:"server_host"`
-	ServerPort  string `yaml:"server_port"`
-	MetricsHost string `yaml:"metrics_host"`
-	MetricsPort string `yaml:"metrics_port"`
+	Jwt             Jwt           `yaml:"jwt"`
+	Cookie          Cookie        `yaml:"cookie"`
+	Cors            Cors          `yaml:"cors"`
+	AccessTokenTTL  time.Duration `yaml:"access_token_ttl"`
+	RefreshTokenTTL time.Duration `yaml:"refresh_token_ttl"`
+	ServerHost      string        `yaml:"server_host"`
+	ServerPort      string        `yaml:"server_port"`
+	MetricsHost     string        `yaml:"metrics_host"`
+	MetricsPort     string        `yaml:"metrics_port"`
 }
 
 type Database struct {
@@ -28,14 +53,26 @@ type Database struct {
 	Port     string `yaml:"db_port"`
 }
 
+type Notifier struct {
+	FilePath string `yaml:"file_path"`
+}
+
 type Logger struct {
 	Level string `yaml:"level"`
 }
 
+// Rating - параметры рейтинга предпринимателей. DefaultStrategy - стратегия вычисления рейтинга,
+// если в запросе она не указана: current, revenue-weighted, growth или review-weighted.
+type Rating struct {
+	DefaultStrategy string `yaml:"default_strategy"`
+}
+
 type Config struct {
 	Server   Server   `yaml:"server"`
 	Database Database `yaml:"database"`
 	Logger   Logger   `yaml:"logger"`
+	Notifier Notifier `yaml:"notifier"`
+	Rating   Rating   `yaml:"rating"`
 }
 
 func ReadConfig() (cfg *Config, err error) {
@@ -0,0 +1,127 @@
+package user_activity_field
+
+import (
+	"fmt"
+	"ppo/domain"
+
+	"github.com/shopspring/decimal"
+)
+
+// maxReviewRating - наибольшая оценка в отзыве
+const maxReviewRating = 5
+
+var (
+	two  = decimal.NewFromInt(2)
+	four = decimal.NewFromInt(4)
+)
+
+var ratingStrategies = map[string]domain.RatingStrategy{
+	domain.RatingStrategyCurrent:         currentStrategy{},
+	domain.RatingStrategyRevenueWeighted: revenueWeightedStrategy{},
+	domain.RatingStrategyGrowth:          growthStrategy{},
+	domain.RatingStrategyReviewWeighted:  reviewWeightedStrategy{},
+}
+
+func findRatingStrategy(name string) (strategy domain.RatingStrategy, err error) {
+	strategy, ok := ratingStrategies[name]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", domain.ErrUnknownRatingStrategy, name)
+	}
+
+	return strategy, nil
+}
+
+// costComponent - общий для всех стратегий вклад веса сферы деятельности: половина его отношения к максимальному весу.
+func costComponent(input *domain.RatingInput) decimal.Decimal {
+	if input.MaxCost.IsZero() {
+		return decimal.Zero
+	}
+
+	return input.Cost.Div(input.MaxCost).Div(two)
+}
+
+// profitability - рентабельность компаний предпринимателя, ноль при нулевой выручке.
+func profitability(input *domain.RatingInput) decimal.Decimal {
+	if input.Revenue.IsZero() {
+		return decimal.Zero
+	}
+
+	return input.Profit.Div(input.Revenue)
+}
+
+// currentStrategy - исходная формула: вторая половина рейтинга - половина рентабельности.
+type currentStrategy struct{}
+
+func (currentStrategy) Name() string {
+	return domain.RatingStrategyCurrent
+}
+
+func (currentStrategy) Requirements() domain.RatingRequirements {
+	return domain.RatingRequirements{}
+}
+
+func (currentStrategy) Components(input *domain.RatingInput) (costPart, performancePart decimal.Decimal) {
+	return ratingComponents(input.Profit, input.Revenue, input.Cost, input.MaxCost)
+}
+
+// revenueWeightedStrategy взвешивает рентабельность отношением выручки к наибольшей выручке предпринимателя
+// за период: при одинаковой рентабельности выше оценивается больший оборот.
+type revenueWeightedStrategy struct{}
+
+func (revenueWeightedStrategy) Name() string {
+	return domain.RatingStrategyRevenueWeighted
+}
+
+func (revenueWeightedStrategy) Requirements() domain.RatingRequirements {
+	return domain.RatingRequirements{MaxRevenue: true}
+}
+
+func (revenueWeightedStrategy) Components(input *domain.RatingInput) (costPart, performancePart decimal.Decimal) {
+	if input.MaxRevenue.IsZero() {
+		return costComponent(input), decimal.Zero
+	}
+
+	// рентабельность, умноженная на долю от наибольшей выручки, - это отношение прибыли к наибольшей выручке
+	return costComponent(input), input.Profit.Div(input.MaxRevenue).Div(two)
+}
+
+// growthStrategy оценивает рост выручки относительно такого же предшествующего периода; рост
+// ограничен 100% в обе стороны, без выручки в предшествующем периоде он считается нулевым.
+type growthStrategy struct{}
+
+func (growthStrategy) Name() string {
+	return domain.RatingStrategyGrowth
+}
+
+func (growthStrategy) Requirements() domain.RatingRequirements {
+	return domain.RatingRequirements{PrevRevenue: true}
+}
+
+func (growthStrategy) Components(input *domain.RatingInput) (costPart, performancePart decimal.Decimal) {
+	if !input.PrevRevenue.IsPositive() {
+		return costComponent(input), decimal.Zero
+	}
+
+	growth := input.Revenue.Sub(input.PrevRevenue).Div(input.PrevRevenue)
+	growth = decimal.Min(decimal.Max(growth, decimal.NewFromInt(-1)), decimal.NewFromInt(1))
+
+	return costComponent(input), growth.Div(two)
+}
+
+// reviewWeightedStrategy делит вторую половину рейтинга поровну между рентабельностью и средней оценкой
+// в отзывах, отнесенной к наибольшей; без отзывов их часть нулевая.
+type reviewWeightedStrategy struct{}
+
+func (reviewWeightedStrategy) Name() string {
+	return domain.RatingStrategyReviewWeighted
+}
+
+func (reviewWeightedStrategy) Requirements() domain.RatingRequirements {
+	return domain.RatingRequirements{Reviews: true}
+}
+
+func (reviewWeightedStrategy) Components(input *domain.RatingInput) (costPart, performancePart decimal.Decimal) {
+	reviewsPart := decimal.NewFromFloat32(input.Reviews.AverageRating).Div(decimal.NewFromInt(maxReviewRating))
+
+	return costComponent(input), profitability(input).Add(reviewsPart).Div(four)
+}
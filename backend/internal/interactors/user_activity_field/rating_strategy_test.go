@@ -0,0 +1,95 @@
+package user_activity_field
+
+import (
+	"errors"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+	"ppo/domain"
+	"testing"
+)
+
+func Test_ratingStrategies(t *testing.T) {
+	input := &domain.RatingInput{
+		Revenue:     decimal.NewFromInt(1000),
+		Profit:      decimal.NewFromInt(100),
+		PrevRevenue: decimal.NewFromInt(800),
+		Cost:        decimal.NewFromInt(5),
+		MaxCost:     decimal.NewFromInt(10),
+		MaxRevenue:  decimal.NewFromInt(4000),
+		Reviews:     domain.ReviewStats{Count: 3, AverageRating: 4},
+	}
+
+	testCases := []struct {
+		name                string
+		strategy            string
+		input               *domain.RatingInput
+		expectedPerformance float32
+	}{
+		{
+			name:                "текущая формула",
+			strategy:            domain.RatingStrategyCurrent,
+			input:               input,
+			expectedPerformance: 100.0 / 1000.0 / 2,
+		},
+		{
+			name:                "с учетом выручки",
+			strategy:            domain.RatingStrategyRevenueWeighted,
+			input:               input,
+			expectedPerformance: 100.0 / 4000.0 / 2,
+		},
+		{
+			name:                "с учетом роста выручки",
+			strategy:            domain.RatingStrategyGrowth,
+			input:               input,
+			expectedPerformance: (1000.0 - 800.0) / 800.0 / 2,
+		},
+		{
+			name:     "рост ограничен 100%",
+			strategy: domain.RatingStrategyGrowth,
+			input: &domain.RatingInput{
+				Revenue:     decimal.NewFromInt(1000),
+				PrevRevenue: decimal.NewFromInt(100),
+				Cost:        decimal.NewFromInt(5),
+				MaxCost:     decimal.NewFromInt(10),
+			},
+			expectedPerformance: 0.5,
+		},
+		{
+			name:     "нет выручки в предшествующем периоде",
+			strategy: domain.RatingStrategyGrowth,
+			input: &domain.RatingInput{
+				Revenue: decimal.NewFromInt(1000),
+				Cost:    decimal.NewFromInt(5),
+				MaxCost: decimal.NewFromInt(10),
+			},
+			expectedPerformance: 0,
+		},
+		{
+			name:                "с учетом отзывов",
+			strategy:            domain.RatingStrategyReviewWeighted,
+			input:               input,
+			expectedPerformance: (100.0/1000.0 + 4.0/5.0) / 4,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			strategy, err := findRatingStrategy(tc.strategy)
+			require.Nil(t, err)
+			require.Equal(t, tc.strategy, strategy.Name())
+
+			costPart, performancePart, rating := calcRating(strategy, tc.input)
+
+			// вклад веса сферы деятельности у всех стратегий одинаков
+			require.InDelta(t, float32(5.0/10.0/2), costPart, eps)
+			require.InDelta(t, tc.expectedPerformance, performancePart, eps)
+			require.InDelta(t, costPart+performancePart, rating, eps)
+		})
+	}
+}
+
+func Test_findRatingStrategy(t *testing.T) {
+	_, err := findRatingStrategy("magic")
+
+	require.True(t, errors.Is(err, domain.ErrUnknownRatingStrategy))
+	require.Equal(t, "неизвестная стратегия вычисления рейтинга: magic", err.Error())
+}
@@ -3,26 +3,52 @@ package user_activity_field
 import (
 	"context"
 	"fmt"
-	"math"
 	"ppo/domain"
+	"ppo/internal/config"
+	"ppo/pkg/base"
 	"ppo/pkg/logger"
+	"sort"
+	"sync"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 )
 
 const (
 	quartersInYear = 4
 	firstQuarter   = 1
 	lastQuarter    = 4
+
+	// точность (число знаков после запятой) сумм налогов и налоговой нагрузки
+	moneyPrecision = 2
+
+	// maxRevenueTTL - сколько используется загруженная наибольшая выручка за период: при сохранении
+	// рейтингов всех предпринимателей она загружается один раз, а не для каждого
+	maxRevenueTTL = time.Minute
 )
 
+var hundred = decimal.NewFromInt(100)
+
 type Interactor struct {
 	userService     domain.IUserService
 	actFieldService domain.IActivityFieldService
 	compService     domain.ICompanyService
 	finService      domain.IFinancialReportService
+	taxService      domain.ITaxScheduleService
+	reviewService   domain.IReviewService
+	clock           base.IClock
+	defaultStrategy domain.RatingStrategy
 	logger          logger.ILogger
+
+	maxRevenueMu sync.Mutex
+	maxRevenue   *cachedMaxRevenue
+}
+
+type cachedMaxRevenue struct {
+	period   domain.Period
+	value    decimal.Decimal
+	loadedAt time.Time
 }
 
 func NewInteractor(
@@ -30,52 +56,69 @@ func NewInteractor(
 	actFieldSvc domain.IActivityFieldService,
 	compSvc domain.ICompanyService,
 	finSvc domain.IFinancialReportService,
+	taxSvc domain.ITaxScheduleService,
+	reviewSvc domain.IReviewService,
+	clock base.IClock,
+	defaultStrategy string,
 	logger logger.ILogger,
 ) *Interactor {
+	strategy, err := findRatingStrategy(defaultStrategy)
+	if err != nil {
+		logger.Errorf("NewInteractor: стратегия по умолчанию: %v, используется %s", err, domain.RatingStrategyCurrent)
+		strategy = currentStrategy{}
+	}
+
 	return &Interactor{
 		userService:     userSvc,
 		actFieldService: actFieldSvc,
 		compService:     compSvc,
 		finService:      finSvc,
+		taxService:      taxSvc,
+		reviewService:   reviewSvc,
+		clock:           clock,
+		defaultStrategy: strategy,
 		logger:          logger,
 	}
 }
 
 type taxesData struct {
-	taxes   float32
-	revenue float32
+	taxes   decimal.Decimal
+	revenue decimal.Decimal
 }
 
-func calculateTaxes(reports map[int]*domain.FinancialReportByPeriod) (taxes *taxesData) {
+func calculateTaxes(reports map[int]*domain.FinancialReportByPeriod, schedules map[int]*domain.TaxSchedule) (taxes *taxesData) {
 	taxes = new(taxesData)
 
-	for _, v := range reports {
+	for year, v := range reports {
 		if len(v.Reports) == quartersInYear {
 			totalProfit := v.Profit()
-			var taxFare int
-			switch true {
-			case totalProfit < 10000000:
-				taxFare = 4
-			case totalProfit < 50000000:
-				taxFare = 7
-			case totalProfit < 150000000:
-				taxFare = 13
-			case totalProfit < 500000000:
-				taxFare = 20
-			default:
-				taxFare = 30
-			}
+			taxFare := schedules[year].Rate(totalProfit)
 
-			v.Taxes = totalProfit * (float32(taxFare) / 100)
+			v.Taxes = totalProfit.Mul(taxFare).Div(hundred).Round(moneyPrecision)
 
-			taxes.taxes += v.Taxes
-			taxes.revenue += v.Revenue()
+			taxes.taxes = taxes.taxes.Add(v.Taxes)
+			taxes.revenue = taxes.revenue.Add(v.Revenue())
 		}
 	}
 
 	return taxes
 }
 
+func (i *Interactor) loadTaxSchedules(ctx context.Context, reports map[int]*domain.FinancialReportByPeriod, schedules map[int]*domain.TaxSchedule) (err error) {
+	for year := range reports {
+		if _, ok := schedules[year]; ok {
+			continue
+		}
+
+		schedules[year], err = i.taxService.GetByYear(ctx, year, domain.DefaultTaxRegime)
+		if err != nil {
+			return fmt.Errorf("получение налоговой шкалы за %d год: %w", year, err)
+		}
+	}
+
+	return nil
+}
+
 func findFullYearReports(rep *domain.FinancialReportByPeriod, period *domain.Period) (fullYearReports map[int]*domain.FinancialReportByPeriod) {
 	fullYearReports = make(map[int]*domain.FinancialReportByPeriod)
 
@@ -119,12 +162,45 @@ func findFullYearReports(rep *domain.FinancialReportByPeriod, period *domain.Per
 	return fullYearReports
 }
 
-func calcRating(profit, revenue, cost, maxCost float32) float32 {
-	return (cost/maxCost + profit/revenue) / 2.0
+// ratingComponents возвращает вклады в рейтинг веса сферы деятельности и рентабельности; рейтинг - их сумма.
+func ratingComponents(profit, revenue, cost, maxCost decimal.Decimal) (costPart, profitPart decimal.Decimal) {
+	if revenue.IsZero() || maxCost.IsZero() {
+		return decimal.Zero, decimal.Zero
+	}
+
+	return cost.Div(maxCost).Div(two), profit.Div(revenue).Div(two)
+}
+
+// ratingStrategy возвращает стратегию по имени, а для пустого имени - стратегию по умолчанию.
+func (i *Interactor) ratingStrategy(name string) (strategy domain.RatingStrategy, err error) {
+	if name == "" {
+		return i.defaultStrategy, nil
+	}
+
+	return findRatingStrategy(name)
+}
+
+// ResolveStrategy возвращает имя стратегии strategyName, а для пустого имени - стратегии по умолчанию.
+func (i *Interactor) ResolveStrategy(strategyName string) (name string, err error) {
+	strategy, err := i.ratingStrategy(strategyName)
+	if err != nil {
+		return "", err
+	}
+
+	return strategy.Name(), nil
+}
+
+// calcRating вычисляет рейтинг стратегией strategy вместе с его составляющими.
+func calcRating(strategy domain.RatingStrategy, input *domain.RatingInput) (costPart, performancePart, rating float32) {
+	costDec, performanceDec := strategy.Components(input)
+
+	return float32(costDec.InexactFloat64()),
+		float32(performanceDec.InexactFloat64()),
+		float32(costDec.Add(performanceDec).InexactFloat64())
 }
 
 func (i *Interactor) GetMostProfitableCompany(ctx context.Context, period *domain.Period, companies []*domain.Company) (company *domain.Company, err error) {
-	var maxProfit float32
+	var maxProfit decimal.Decimal
 
 	for _, comp := range companies {
 		rep, err := i.finService.GetByCompany(ctx, comp.ID, period)
@@ -132,7 +208,7 @@ func (i *Interactor) GetMostProfitableCompany(ctx context.Context, period *domai
 			return nil, fmt.Errorf("получение отчета компании: %w", err)
 		}
 
-		if rep.Profit() > maxProfit {
+		if rep.Profit().GreaterThan(maxProfit) {
 			company = comp
 			maxProfit = rep.Profit()
 		}
@@ -141,73 +217,324 @@ func (i *Interactor) GetMostProfitableCompany(ctx context.Context, period *domai
 	return company, nil
 }
 
-func (i *Interactor) CalculateUserRating(ctx context.Context, id uuid.UUID) (rating float32, err error) {
-	prompt := "UserActivityFieldCalculateUserRating"
-
-	companies, _, err := i.compService.GetByOwnerId(ctx, id, 0, false)
-	if err != nil {
-		i.logger.Infof("%s: получение списка компаний: %v", prompt, err)
-		return 0, fmt.Errorf("получение списка компаний: %w", err)
-	}
+// prevYearPeriod - период, за который по умолчанию вычисляется рейтинг: предыдущий календарный год
+func (i *Interactor) prevYearPeriod() *domain.Period {
+	prevYear := i.clock.Now().AddDate(-1, 0, 0).Year()
 
-	prevYear := time.Now().AddDate(-1, 0, 0).Year()
-	period := &domain.Period{
+	return &domain.Period{
 		StartYear:    prevYear,
 		EndYear:      prevYear,
 		StartQuarter: firstQuarter,
 		EndQuarter:   lastQuarter,
 	}
+}
+
+// trailingYearPeriod - четыре последних завершенных квартала
+func (i *Interactor) trailingYearPeriod() *domain.Period {
+	return domain.TrailingYear(domain.LastCompletedQuarter(i.clock.Now()))
+}
+
+// ResolvePeriod возвращает период, выбранный одним из способов domain.PeriodPrevYear (по умолчанию)
+// или domain.PeriodTrailingYear, относительно текущей даты.
+func (i *Interactor) ResolvePeriod(mode string) (period *domain.Period, err error) {
+	switch mode {
+	case "", domain.PeriodPrevYear:
+		return i.prevYearPeriod(), nil
+	case domain.PeriodTrailingYear:
+		return i.trailingYearPeriod(), nil
+	default:
+		return nil, fmt.Errorf("неизвестный способ выбора периода: %s", mode)
+	}
+}
+
+// mostProfitableOwnership находит компанию, приносящую пользователю наибольшую прибыль с учетом его доли.
+func (i *Interactor) mostProfitableOwnership(ctx context.Context, period *domain.Period, ownerships []*domain.CompanyOwner) (best *domain.CompanyOwner, err error) {
+	var maxProfit decimal.Decimal
+
+	for _, own := range ownerships {
+		rep, err := i.finService.GetByCompany(ctx, own.CompanyID, period)
+		if err != nil {
+			return nil, fmt.Errorf("получение отчета компании: %w", err)
+		}
+
+		profit := rep.Profit().Mul(own.Weight())
+		if profit.GreaterThan(maxProfit) {
+			best = own
+			maxProfit = profit
+		}
+	}
+
+	return best, nil
+}
+
+// getMaxRevenue возвращает наибольшую выручку предпринимателя за период, загруженную не раньше maxRevenueTTL назад.
+func (i *Interactor) getMaxRevenue(ctx context.Context, period *domain.Period) (maxRevenue decimal.Decimal, err error) {
+	i.maxRevenueMu.Lock()
+	defer i.maxRevenueMu.Unlock()
+
+	now := i.clock.Now()
+	if i.maxRevenue != nil && i.maxRevenue.period == *period && now.Sub(i.maxRevenue.loadedAt) < maxRevenueTTL {
+		return i.maxRevenue.value, nil
+	}
+
+	maxRevenue, err = i.userService.GetMaxRevenue(ctx, period)
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	i.maxRevenue = &cachedMaxRevenue{period: *period, value: maxRevenue, loadedAt: now}
+
+	return maxRevenue, nil
+}
+
+// ownershipsRevenue - выручка компаний пользователя за период с учетом его долей.
+func (i *Interactor) ownershipsRevenue(ctx context.Context, period *domain.Period, ownerships []*domain.CompanyOwner) (revenue decimal.Decimal, err error) {
+	for _, own := range ownerships {
+		rep, err := i.finService.GetByCompany(ctx, own.CompanyID, period)
+		if err != nil {
+			return decimal.Zero, fmt.Errorf("получение отчета компании: %w", err)
+		}
+
+		revenue = revenue.Add(rep.Revenue().Mul(own.Weight()))
+	}
+
+	return revenue.Round(moneyPrecision), nil
+}
+
+// CalculateUserRating вычисляет рейтинг предпринимателя за период (по умолчанию - за предыдущий год)
+// стратегией с именем strategyName (по умолчанию - заданной в конфиге) вместе с его составляющими.
+func (i *Interactor) CalculateUserRating(ctx context.Context, id uuid.UUID, period *domain.Period, strategyName string) (breakdown *domain.RatingBreakdown, err error) {
+	prompt := "UserActivityFieldCalculateUserRating"
+
+	if period == nil {
+		period = i.prevYearPeriod()
+	}
+
+	err = period.Validate()
+	if err != nil {
+		i.logger.Infof("%s: %v", prompt, err)
+		return nil, err
+	}
+
+	strategy, err := i.ratingStrategy(strategyName)
+	if err != nil {
+		i.logger.Infof("%s: %v", prompt, err)
+		return nil, err
+	}
+
+	ownerships, err := i.compService.GetOwnerships(ctx, id)
+	if err != nil {
+		i.logger.Infof("%s: получение долей в компаниях: %v", prompt, err)
+		return nil, fmt.Errorf("получение долей в компаниях: %w", err)
+	}
 
 	report, err := i.GetUserFinancialReport(ctx, id, period)
 	if err != nil {
 		i.logger.Infof("%s: получение финансового отчета пользователя: %v", prompt, err)
-		return 0, fmt.Errorf("получение финансового отчета пользователя: %w", err)
+		return nil, fmt.Errorf("получение финансового отчета пользователя: %w", err)
 	}
 
-	mostProfitableCompany, err := i.GetMostProfitableCompany(ctx, period, companies)
+	breakdown = &domain.RatingBreakdown{
+		Strategy: strategy.Name(),
+		Period:   period,
+		Revenue:  report.Revenue(),
+		Profit:   report.Profit(),
+	}
+
+	mostProfitable, err := i.mostProfitableOwnership(ctx, period, ownerships)
 	if err != nil {
 		i.logger.Infof("%s: поиск наиболее прибыльной компании: %v", prompt, err)
-		return 0, fmt.Errorf("поиск наиболее прибыльной компании: %w", err)
+		return nil, fmt.Errorf("поиск наиболее прибыльной компании: %w", err)
 	}
-	if mostProfitableCompany == nil {
-		return 0.0, nil
+	if mostProfitable == nil {
+		return breakdown, nil
 	}
 
-	maxCost, err := i.actFieldService.GetMaxCost(ctx)
+	breakdown.Share = mostProfitable.Share
+	breakdown.Company, err = i.compService.GetById(ctx, mostProfitable.CompanyID)
+	if err != nil {
+		i.logger.Infof("%s: получение наиболее прибыльной компании: %v", prompt, err)
+		return nil, fmt.Errorf("получение наиболее прибыльной компании: %w", err)
+	}
+
+	breakdown.MaxCost, err = i.actFieldService.GetMaxCost(ctx)
 	if err != nil {
 		i.logger.Infof("%s: поиск максимального веса: %v", prompt, err)
-		return 0, fmt.Errorf("поиск максимального веса: %w", err)
+		return nil, fmt.Errorf("поиск максимального веса: %w", err)
 	}
 
-	cost, err := i.actFieldService.GetCostByCompanyId(ctx, mostProfitableCompany.ID)
+	breakdown.Cost, err = i.actFieldService.GetCostByCompanyId(ctx, mostProfitable.CompanyID)
 	if err != nil {
 		i.logger.Infof("%s: получение веса сферы деятельности компании: %v", prompt, err)
-		return 0, fmt.Errorf("получение веса сферы деятельности компании: %w", err)
+		return nil, fmt.Errorf("получение веса сферы деятельности компании: %w", err)
+	}
+
+	input := &domain.RatingInput{
+		Revenue: breakdown.Revenue,
+		Profit:  breakdown.Profit,
+		Cost:    breakdown.Cost,
+		MaxCost: breakdown.MaxCost,
 	}
 
-	var totalRevenue, totalProfit float32
-	totalRevenue = report.Revenue()
-	totalProfit = report.Profit()
+	requirements := strategy.Requirements()
+
+	if requirements.PrevRevenue {
+		input.PrevRevenue, err = i.ownershipsRevenue(ctx, period.Previous(), ownerships)
+		if err != nil {
+			i.logger.Infof("%s: получение выручки за предшествующий период: %v", prompt, err)
+			return nil, fmt.Errorf("получение выручки за предшествующий период: %w", err)
+		}
+	}
+
+	if requirements.MaxRevenue {
+		input.MaxRevenue, err = i.getMaxRevenue(ctx, period)
+		if err != nil {
+			i.logger.Infof("%s: поиск наибольшей выручки: %v", prompt, err)
+			return nil, fmt.Errorf("поиск наибольшей выручки: %w", err)
+		}
+	}
+
+	if requirements.Reviews {
+		reviews, err := i.reviewService.GetStatsByTarget(ctx, id)
+		if err != nil {
+			i.logger.Infof("%s: получение статистики отзывов: %v", prompt, err)
+			return nil, fmt.Errorf("получение статистики отзывов: %w", err)
+		}
+		input.Reviews = *reviews
+	}
 
-	rating = calcRating(totalProfit, totalRevenue, cost, maxCost)
+	breakdown.CostContribution, breakdown.ProfitabilityContribution, breakdown.Rating = calcRating(strategy, input)
 
-	return rating, nil
+	return breakdown, nil
 }
 
+// GetRanking строит рейтинг предпринимателей за период (по умолчанию - за предыдущий год) стратегией
+// с именем strategyName (по умолчанию - заданной в конфиге).
+func (i *Interactor) GetRanking(ctx context.Context, filter *domain.UserFilter, period *domain.Period, page int, strategyName string) (ranking []*domain.UserRating, numPages int, err error) {
+	prompt := "UserActivityFieldGetRanking"
+
+	if period == nil {
+		period = i.prevYearPeriod()
+	}
+
+	err = period.Validate()
+	if err != nil {
+		i.logger.Infof("%s: %v", prompt, err)
+		return nil, 0, err
+	}
+
+	if page < 1 {
+		i.logger.Infof("%s: номер страницы должен быть положительным", prompt)
+		return nil, 0, fmt.Errorf("номер страницы должен быть положительным")
+	}
+
+	strategy, err := i.ratingStrategy(strategyName)
+	if err != nil {
+		i.logger.Infof("%s: %v", prompt, err)
+		return nil, 0, err
+	}
+
+	data, err := i.userService.GetRatingData(ctx, period, filter)
+	if err != nil {
+		i.logger.Infof("%s: получение показателей предпринимателей: %v", prompt, err)
+		return nil, 0, fmt.Errorf("получение показателей предпринимателей: %w", err)
+	}
+
+	requirements := strategy.Requirements()
+
+	prevRevenue := make(map[uuid.UUID]decimal.Decimal)
+	if requirements.PrevRevenue {
+		prevData, err := i.userService.GetRatingData(ctx, period.Previous(), filter)
+		if err != nil {
+			i.logger.Infof("%s: получение показателей предпринимателей за предшествующий период: %v", prompt, err)
+			return nil, 0, fmt.Errorf("получение показателей предпринимателей за предшествующий период: %w", err)
+		}
+
+		for _, entry := range prevData {
+			prevRevenue[entry.User.ID] = entry.Revenue
+		}
+	}
+
+	maxCost, err := i.actFieldService.GetMaxCost(ctx)
+	if err != nil {
+		i.logger.Infof("%s: поиск максимального веса: %v", prompt, err)
+		return nil, 0, fmt.Errorf("поиск максимального веса: %w", err)
+	}
+
+	var maxRevenue decimal.Decimal
+	if requirements.MaxRevenue {
+		maxRevenue, err = i.getMaxRevenue(ctx, period)
+		if err != nil {
+			i.logger.Infof("%s: поиск наибольшей выручки: %v", prompt, err)
+			return nil, 0, fmt.Errorf("поиск наибольшей выручки: %w", err)
+		}
+	}
+
+	ranking = make([]*domain.UserRating, len(data))
+	for j, entry := range data {
+		ranking[j] = &domain.UserRating{User: entry.User}
+
+		// как и в CalculateUserRating, рейтинг предпринимателя без прибыльных компаний равен нулю
+		if entry.Cost.Valid {
+			_, _, ranking[j].Rating = calcRating(strategy, &domain.RatingInput{
+				Revenue:     entry.Revenue,
+				Profit:      entry.Profit,
+				PrevRevenue: prevRevenue[entry.User.ID],
+				Cost:        entry.Cost.Decimal,
+				MaxCost:     maxCost,
+				MaxRevenue:  maxRevenue,
+				Reviews:     entry.Reviews,
+			})
+		}
+	}
+
+	sort.SliceStable(ranking, func(a, b int) bool {
+		return ranking[a].Rating > ranking[b].Rating
+	})
+
+	numPages = len(ranking) / config.PageSize
+	if len(ranking)%config.PageSize != 0 {
+		numPages++
+	}
+
+	from := (page - 1) * config.PageSize
+	if from >= len(ranking) {
+		return make([]*domain.UserRating, 0), numPages, nil
+	}
+	to := min(from+config.PageSize, len(ranking))
+
+	return ranking[from:to], numPages, nil
+}
+
+// weightReports возвращает отчеты компании в части, приходящейся на долю пользователя.
+func weightReports(reports []domain.FinancialReport, weight decimal.Decimal) (weighted []domain.FinancialReport) {
+	weighted = make([]domain.FinancialReport, len(reports))
+	for j, rep := range reports {
+		rep.Revenue = rep.Revenue.Mul(weight).Round(moneyPrecision)
+		rep.Costs = rep.Costs.Mul(weight).Round(moneyPrecision)
+		weighted[j] = rep
+	}
+
+	return weighted
+}
+
+// GetUserFinancialReport суммирует показатели компаний пользователя пропорционально его долям.
+// Налог считается с прибыли всей компании, пользователю из него приходится та же доля.
 func (i *Interactor) GetUserFinancialReport(ctx context.Context, id uuid.UUID, period *domain.Period) (report *domain.FinancialReportByPeriod, err error) {
 	prompt := "UserActivityFieldGetUserFinancialReport"
 	report = new(domain.FinancialReportByPeriod)
 
-	companies, _, err := i.compService.GetByOwnerId(ctx, id, 0, false)
+	ownerships, err := i.compService.GetOwnerships(ctx, id)
 	if err != nil {
-		i.logger.Infof("%s: получение списка компаний: %v", prompt, err)
-		return nil, fmt.Errorf("получение списка компаний: %w", err)
+		i.logger.Infof("%s: получение долей в компаниях: %v", prompt, err)
+		return nil, fmt.Errorf("получение долей в компаниях: %w", err)
 	}
 
-	var revenueForTaxLoad float32
+	var revenueForTaxLoad decimal.Decimal
+	schedules := make(map[int]*domain.TaxSchedule)
 	report.Reports = make([]domain.FinancialReport, 0)
-	for _, comp := range companies {
-		rep, err := i.finService.GetByCompany(ctx, comp.ID, period)
+	for _, own := range ownerships {
+		rep, err := i.finService.GetByCompany(ctx, own.CompanyID, period)
 		if err != nil {
 			i.logger.Infof("%s: получение отчета компании: %v", prompt, err)
 			return nil, fmt.Errorf("получение отчета компании: %w", err)
@@ -215,17 +542,43 @@ func (i *Interactor) GetUserFinancialReport(ctx context.Context, id uuid.UUID, p
 
 		fullYears := findFullYearReports(rep, period)
 
-		tax := calculateTaxes(fullYears)
-		report.Taxes += tax.taxes
-		revenueForTaxLoad += tax.revenue
+		err = i.loadTaxSchedules(ctx, fullYears, schedules)
+		if err != nil {
+			i.logger.Infof("%s: %v", prompt, err)
+			return nil, err
+		}
 
-		report.Reports = append(report.Reports, rep.Reports...)
+		weight := own.Weight()
+		tax := calculateTaxes(fullYears, schedules)
+		report.Taxes = report.Taxes.Add(tax.taxes.Mul(weight))
+		revenueForTaxLoad = revenueForTaxLoad.Add(tax.revenue.Mul(weight))
+
+		report.Reports = append(report.Reports, weightReports(rep.Reports, weight)...)
 	}
 
 	report.Period = period
-	if math.Abs(float64(revenueForTaxLoad)) >= 1e-6 {
-		report.TaxLoad = report.Taxes / revenueForTaxLoad * 100
+	report.Taxes = report.Taxes.Round(moneyPrecision)
+	if !revenueForTaxLoad.IsZero() {
+		report.TaxLoad = report.Taxes.Mul(hundred).Div(revenueForTaxLoad).Round(moneyPrecision)
 	}
 
 	return report, nil
 }
+
+// GetUserInfluence возвращает влияние предпринимателя в сферах деятельности его компаний за период,
+// по умолчанию - за тот же период, что и рейтинг.
+func (i *Interactor) GetUserInfluence(ctx context.Context, id uuid.UUID, period *domain.Period) (influence []*domain.ActivityFieldInfluence, err error) {
+	prompt := "UserActivityFieldGetUserInfluence"
+
+	if period == nil {
+		period = i.prevYearPeriod()
+	}
+
+	influence, err = i.userService.GetInfluence(ctx, id, period)
+	if err != nil {
+		i.logger.Infof("%s: получение влияния в сферах деятельности: %v", prompt, err)
+		return nil, fmt.Errorf("получение влияния в сферах деятельности: %w", err)
+	}
+
+	return influence, nil
+}
@@ -2,20 +2,89 @@ package user_activity_field
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
+	"io"
 	"ppo/domain"
 	"ppo/internal/services/activity_field"
 	"ppo/internal/services/company"
 	"ppo/internal/services/fin_report"
+	"ppo/internal/services/review"
+	"ppo/internal/services/tax_schedule"
 	"ppo/internal/services/user"
 	"ppo/mocks"
+	"ppo/pkg/logger"
 	"testing"
+	"time"
 )
 
 const eps = 1e-7
 
+type fixedClock time.Time
+
+func (c fixedClock) Now() time.Time {
+	return time.Time(c)
+}
+
+// тесты не зависят от текущей даты: "сейчас" - второй квартал 2024 года, предыдущий год - 2023
+var testClock = fixedClock(time.Date(2024, time.May, 15, 12, 0, 0, 0, time.UTC))
+
+var period2023 = &domain.Period{
+	StartYear:    2023,
+	EndYear:      2023,
+	StartQuarter: 1,
+	EndQuarter:   4,
+}
+
+// flatTaxSchedule - шкала с единственной ставкой rate процентов
+func flatTaxSchedule(year int, rate int64) *domain.TaxSchedule {
+	return &domain.TaxSchedule{
+		Year:   year,
+		Regime: domain.DefaultTaxRegime,
+		Brackets: []domain.TaxBracket{
+			{Rate: decimal.NewFromInt(rate)},
+		},
+	}
+}
+
+func ownerships(userId uuid.UUID, companyIds ...uuid.UUID) []*domain.CompanyOwner {
+	owners := make([]*domain.CompanyOwner, len(companyIds))
+	for i, companyId := range companyIds {
+		owners[i] = &domain.CompanyOwner{
+			CompanyID:  companyId,
+			UserID:     userId,
+			Share:      domain.MaxOwnerShare,
+			AcceptedAt: time.Date(2022, time.January, 1, 0, 0, 0, 0, time.UTC),
+		}
+	}
+
+	return owners
+}
+
+// yearReports - отчеты компании за четыре квартала года year с одинаковыми выручкой и расходами
+func yearReports(companyId uuid.UUID, year int, revenue, costs int64) *domain.FinancialReportByPeriod {
+	reports := make([]domain.FinancialReport, 4)
+	for i := range reports {
+		reports[i] = domain.FinancialReport{
+			ID:        uuid.UUID{byte(i + 1)},
+			CompanyID: companyId,
+			Year:      year,
+			Quarter:   i + 1,
+			Revenue:   decimal.NewFromInt(revenue),
+			Costs:     decimal.NewFromInt(costs),
+		}
+	}
+
+	return &domain.FinancialReportByPeriod{
+		Reports: reports,
+		Period:  &domain.Period{StartYear: year, StartQuarter: 1, EndYear: year, EndQuarter: 4},
+	}
+}
+
 func TestInteractor_CalculateUserRating(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -24,48 +93,55 @@ func TestInteractor_CalculateUserRating(t *testing.T) {
 	finRepo := mocks.NewMockIFinancialReportRepository(ctrl)
 	compRepo := mocks.NewMockICompanyRepository(ctrl)
 	actFieldRepo := mocks.NewMockIActivityFieldRepository(ctrl)
+	ownerRepo := mocks.NewMockICompanyOwnerRepository(ctrl)
+	taxRepo := mocks.NewMockITaxScheduleRepository(ctrl)
+	reviewRepo := mocks.NewMockIReviewRepository(ctrl)
 
-	userSvc := user.NewService(userRepo, compRepo, actFieldRepo)
-	actFieldSvc := activity_field.NewService(actFieldRepo, compRepo)
-	compSvc := company.NewService(compRepo)
-	finSvc := fin_report.NewService(finRepo)
+	log := logger.NewLogger(logger.InfoLevel, io.Discard)
+	userSvc := user.NewService(userRepo, compRepo, actFieldRepo, log)
+	actFieldSvc := activity_field.NewService(actFieldRepo, compRepo, log)
+	compSvc := company.NewService(compRepo, actFieldRepo, ownerRepo, log)
+	finSvc := fin_report.NewService(finRepo, compRepo, ownerRepo, log)
+	taxSvc := tax_schedule.NewService(taxRepo, log)
+	reviewSvc := review.NewService(reviewRepo, userRepo, log)
 
-	interactor := NewInteractor(userSvc, actFieldSvc, compSvc, finSvc)
+	interactor := NewInteractor(userSvc, actFieldSvc, compSvc, finSvc, taxSvc, reviewSvc, testClock, domain.RatingStrategyCurrent, log)
 
 	testCases := []struct {
 		name       string
 		userId     uuid.UUID
+		period     *domain.Period
+		strategy   string
 		beforeTest func(
 			userRepo mocks.MockIUserRepository,
 			finRepo mocks.MockIFinancialReportRepository,
 			compRepo mocks.MockICompanyRepository,
 			actFieldRepo mocks.MockIActivityFieldRepository,
+			ownerRepo mocks.MockICompanyOwnerRepository,
+			taxRepo mocks.MockITaxScheduleRepository,
+			reviewRepo mocks.MockIReviewRepository,
 		)
-		wantErr  bool
-		expected float32
-		errStr   error
+		wantErr                  bool
+		expected                 float32
+		expectedCost             float32
+		expectedProfitability    float32
+		expectedCompany          *domain.Company
+		expectedCompanyFieldCost decimal.Decimal
+		expectedMaxCost          decimal.Decimal
+		errStr                   error
 	}{
 		{
-			name:   "успешное вычисление рейтинга",
+			name:   "успешное вычисление рейтинга за предыдущий год",
 			userId: uuid.UUID{1},
-			beforeTest: func(userRepo mocks.MockIUserRepository, finRepo mocks.MockIFinancialReportRepository, compRepo mocks.MockICompanyRepository, actFieldRepo mocks.MockIActivityFieldRepository) {
-				compRepo.EXPECT().
-					GetByOwnerId(context.Background(), uuid.UUID{1}, 0, false).
-					Return(
-						[]*domain.Company{
-							{
-								ID:      uuid.UUID{1},
-								OwnerID: uuid.UUID{1},
-								Name:    "a",
-								City:    "a",
-							},
-							{
-								ID:      uuid.UUID{2},
-								OwnerID: uuid.UUID{1},
-								Name:    "b",
-								City:    "b",
-							},
-						}, nil).AnyTimes()
+			beforeTest: func(userRepo mocks.MockIUserRepository, finRepo mocks.MockIFinancialReportRepository, compRepo mocks.MockICompanyRepository, actFieldRepo mocks.MockIActivityFieldRepository, ownerRepo mocks.MockICompanyOwnerRepository, taxRepo mocks.MockITaxScheduleRepository, reviewRepo mocks.MockIReviewRepository) {
+				ownerRepo.EXPECT().
+					GetAcceptedByUserId(context.Background(), uuid.UUID{1}).
+					Return(ownerships(uuid.UUID{1}, uuid.UUID{1}, uuid.UUID{2}), nil).
+					Times(2)
+
+				taxRepo.EXPECT().
+					GetByYear(context.Background(), 2023, domain.DefaultTaxRegime).
+					Return(flatTaxSchedule(2023, 20), nil)
 
 				compRepo.EXPECT().
 					GetById(
@@ -78,7 +154,8 @@ func TestInteractor_CalculateUserRating(t *testing.T) {
 						ActivityFieldId: uuid.UUID{1},
 						Name:            "a",
 						City:            "a",
-					}, nil)
+					}, nil).
+					Times(2)
 
 				actFieldRepo.EXPECT().
 					GetById(
@@ -88,23 +165,18 @@ func TestInteractor_CalculateUserRating(t *testing.T) {
 					Return(
 						&domain.ActivityField{
 							ID:   uuid.UUID{1},
-							Cost: float32(5.0),
+							Cost: decimal.NewFromInt(5),
 						}, nil)
 
 				actFieldRepo.EXPECT().
 					GetMaxCost(context.Background()).
-					Return(float32(13.5), nil)
+					Return(decimal.RequireFromString("13.5"), nil)
 
 				finRepo.EXPECT().
 					GetByCompany(
 						context.Background(),
 						uuid.UUID{1},
-						&domain.Period{
-							StartYear:    2023,
-							EndYear:      2023,
-							StartQuarter: 1,
-							EndQuarter:   4,
-						},
+						period2023,
 					).
 					Return(&domain.FinancialReportByPeriod{
 						Reports: []domain.FinancialReport{
@@ -112,32 +184,32 @@ func TestInteractor_CalculateUserRating(t *testing.T) {
 								ID:        uuid.UUID{8},
 								Year:      2023,
 								Quarter:   1,
-								Revenue:   32532513,
-								Costs:     5436438,
+								Revenue:   decimal.NewFromInt(32532513),
+								Costs:     decimal.NewFromInt(5436438),
 								CompanyID: uuid.UUID{1},
 							},
 							{
 								ID:        uuid.UUID{9},
 								Year:      2023,
 								Quarter:   2,
-								Revenue:   6743634,
-								Costs:     9876967,
+								Revenue:   decimal.NewFromInt(6743634),
+								Costs:     decimal.NewFromInt(9876967),
 								CompanyID: uuid.UUID{1},
 							},
 							{
 								ID:        uuid.UUID{10},
 								Year:      2023,
 								Quarter:   3,
-								Revenue:   4675424,
-								Costs:     2436653,
+								Revenue:   decimal.NewFromInt(4675424),
+								Costs:     decimal.NewFromInt(2436653),
 								CompanyID: uuid.UUID{1},
 							},
 							{
 								ID:        uuid.UUID{11},
 								Year:      2023,
 								Quarter:   4,
-								Revenue:   14385253,
-								Costs:     7546424,
+								Revenue:   decimal.NewFromInt(14385253),
+								Costs:     decimal.NewFromInt(7546424),
 								CompanyID: uuid.UUID{1},
 							},
 						},
@@ -153,12 +225,7 @@ func TestInteractor_CalculateUserRating(t *testing.T) {
 					GetByCompany(
 						context.Background(),
 						uuid.UUID{2},
-						&domain.Period{
-							StartYear:    2023,
-							EndYear:      2023,
-							StartQuarter: 1,
-							EndQuarter:   4,
-						},
+						period2023,
 					).
 					Return(&domain.FinancialReportByPeriod{
 						Reports: []domain.FinancialReport{
@@ -166,32 +233,32 @@ func TestInteractor_CalculateUserRating(t *testing.T) {
 								ID:        uuid.UUID{8},
 								Year:      2023,
 								Quarter:   1,
-								Revenue:   3253251,
-								Costs:     543643,
+								Revenue:   decimal.NewFromInt(3253251),
+								Costs:     decimal.NewFromInt(543643),
 								CompanyID: uuid.UUID{2},
 							},
 							{
 								ID:        uuid.UUID{9},
 								Year:      2023,
 								Quarter:   2,
-								Revenue:   6743634,
-								Costs:     9876967,
+								Revenue:   decimal.NewFromInt(6743634),
+								Costs:     decimal.NewFromInt(9876967),
 								CompanyID: uuid.UUID{2},
 							},
 							{
 								ID:        uuid.UUID{10},
 								Year:      2023,
 								Quarter:   3,
-								Revenue:   4675412,
-								Costs:     2436765,
+								Revenue:   decimal.NewFromInt(4675412),
+								Costs:     decimal.NewFromInt(2436765),
 								CompanyID: uuid.UUID{2},
 							},
 							{
 								ID:        uuid.UUID{11},
 								Year:      2023,
 								Quarter:   4,
-								Revenue:   1438525,
-								Costs:     754642,
+								Revenue:   decimal.NewFromInt(1438525),
+								Costs:     decimal.NewFromInt(754642),
 								CompanyID: uuid.UUID{2},
 							},
 						},
@@ -203,23 +270,727 @@ func TestInteractor_CalculateUserRating(t *testing.T) {
 						},
 					}, nil).AnyTimes()
 			},
-			expected: (5.0/13.5 + float32(32532513+6743634+4675424+14385253+3253251+6743634+4675412+1438525-5436438-9876967-2436653-7546424-543643-9876967-2436765-754642)/float32(32532513+6743634+4675424+14385253+3253251+6743634+4675412+1438525)) / 2.0,
+			expected:              (5.0/13.5 + float32(32532513+6743634+4675424+14385253+3253251+6743634+4675412+1438525-5436438-9876967-2436653-7546424-543643-9876967-2436765-754642)/float32(32532513+6743634+4675424+14385253+3253251+6743634+4675412+1438525)) / 2.0,
+			expectedCost:          5.0 / 13.5 / 2.0,
+			expectedProfitability: float32(32532513+6743634+4675424+14385253+3253251+6743634+4675412+1438525-5436438-9876967-2436653-7546424-543643-9876967-2436765-754642) / float32(32532513+6743634+4675424+14385253+3253251+6743634+4675412+1438525) / 2.0,
+			// вес сферы деятельности берется у самой прибыльной компании предпринимателя
+			expectedCompany: &domain.Company{
+				ID:              uuid.UUID{1},
+				OwnerID:         uuid.UUID{1},
+				ActivityFieldId: uuid.UUID{1},
+				Name:            "a",
+				City:            "a",
+			},
+			expectedCompanyFieldCost: decimal.NewFromInt(5),
+			expectedMaxCost:          decimal.RequireFromString("13.5"),
+		},
+		{
+			name:   "нет прибыльных компаний",
+			userId: uuid.UUID{2},
+			period: &domain.Period{
+				StartYear:    2023,
+				EndYear:      2024,
+				StartQuarter: 2,
+				EndQuarter:   1,
+			},
+			beforeTest: func(userRepo mocks.MockIUserRepository, finRepo mocks.MockIFinancialReportRepository, compRepo mocks.MockICompanyRepository, actFieldRepo mocks.MockIActivityFieldRepository, ownerRepo mocks.MockICompanyOwnerRepository, taxRepo mocks.MockITaxScheduleRepository, reviewRepo mocks.MockIReviewRepository) {
+				ownerRepo.EXPECT().
+					GetAcceptedByUserId(context.Background(), uuid.UUID{2}).
+					Return([]*domain.CompanyOwner{}, nil).
+					Times(2)
+			},
+			expected: 0,
+		},
+		{
+			name:     "неизвестная стратегия",
+			userId:   uuid.UUID{1},
+			strategy: "magic",
+			wantErr:  true,
+			errStr:   errors.New("неизвестная стратегия вычисления рейтинга: magic"),
+		},
+		{
+			name:   "конец периода раньше начала",
+			userId: uuid.UUID{1},
+			period: &domain.Period{
+				StartYear:    2024,
+				EndYear:      2023,
+				StartQuarter: 1,
+				EndQuarter:   4,
+			},
+			wantErr: true,
+			errStr:  errors.New("дата конца периода должна быть позже даты начала"),
 		},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			ctx := context.Background()
 			if tc.beforeTest != nil {
-				tc.beforeTest(*userRepo, *finRepo, *compRepo, *actFieldRepo)
+				tc.beforeTest(*userRepo, *finRepo, *compRepo, *actFieldRepo, *ownerRepo, *taxRepo, *reviewRepo)
 			}
 
-			val, err := interactor.CalculateUserRating(ctx, tc.userId)
+			val, err := interactor.CalculateUserRating(ctx, tc.userId, tc.period, tc.strategy)
 
 			if tc.wantErr {
 				require.Equal(t, tc.errStr.Error(), err.Error())
 			} else {
 				require.Nil(t, err)
-				require.InEpsilon(t, tc.expected, val, eps)
+				require.Equal(t, domain.RatingStrategyCurrent, val.Strategy)
+				require.InDelta(t, tc.expected, val.Rating, eps)
+				require.InDelta(t, tc.expectedCost, val.CostContribution, eps)
+				require.InDelta(t, tc.expectedProfitability, val.ProfitabilityContribution, eps)
+				require.InDelta(t, val.Rating, val.CostContribution+val.ProfitabilityContribution, eps)
+				require.True(t, tc.expectedCompanyFieldCost.Equal(val.Cost))
+				require.True(t, tc.expectedMaxCost.Equal(val.MaxCost))
+
+				if tc.period == nil {
+					require.Equal(t, period2023, val.Period)
+				} else {
+					require.Equal(t, tc.period, val.Period)
+				}
+
+				require.Equal(t, tc.expectedCompany, val.Company)
+			}
+		})
+	}
+}
+
+// Стратегии, кроме стратегии по умолчанию, получают данные, которые нужны только им; лишние данные
+// не загружаются: неожиданный вызов репозитория завершает тест ошибкой.
+func TestInteractor_CalculateUserRatingStrategies(t *testing.T) {
+	testCases := []struct {
+		name                string
+		strategy            string
+		calls               int
+		beforeTest          func(userRepo mocks.MockIUserRepository, finRepo mocks.MockIFinancialReportRepository, reviewRepo mocks.MockIReviewRepository)
+		expectedPerformance float32
+	}{
+		{
+			name:                "текущая формула",
+			strategy:            domain.RatingStrategyCurrent,
+			calls:               1,
+			expectedPerformance: 1000.0 / 4000.0 / 2,
+		},
+		{
+			name:     "с учетом выручки",
+			strategy: domain.RatingStrategyRevenueWeighted,
+			calls:    1,
+			beforeTest: func(userRepo mocks.MockIUserRepository, finRepo mocks.MockIFinancialReportRepository, reviewRepo mocks.MockIReviewRepository) {
+				userRepo.EXPECT().
+					GetMaxRevenue(context.Background(), period2023).
+					Return(decimal.NewFromInt(8000), nil)
+			},
+			expectedPerformance: 1000.0 / 8000.0 / 2,
+		},
+		{
+			name:     "наибольшая выручка загружается один раз за период",
+			strategy: domain.RatingStrategyRevenueWeighted,
+			calls:    3,
+			beforeTest: func(userRepo mocks.MockIUserRepository, finRepo mocks.MockIFinancialReportRepository, reviewRepo mocks.MockIReviewRepository) {
+				userRepo.EXPECT().
+					GetMaxRevenue(context.Background(), period2023).
+					Return(decimal.NewFromInt(8000), nil)
+			},
+			expectedPerformance: 1000.0 / 8000.0 / 2,
+		},
+		{
+			name:     "с учетом роста выручки",
+			strategy: domain.RatingStrategyGrowth,
+			calls:    1,
+			beforeTest: func(userRepo mocks.MockIUserRepository, finRepo mocks.MockIFinancialReportRepository, reviewRepo mocks.MockIReviewRepository) {
+				finRepo.EXPECT().
+					GetByCompany(context.Background(), uuid.UUID{1}, period2023.Previous()).
+					Return(yearReports(uuid.UUID{1}, 2022, 800, 700), nil)
+			},
+			expectedPerformance: (4000.0 - 3200.0) / 3200.0 / 2,
+		},
+		{
+			name:     "с учетом отзывов",
+			strategy: domain.RatingStrategyReviewWeighted,
+			calls:    1,
+			beforeTest: func(userRepo mocks.MockIUserRepository, finRepo mocks.MockIFinancialReportRepository, reviewRepo mocks.MockIReviewRepository) {
+				reviewRepo.EXPECT().
+					GetStatsByTarget(context.Background(), uuid.UUID{1}).
+					Return(&domain.ReviewStats{Count: 2, AverageRating: 4}, nil)
+			},
+			expectedPerformance: (1000.0/4000.0 + 4.0/5.0) / 4,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			userRepo := mocks.NewMockIUserRepository(ctrl)
+			finRepo := mocks.NewMockIFinancialReportRepository(ctrl)
+			compRepo := mocks.NewMockICompanyRepository(ctrl)
+			actFieldRepo := mocks.NewMockIActivityFieldRepository(ctrl)
+			ownerRepo := mocks.NewMockICompanyOwnerRepository(ctrl)
+			taxRepo := mocks.NewMockITaxScheduleRepository(ctrl)
+			reviewRepo := mocks.NewMockIReviewRepository(ctrl)
+
+			log := logger.NewLogger(logger.InfoLevel, io.Discard)
+			interactor := NewInteractor(
+				user.NewService(userRepo, compRepo, actFieldRepo, log),
+				activity_field.NewService(actFieldRepo, compRepo, log),
+				company.NewService(compRepo, actFieldRepo, ownerRepo, log),
+				fin_report.NewService(finRepo, compRepo, ownerRepo, log),
+				tax_schedule.NewService(taxRepo, log),
+				review.NewService(reviewRepo, userRepo, log),
+				testClock,
+				domain.RatingStrategyCurrent,
+				log,
+			)
+
+			// выручка 4000, прибыль 1000, вес сферы деятельности - половина максимального
+			ownerRepo.EXPECT().
+				GetAcceptedByUserId(context.Background(), uuid.UUID{1}).
+				Return(ownerships(uuid.UUID{1}, uuid.UUID{1}), nil).
+				AnyTimes()
+			finRepo.EXPECT().
+				GetByCompany(context.Background(), uuid.UUID{1}, period2023).
+				Return(yearReports(uuid.UUID{1}, 2023, 1000, 750), nil).
+				AnyTimes()
+			taxRepo.EXPECT().
+				GetByYear(context.Background(), 2023, domain.DefaultTaxRegime).
+				Return(flatTaxSchedule(2023, 20), nil).
+				AnyTimes()
+			compRepo.EXPECT().
+				GetById(context.Background(), uuid.UUID{1}).
+				Return(&domain.Company{ID: uuid.UUID{1}, ActivityFieldId: uuid.UUID{1}}, nil).
+				AnyTimes()
+			actFieldRepo.EXPECT().
+				GetById(context.Background(), uuid.UUID{1}).
+				Return(&domain.ActivityField{ID: uuid.UUID{1}, Cost: decimal.NewFromInt(5)}, nil).
+				AnyTimes()
+			actFieldRepo.EXPECT().
+				GetMaxCost(context.Background()).
+				Return(decimal.NewFromInt(10), nil).
+				AnyTimes()
+
+			if tc.beforeTest != nil {
+				tc.beforeTest(*userRepo, *finRepo, *reviewRepo)
+			}
+
+			for j := 0; j < tc.calls; j++ {
+				breakdown, err := interactor.CalculateUserRating(context.Background(), uuid.UUID{1}, nil, tc.strategy)
+
+				require.Nil(t, err)
+				require.Equal(t, tc.strategy, breakdown.Strategy)
+				require.InDelta(t, float32(5.0/10.0/2), breakdown.CostContribution, eps)
+				require.InDelta(t, tc.expectedPerformance, breakdown.ProfitabilityContribution, eps)
+			}
+		})
+	}
+}
+
+// ratingData - показатели предпринимателя для рейтинга; cost < 0 - нет прибыльных компаний
+func ratingData(userId uuid.UUID, revenue, profit, cost int64) *domain.UserRatingData {
+	data := &domain.UserRatingData{
+		User:    &domain.User{ID: userId},
+		Revenue: decimal.NewFromInt(revenue),
+		Profit:  decimal.NewFromInt(profit),
+	}
+	if cost >= 0 {
+		data.Cost = decimal.NewNullDecimal(decimal.NewFromInt(cost))
+	}
+
+	return data
+}
+
+func TestInteractor_GetRanking(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userRepo := mocks.NewMockIUserRepository(ctrl)
+	finRepo := mocks.NewMockIFinancialReportRepository(ctrl)
+	compRepo := mocks.NewMockICompanyRepository(ctrl)
+	actFieldRepo := mocks.NewMockIActivityFieldRepository(ctrl)
+	ownerRepo := mocks.NewMockICompanyOwnerRepository(ctrl)
+	taxRepo := mocks.NewMockITaxScheduleRepository(ctrl)
+	reviewRepo := mocks.NewMockIReviewRepository(ctrl)
+
+	log := logger.NewLogger(logger.InfoLevel, io.Discard)
+	interactor := NewInteractor(
+		user.NewService(userRepo, compRepo, actFieldRepo, log),
+		activity_field.NewService(actFieldRepo, compRepo, log),
+		company.NewService(compRepo, actFieldRepo, ownerRepo, log),
+		fin_report.NewService(finRepo, compRepo, ownerRepo, log),
+		tax_schedule.NewService(taxRepo, log),
+		review.NewService(reviewRepo, userRepo, log),
+		testClock,
+		domain.RatingStrategyCurrent,
+		log,
+	)
+
+	filter := &domain.UserFilter{}
+
+	// по текущей формуле при максимальном весе 10: {1} - 0.25+0.125, {2} - 0.1+0.45, {3} - 0, {4} - 0.4+0.05
+	data := []*domain.UserRatingData{
+		ratingData(uuid.UUID{1}, 4000, 1000, 5),
+		ratingData(uuid.UUID{2}, 1000, 900, 2),
+		ratingData(uuid.UUID{3}, 0, 0, -1),
+		ratingData(uuid.UUID{4}, 2000, 200, 8),
+	}
+
+	expectCurrentData := func(userRepo mocks.MockIUserRepository, actFieldRepo mocks.MockIActivityFieldRepository) {
+		userRepo.EXPECT().
+			GetRatingData(context.Background(), period2023, filter).
+			Return(data, nil)
+		actFieldRepo.EXPECT().
+			GetMaxCost(context.Background()).
+			Return(decimal.NewFromInt(10), nil)
+	}
+
+	customPeriod := &domain.Period{StartYear: 2022, StartQuarter: 3, EndYear: 2023, EndQuarter: 2}
+
+	type ranked struct {
+		id     uuid.UUID
+		rating float32
+	}
+
+	testCases := []struct {
+		name             string
+		period           *domain.Period
+		page             int
+		strategy         string
+		beforeTest       func(userRepo mocks.MockIUserRepository, actFieldRepo mocks.MockIActivityFieldRepository)
+		expected         []ranked
+		expectedNumPages int
+		wantErr          bool
+		errStr           error
+	}{
+		{
+			name:       "первая страница по убыванию рейтинга",
+			page:       1,
+			beforeTest: expectCurrentData,
+			expected: []ranked{
+				{uuid.UUID{2}, 0.55},
+				{uuid.UUID{4}, 0.45},
+				{uuid.UUID{1}, 0.375},
+			},
+			expectedNumPages: 2,
+		},
+		{
+			name:       "вторая страница: предприниматель без прибыльных компаний последний",
+			page:       2,
+			beforeTest: expectCurrentData,
+			expected: []ranked{
+				{uuid.UUID{3}, 0},
+			},
+			expectedNumPages: 2,
+		},
+		{
+			name:             "страница за пределами рейтинга",
+			page:             3,
+			beforeTest:       expectCurrentData,
+			expected:         []ranked{},
+			expectedNumPages: 2,
+		},
+		{
+			name:     "рост выручки относительно предшествующего года",
+			page:     1,
+			strategy: domain.RatingStrategyGrowth,
+			beforeTest: func(userRepo mocks.MockIUserRepository, actFieldRepo mocks.MockIActivityFieldRepository) {
+				expectCurrentData(userRepo, actFieldRepo)
+
+				// {1}: рост 100% - 0.25+0.5, {2}: без выручки - 0.1, {4}: падение 50% - 0.4-0.25
+				userRepo.EXPECT().
+					GetRatingData(context.Background(), period2023.Previous(), filter).
+					Return([]*domain.UserRatingData{
+						ratingData(uuid.UUID{1}, 2000, 0, 5),
+						ratingData(uuid.UUID{4}, 4000, 0, 8),
+					}, nil)
+			},
+			expected: []ranked{
+				{uuid.UUID{1}, 0.75},
+				{uuid.UUID{4}, 0.15},
+				{uuid.UUID{2}, 0.1},
+			},
+			expectedNumPages: 2,
+		},
+		{
+			name:     "с учетом выручки",
+			page:     1,
+			strategy: domain.RatingStrategyRevenueWeighted,
+			beforeTest: func(userRepo mocks.MockIUserRepository, actFieldRepo mocks.MockIActivityFieldRepository) {
+				expectCurrentData(userRepo, actFieldRepo)
+
+				// {1}: 0.25+1000/4000/2, {2}: 0.1+900/4000/2, {4}: 0.4+200/4000/2
+				userRepo.EXPECT().
+					GetMaxRevenue(context.Background(), period2023).
+					Return(decimal.NewFromInt(4000), nil)
+			},
+			expected: []ranked{
+				{uuid.UUID{4}, 0.425},
+				{uuid.UUID{1}, 0.375},
+				{uuid.UUID{2}, 0.2125},
+			},
+			expectedNumPages: 2,
+		},
+		{
+			name:    "неположительный номер страницы",
+			page:    0,
+			wantErr: true,
+			errStr:  errors.New("номер страницы должен быть положительным"),
+		},
+		{
+			name:     "неизвестная стратегия",
+			page:     1,
+			strategy: "magic",
+			wantErr:  true,
+			errStr:   errors.New("неизвестная стратегия вычисления рейтинга: magic"),
+		},
+		{
+			name:   "рейтинг за указанный период",
+			period: customPeriod,
+			page:   1,
+			beforeTest: func(userRepo mocks.MockIUserRepository, actFieldRepo mocks.MockIActivityFieldRepository) {
+				userRepo.EXPECT().
+					GetRatingData(context.Background(), customPeriod, filter).
+					Return(data, nil)
+				actFieldRepo.EXPECT().
+					GetMaxCost(context.Background()).
+					Return(decimal.NewFromInt(10), nil)
+			},
+			expected: []ranked{
+				{uuid.UUID{2}, 0.55},
+				{uuid.UUID{4}, 0.45},
+				{uuid.UUID{1}, 0.375},
+			},
+			expectedNumPages: 2,
+		},
+		{
+			name:     "рост выручки относительно периода, предшествующего указанному",
+			period:   customPeriod,
+			page:     2,
+			strategy: domain.RatingStrategyGrowth,
+			beforeTest: func(userRepo mocks.MockIUserRepository, actFieldRepo mocks.MockIActivityFieldRepository) {
+				userRepo.EXPECT().
+					GetRatingData(context.Background(), customPeriod, filter).
+					Return(data, nil)
+				userRepo.EXPECT().
+					GetRatingData(context.Background(), customPeriod.Previous(), filter).
+					Return([]*domain.UserRatingData{}, nil)
+				actFieldRepo.EXPECT().
+					GetMaxCost(context.Background()).
+					Return(decimal.NewFromInt(10), nil)
+			},
+			expected: []ranked{
+				{uuid.UUID{3}, 0},
+			},
+			expectedNumPages: 2,
+		},
+		{
+			name:    "конец периода раньше начала",
+			period:  &domain.Period{StartYear: 2023, StartQuarter: 2, EndYear: 2022, EndQuarter: 3},
+			page:    1,
+			wantErr: true,
+			errStr:  errors.New("дата конца периода должна быть позже даты начала"),
+		},
+		{
+			name: "ошибка получения показателей",
+			page: 1,
+			beforeTest: func(userRepo mocks.MockIUserRepository, actFieldRepo mocks.MockIActivityFieldRepository) {
+				userRepo.EXPECT().
+					GetRatingData(context.Background(), period2023, filter).
+					Return(nil, errors.New("sql error"))
+			},
+			wantErr: true,
+			errStr: errors.New("получение показателей предпринимателей: " +
+				"получение показателей предпринимателей для рейтинга: sql error"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.beforeTest != nil {
+				tc.beforeTest(*userRepo, *actFieldRepo)
+			}
+
+			ranking, numPages, err := interactor.GetRanking(context.Background(), filter, tc.period, tc.page, tc.strategy)
+
+			if tc.wantErr {
+				require.Equal(t, tc.errStr.Error(), err.Error())
+			} else {
+				require.Nil(t, err)
+				require.Equal(t, tc.expectedNumPages, numPages)
+				require.Len(t, ranking, len(tc.expected))
+				for j, exp := range tc.expected {
+					require.Equal(t, exp.id, ranking[j].User.ID)
+					require.InDelta(t, exp.rating, ranking[j].Rating, eps)
+				}
+			}
+		})
+	}
+}
+
+// Рейтинг в общем списке совпадает с рейтингом, вычисленным для отдельного предпринимателя по его отчетам.
+func TestInteractor_GetRankingMatchesCalculateUserRating(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userRepo := mocks.NewMockIUserRepository(ctrl)
+	finRepo := mocks.NewMockIFinancialReportRepository(ctrl)
+	compRepo := mocks.NewMockICompanyRepository(ctrl)
+	actFieldRepo := mocks.NewMockIActivityFieldRepository(ctrl)
+	ownerRepo := mocks.NewMockICompanyOwnerRepository(ctrl)
+	taxRepo := mocks.NewMockITaxScheduleRepository(ctrl)
+	reviewRepo := mocks.NewMockIReviewRepository(ctrl)
+
+	log := logger.NewLogger(logger.InfoLevel, io.Discard)
+	interactor := NewInteractor(
+		user.NewService(userRepo, compRepo, actFieldRepo, log),
+		activity_field.NewService(actFieldRepo, compRepo, log),
+		company.NewService(compRepo, actFieldRepo, ownerRepo, log),
+		fin_report.NewService(finRepo, compRepo, ownerRepo, log),
+		tax_schedule.NewService(taxRepo, log),
+		review.NewService(reviewRepo, userRepo, log),
+		testClock,
+		domain.RatingStrategyCurrent,
+		log,
+	)
+
+	// единственная компания предпринимателя: выручка 4000, прибыль 1000, вес сферы деятельности 5 из 10
+	ownerRepo.EXPECT().
+		GetAcceptedByUserId(context.Background(), uuid.UUID{1}).
+		Return(ownerships(uuid.UUID{1}, uuid.UUID{1}), nil).
+		AnyTimes()
+	finRepo.EXPECT().
+		GetByCompany(context.Background(), uuid.UUID{1}, period2023).
+		Return(yearReports(uuid.UUID{1}, 2023, 1000, 750), nil).
+		AnyTimes()
+	taxRepo.EXPECT().
+		GetByYear(context.Background(), 2023, domain.DefaultTaxRegime).
+		Return(flatTaxSchedule(2023, 20), nil).
+		AnyTimes()
+	compRepo.EXPECT().
+		GetById(context.Background(), uuid.UUID{1}).
+		Return(&domain.Company{ID: uuid.UUID{1}, ActivityFieldId: uuid.UUID{1}}, nil).
+		AnyTimes()
+	actFieldRepo.EXPECT().
+		GetById(context.Background(), uuid.UUID{1}).
+		Return(&domain.ActivityField{ID: uuid.UUID{1}, Cost: decimal.NewFromInt(5)}, nil).
+		AnyTimes()
+	actFieldRepo.EXPECT().
+		GetMaxCost(context.Background()).
+		Return(decimal.NewFromInt(10), nil).
+		AnyTimes()
+	userRepo.EXPECT().
+		GetRatingData(context.Background(), period2023, &domain.UserFilter{}).
+		Return([]*domain.UserRatingData{ratingData(uuid.UUID{1}, 4000, 1000, 5)}, nil)
+
+	breakdown, err := interactor.CalculateUserRating(context.Background(), uuid.UUID{1}, nil, "")
+	require.Nil(t, err)
+
+	ranking, _, err := interactor.GetRanking(context.Background(), &domain.UserFilter{}, nil, 1, "")
+	require.Nil(t, err)
+
+	require.Len(t, ranking, 1)
+	require.InDelta(t, breakdown.Rating, ranking[0].Rating, eps)
+}
+
+func TestInteractor_GetUserInfluence(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userRepo := mocks.NewMockIUserRepository(ctrl)
+	finRepo := mocks.NewMockIFinancialReportRepository(ctrl)
+	compRepo := mocks.NewMockICompanyRepository(ctrl)
+	actFieldRepo := mocks.NewMockIActivityFieldRepository(ctrl)
+	ownerRepo := mocks.NewMockICompanyOwnerRepository(ctrl)
+	taxRepo := mocks.NewMockITaxScheduleRepository(ctrl)
+	reviewRepo := mocks.NewMockIReviewRepository(ctrl)
+
+	log := logger.NewLogger(logger.InfoLevel, io.Discard)
+	interactor := NewInteractor(
+		user.NewService(userRepo, compRepo, actFieldRepo, log),
+		activity_field.NewService(actFieldRepo, compRepo, log),
+		company.NewService(compRepo, actFieldRepo, ownerRepo, log),
+		fin_report.NewService(finRepo, compRepo, ownerRepo, log),
+		tax_schedule.NewService(taxRepo, log),
+		review.NewService(reviewRepo, userRepo, log),
+		testClock,
+		domain.RatingStrategyCurrent,
+		log,
+	)
+
+	influence := []*domain.ActivityFieldInfluence{
+		{
+			ActivityField: &domain.ActivityField{ID: uuid.UUID{1}},
+			Revenue:       decimal.NewFromInt(250),
+			TotalRevenue:  decimal.NewFromInt(1000),
+			Rank:          1,
+			Participants:  2,
+		},
+	}
+
+	testCases := []struct {
+		name       string
+		period     *domain.Period
+		beforeTest func(userRepo mocks.MockIUserRepository)
+		expected   []*domain.ActivityFieldInfluence
+		wantErr    bool
+		errStr     error
+	}{
+		{
+			name: "по умолчанию - период рейтинга",
+			beforeTest: func(userRepo mocks.MockIUserRepository) {
+				userRepo.EXPECT().
+					GetById(context.Background(), uuid.UUID{1}).
+					Return(&domain.User{ID: uuid.UUID{1}}, nil)
+				userRepo.EXPECT().
+					GetInfluence(context.Background(), uuid.UUID{1}, period2023).
+					Return(influence, nil)
+			},
+			expected: influence,
+		},
+		{
+			name:   "указанный период",
+			period: &domain.Period{StartYear: 2022, StartQuarter: 3, EndYear: 2023, EndQuarter: 2},
+			beforeTest: func(userRepo mocks.MockIUserRepository) {
+				userRepo.EXPECT().
+					GetById(context.Background(), uuid.UUID{1}).
+					Return(&domain.User{ID: uuid.UUID{1}}, nil)
+				userRepo.EXPECT().
+					GetInfluence(
+						context.Background(),
+						uuid.UUID{1},
+						&domain.Period{StartYear: 2022, StartQuarter: 3, EndYear: 2023, EndQuarter: 2},
+					).
+					Return(influence, nil)
+			},
+			expected: influence,
+		},
+		{
+			name:    "конец периода раньше начала",
+			period:  &domain.Period{StartYear: 2023, StartQuarter: 4, EndYear: 2023, EndQuarter: 1},
+			wantErr: true,
+			errStr: errors.New("получение влияния в сферах деятельности: " +
+				"дата конца периода должна быть позже даты начала"),
+		},
+		{
+			name: "пользователь не найден",
+			beforeTest: func(userRepo mocks.MockIUserRepository) {
+				userRepo.EXPECT().
+					GetById(context.Background(), uuid.UUID{1}).
+					Return(nil, fmt.Errorf("получение пользователя по id: %w", domain.ErrUserNotFound))
+			},
+			wantErr: true,
+			errStr: errors.New("получение влияния в сферах деятельности: " +
+				"получение пользователя по id: пользователь не найден"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.beforeTest != nil {
+				tc.beforeTest(*userRepo)
+			}
+
+			influence, err := interactor.GetUserInfluence(context.Background(), uuid.UUID{1}, tc.period)
+
+			if tc.wantErr {
+				require.Equal(t, tc.errStr.Error(), err.Error())
+			} else {
+				require.Nil(t, err)
+				require.Equal(t, tc.expected, influence)
+			}
+		})
+	}
+}
+
+func TestInteractor_ResolvePeriod(t *testing.T) {
+	testCases := []struct {
+		name     string
+		now      time.Time
+		mode     string
+		expected *domain.Period
+		wantErr  bool
+		errStr   error
+	}{
+		{
+			name:     "по умолчанию - предыдущий год",
+			now:      time.Time(testClock),
+			expected: period2023,
+		},
+		{
+			name:     "четыре последних квартала",
+			now:      time.Time(testClock),
+			mode:     domain.PeriodTrailingYear,
+			expected: &domain.Period{StartYear: 2023, StartQuarter: 2, EndYear: 2024, EndQuarter: 1},
+		},
+		{
+			name:     "четыре последних квартала в начале года",
+			now:      time.Date(2024, time.February, 1, 0, 0, 0, 0, time.UTC),
+			mode:     domain.PeriodTrailingYear,
+			expected: period2023,
+		},
+		{
+			name:    "неизвестный способ",
+			now:     time.Time(testClock),
+			mode:    "decade",
+			wantErr: true,
+			errStr:  errors.New("неизвестный способ выбора периода: decade"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			interactor := NewInteractor(nil, nil, nil, nil, nil, nil, fixedClock(tc.now), "", logger.NewLogger(logger.InfoLevel, io.Discard))
+
+			period, err := interactor.ResolvePeriod(tc.mode)
+
+			if tc.wantErr {
+				require.Equal(t, tc.errStr.Error(), err.Error())
+			} else {
+				require.Nil(t, err)
+				require.Equal(t, tc.expected, period)
+			}
+		})
+	}
+}
+
+func TestInteractor_ResolveStrategy(t *testing.T) {
+	testCases := []struct {
+		name            string
+		defaultStrategy string
+		strategy        string
+		expected        string
+		wantErr         bool
+		errStr          error
+	}{
+		{
+			name:            "по умолчанию - заданная в конфиге",
+			defaultStrategy: domain.RatingStrategyGrowth,
+			expected:        domain.RatingStrategyGrowth,
+		},
+		{
+			name:            "неизвестная стратегия в конфиге заменяется текущей формулой",
+			defaultStrategy: "magic",
+			expected:        domain.RatingStrategyCurrent,
+		},
+		{
+			name:            "выбранная стратегия",
+			defaultStrategy: domain.RatingStrategyCurrent,
+			strategy:        domain.RatingStrategyReviewWeighted,
+			expected:        domain.RatingStrategyReviewWeighted,
+		},
+		{
+			name:            "неизвестная стратегия",
+			defaultStrategy: domain.RatingStrategyCurrent,
+			strategy:        "magic",
+			wantErr:         true,
+			errStr:          errors.New("неизвестная стратегия вычисления рейтинга: magic"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			interactor := NewInteractor(nil, nil, nil, nil, nil, nil, testClock, tc.defaultStrategy, logger.NewLogger(logger.InfoLevel, io.Discard))
+
+			strategy, err := interactor.ResolveStrategy(tc.strategy)
+
+			if tc.wantErr {
+				require.Equal(t, tc.errStr.Error(), err.Error())
+			} else {
+				require.Nil(t, err)
+				require.Equal(t, tc.expected, strategy)
 			}
 		})
 	}
@@ -233,13 +1004,19 @@ func TestInteractor_GetMostProfitableCompany(t *testing.T) {
 	finRepo := mocks.NewMockIFinancialReportRepository(ctrl)
 	compRepo := mocks.NewMockICompanyRepository(ctrl)
 	actFieldRepo := mocks.NewMockIActivityFieldRepository(ctrl)
+	ownerRepo := mocks.NewMockICompanyOwnerRepository(ctrl)
+	taxRepo := mocks.NewMockITaxScheduleRepository(ctrl)
+	reviewRepo := mocks.NewMockIReviewRepository(ctrl)
 
-	userSvc := user.NewService(userRepo, compRepo, actFieldRepo)
-	actFieldSvc := activity_field.NewService(actFieldRepo, compRepo)
-	compSvc := company.NewService(compRepo)
-	finSvc := fin_report.NewService(finRepo)
+	log := logger.NewLogger(logger.InfoLevel, io.Discard)
+	userSvc := user.NewService(userRepo, compRepo, actFieldRepo, log)
+	actFieldSvc := activity_field.NewService(actFieldRepo, compRepo, log)
+	compSvc := company.NewService(compRepo, actFieldRepo, ownerRepo, log)
+	finSvc := fin_report.NewService(finRepo, compRepo, ownerRepo, log)
+	taxSvc := tax_schedule.NewService(taxRepo, log)
+	reviewSvc := review.NewService(reviewRepo, userRepo, log)
 
-	interactor := NewInteractor(userSvc, actFieldSvc, compSvc, finSvc)
+	interactor := NewInteractor(userSvc, actFieldSvc, compSvc, finSvc, taxSvc, reviewSvc, testClock, domain.RatingStrategyCurrent, log)
 
 	testCases := []struct {
 		name       string
@@ -283,32 +1060,32 @@ func TestInteractor_GetMostProfitableCompany(t *testing.T) {
 							{
 								ID:        uuid.UUID{1},
 								CompanyID: uuid.UUID{1},
-								Revenue:   100,
-								Costs:     50,
+								Revenue:   decimal.NewFromInt(100),
+								Costs:     decimal.NewFromInt(50),
 								Year:      2023,
 								Quarter:   1,
 							},
 							{
 								ID:        uuid.UUID{2},
 								CompanyID: uuid.UUID{1},
-								Revenue:   100,
-								Costs:     50,
+								Revenue:   decimal.NewFromInt(100),
+								Costs:     decimal.NewFromInt(50),
 								Year:      2023,
 								Quarter:   2,
 							},
 							{
 								ID:        uuid.UUID{3},
 								CompanyID: uuid.UUID{1},
-								Revenue:   100,
-								Costs:     50,
+								Revenue:   decimal.NewFromInt(100),
+								Costs:     decimal.NewFromInt(50),
 								Year:      2023,
 								Quarter:   3,
 							},
 							{
 								ID:        uuid.UUID{4},
 								CompanyID: uuid.UUID{1},
-								Revenue:   100,
-								Costs:     50,
+								Revenue:   decimal.NewFromInt(100),
+								Costs:     decimal.NewFromInt(50),
 								Year:      2023,
 								Quarter:   4,
 							},
@@ -331,32 +1108,32 @@ func TestInteractor_GetMostProfitableCompany(t *testing.T) {
 							{
 								ID:        uuid.UUID{5},
 								CompanyID: uuid.UUID{2},
-								Revenue:   75,
-								Costs:     50,
+								Revenue:   decimal.NewFromInt(75),
+								Costs:     decimal.NewFromInt(50),
 								Year:      2023,
 								Quarter:   1,
 							},
 							{
 								ID:        uuid.UUID{6},
 								CompanyID: uuid.UUID{2},
-								Revenue:   75,
-								Costs:     50,
+								Revenue:   decimal.NewFromInt(75),
+								Costs:     decimal.NewFromInt(50),
 								Year:      2023,
 								Quarter:   2,
 							},
 							{
 								ID:        uuid.UUID{7},
 								CompanyID: uuid.UUID{2},
-								Revenue:   75,
-								Costs:     50,
+								Revenue:   decimal.NewFromInt(75),
+								Costs:     decimal.NewFromInt(50),
 								Year:      2023,
 								Quarter:   3,
 							},
 							{
 								ID:        uuid.UUID{8},
 								CompanyID: uuid.UUID{2},
-								Revenue:   75,
-								Costs:     50,
+								Revenue:   decimal.NewFromInt(75),
+								Costs:     decimal.NewFromInt(50),
 								Year:      2023,
 								Quarter:   4,
 							},
@@ -396,13 +1173,19 @@ func TestInteractor_GetUserFinancialReport(t *testing.T) {
 	finRepo := mocks.NewMockIFinancialReportRepository(ctrl)
 	compRepo := mocks.NewMockICompanyRepository(ctrl)
 	actFieldRepo := mocks.NewMockIActivityFieldRepository(ctrl)
+	ownerRepo := mocks.NewMockICompanyOwnerRepository(ctrl)
+	taxRepo := mocks.NewMockITaxScheduleRepository(ctrl)
+	reviewRepo := mocks.NewMockIReviewRepository(ctrl)
 
-	userSvc := user.NewService(userRepo, compRepo, actFieldRepo)
-	actFieldSvc := activity_field.NewService(actFieldRepo, compRepo)
-	compSvc := company.NewService(compRepo)
-	finSvc := fin_report.NewService(finRepo)
+	log := logger.NewLogger(logger.InfoLevel, io.Discard)
+	userSvc := user.NewService(userRepo, compRepo, actFieldRepo, log)
+	actFieldSvc := activity_field.NewService(actFieldRepo, compRepo, log)
+	compSvc := company.NewService(compRepo, actFieldRepo, ownerRepo, log)
+	finSvc := fin_report.NewService(finRepo, compRepo, ownerRepo, log)
+	taxSvc := tax_schedule.NewService(taxRepo, log)
+	reviewSvc := review.NewService(reviewRepo, userRepo, log)
 
-	interactor := NewInteractor(userSvc, actFieldSvc, compSvc, finSvc)
+	interactor := NewInteractor(userSvc, actFieldSvc, compSvc, finSvc, taxSvc, reviewSvc, testClock, domain.RatingStrategyCurrent, log)
 
 	testCases := []struct {
 		name       string
@@ -412,6 +1195,8 @@ func TestInteractor_GetUserFinancialReport(t *testing.T) {
 			finRepo mocks.MockIFinancialReportRepository,
 			compRepo mocks.MockICompanyRepository,
 			actFieldRepo mocks.MockIActivityFieldRepository,
+			ownerRepo mocks.MockICompanyOwnerRepository,
+			taxRepo mocks.MockITaxScheduleRepository,
 		)
 		period   *domain.Period
 		expected *domain.FinancialReportByPeriod
@@ -421,24 +1206,14 @@ func TestInteractor_GetUserFinancialReport(t *testing.T) {
 		{
 			name:   "успешный тест",
 			userId: uuid.UUID{1},
-			beforeTest: func(userRepo mocks.MockIUserRepository, finRepo mocks.MockIFinancialReportRepository, compRepo mocks.MockICompanyRepository, actFieldRepo mocks.MockIActivityFieldRepository) {
-				compRepo.EXPECT().
-					GetByOwnerId(context.Background(), uuid.UUID{1}, 0, false).
-					Return(
-						[]*domain.Company{
-							{
-								ID:      uuid.UUID{1},
-								OwnerID: uuid.UUID{1},
-								Name:    "a",
-								City:    "a",
-							},
-							{
-								ID:      uuid.UUID{2},
-								OwnerID: uuid.UUID{1},
-								Name:    "b",
-								City:    "b",
-							},
-						}, nil)
+			beforeTest: func(userRepo mocks.MockIUserRepository, finRepo mocks.MockIFinancialReportRepository, compRepo mocks.MockICompanyRepository, actFieldRepo mocks.MockIActivityFieldRepository, ownerRepo mocks.MockICompanyOwnerRepository, taxRepo mocks.MockITaxScheduleRepository) {
+				ownerRepo.EXPECT().
+					GetAcceptedByUserId(context.Background(), uuid.UUID{1}).
+					Return(ownerships(uuid.UUID{1}, uuid.UUID{1}, uuid.UUID{2}), nil)
+
+				taxRepo.EXPECT().
+					GetByYear(context.Background(), 2023, domain.DefaultTaxRegime).
+					Return(flatTaxSchedule(2023, 4), nil)
 
 				finRepo.EXPECT().
 					GetByCompany(
@@ -456,40 +1231,40 @@ func TestInteractor_GetUserFinancialReport(t *testing.T) {
 							{
 								ID:        uuid.UUID{1},
 								CompanyID: uuid.UUID{1},
-								Revenue:   100,
-								Costs:     50,
+								Revenue:   decimal.NewFromInt(100),
+								Costs:     decimal.NewFromInt(50),
 								Year:      2023,
 								Quarter:   1,
 							},
 							{
 								ID:        uuid.UUID{2},
 								CompanyID: uuid.UUID{1},
-								Revenue:   100,
-								Costs:     50,
+								Revenue:   decimal.NewFromInt(100),
+								Costs:     decimal.NewFromInt(50),
 								Year:      2023,
 								Quarter:   2,
 							},
 							{
 								ID:        uuid.UUID{3},
 								CompanyID: uuid.UUID{1},
-								Revenue:   100,
-								Costs:     50,
+								Revenue:   decimal.NewFromInt(100),
+								Costs:     decimal.NewFromInt(50),
 								Year:      2023,
 								Quarter:   3,
 							},
 							{
 								ID:        uuid.UUID{4},
 								CompanyID: uuid.UUID{1},
-								Revenue:   100,
-								Costs:     50,
+								Revenue:   decimal.NewFromInt(100),
+								Costs:     decimal.NewFromInt(50),
 								Year:      2023,
 								Quarter:   4,
 							},
 							{
 								ID:        uuid.UUID{5},
 								CompanyID: uuid.UUID{1},
-								Revenue:   100,
-								Costs:     50,
+								Revenue:   decimal.NewFromInt(100),
+								Costs:     decimal.NewFromInt(50),
 								Year:      2024,
 								Quarter:   1,
 							},
@@ -518,40 +1293,40 @@ func TestInteractor_GetUserFinancialReport(t *testing.T) {
 							{
 								ID:        uuid.UUID{6},
 								CompanyID: uuid.UUID{2},
-								Revenue:   75,
-								Costs:     50,
+								Revenue:   decimal.NewFromInt(75),
+								Costs:     decimal.NewFromInt(50),
 								Year:      2023,
 								Quarter:   1,
 							},
 							{
 								ID:        uuid.UUID{7},
 								CompanyID: uuid.UUID{2},
-								Revenue:   75,
-								Costs:     50,
+								Revenue:   decimal.NewFromInt(75),
+								Costs:     decimal.NewFromInt(50),
 								Year:      2023,
 								Quarter:   2,
 							},
 							{
 								ID:        uuid.UUID{8},
 								CompanyID: uuid.UUID{2},
-								Revenue:   75,
-								Costs:     50,
+								Revenue:   decimal.NewFromInt(75),
+								Costs:     decimal.NewFromInt(50),
 								Year:      2023,
 								Quarter:   3,
 							},
 							{
 								ID:        uuid.UUID{9},
 								CompanyID: uuid.UUID{2},
-								Revenue:   75,
-								Costs:     50,
+								Revenue:   decimal.NewFromInt(75),
+								Costs:     decimal.NewFromInt(50),
 								Year:      2023,
 								Quarter:   4,
 							},
 							{
 								ID:        uuid.UUID{10},
 								CompanyID: uuid.UUID{2},
-								Revenue:   75,
-								Costs:     50,
+								Revenue:   decimal.NewFromInt(75),
+								Costs:     decimal.NewFromInt(50),
 								Year:      2024,
 								Quarter:   1,
 							},
@@ -575,80 +1350,80 @@ func TestInteractor_GetUserFinancialReport(t *testing.T) {
 					{
 						ID:        uuid.UUID{1},
 						CompanyID: uuid.UUID{1},
-						Revenue:   100,
-						Costs:     50,
+						Revenue:   decimal.NewFromInt(100),
+						Costs:     decimal.NewFromInt(50),
 						Year:      2023,
 						Quarter:   1,
 					},
 					{
 						ID:        uuid.UUID{2},
 						CompanyID: uuid.UUID{1},
-						Revenue:   100,
-						Costs:     50,
+						Revenue:   decimal.NewFromInt(100),
+						Costs:     decimal.NewFromInt(50),
 						Year:      2023,
 						Quarter:   2,
 					},
 					{
 						ID:        uuid.UUID{3},
 						CompanyID: uuid.UUID{1},
-						Revenue:   100,
-						Costs:     50,
+						Revenue:   decimal.NewFromInt(100),
+						Costs:     decimal.NewFromInt(50),
 						Year:      2023,
 						Quarter:   3,
 					},
 					{
 						ID:        uuid.UUID{4},
 						CompanyID: uuid.UUID{1},
-						Revenue:   100,
-						Costs:     50,
+						Revenue:   decimal.NewFromInt(100),
+						Costs:     decimal.NewFromInt(50),
 						Year:      2023,
 						Quarter:   4,
 					},
 					{
 						ID:        uuid.UUID{5},
 						CompanyID: uuid.UUID{1},
-						Revenue:   100,
-						Costs:     50,
+						Revenue:   decimal.NewFromInt(100),
+						Costs:     decimal.NewFromInt(50),
 						Year:      2024,
 						Quarter:   1,
 					},
 					{
 						ID:        uuid.UUID{6},
 						CompanyID: uuid.UUID{2},
-						Revenue:   75,
-						Costs:     50,
+						Revenue:   decimal.NewFromInt(75),
+						Costs:     decimal.NewFromInt(50),
 						Year:      2023,
 						Quarter:   1,
 					},
 					{
 						ID:        uuid.UUID{7},
 						CompanyID: uuid.UUID{2},
-						Revenue:   75,
-						Costs:     50,
+						Revenue:   decimal.NewFromInt(75),
+						Costs:     decimal.NewFromInt(50),
 						Year:      2023,
 						Quarter:   2,
 					},
 					{
 						ID:        uuid.UUID{8},
 						CompanyID: uuid.UUID{2},
-						Revenue:   75,
-						Costs:     50,
+						Revenue:   decimal.NewFromInt(75),
+						Costs:     decimal.NewFromInt(50),
 						Year:      2023,
 						Quarter:   3,
 					},
 					{
 						ID:        uuid.UUID{9},
 						CompanyID: uuid.UUID{2},
-						Revenue:   75,
-						Costs:     50,
+						Revenue:   decimal.NewFromInt(75),
+						Costs:     decimal.NewFromInt(50),
 						Year:      2023,
 						Quarter:   4,
 					},
 					{
 						ID:        uuid.UUID{10},
 						CompanyID: uuid.UUID{2},
-						Revenue:   75,
-						Costs:     50,
+						Revenue:   decimal.NewFromInt(75),
+						Costs:     decimal.NewFromInt(50),
 						Year:      2024,
 						Quarter:   1,
 					},
@@ -659,8 +1434,8 @@ func TestInteractor_GetUserFinancialReport(t *testing.T) {
 					StartQuarter: 1,
 					EndQuarter:   1,
 				},
-				Taxes:   float32(((100 - 50) + (75 - 50)) * 4 * 0.04),
-				TaxLoad: float32((((100 - 50) + (75 - 50)) * 4 * 0.04) / ((100 + 75) * 4) * 100),
+				Taxes:   decimal.NewFromInt(12),
+				TaxLoad: decimal.RequireFromString("1.71"),
 			},
 			wantErr: false,
 		},
@@ -669,7 +1444,7 @@ func TestInteractor_GetUserFinancialReport(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			ctx := context.Background()
 			if tc.beforeTest != nil {
-				tc.beforeTest(*userRepo, *finRepo, *compRepo, *actFieldRepo)
+				tc.beforeTest(*userRepo, *finRepo, *compRepo, *actFieldRepo, *ownerRepo, *taxRepo)
 			}
 
 			report, err := interactor.GetUserFinancialReport(ctx, tc.userId, tc.period)
@@ -678,36 +1453,137 @@ func TestInteractor_GetUserFinancialReport(t *testing.T) {
 				require.Equal(t, tc.errStr.Error(), err.Error())
 			} else {
 				require.Nil(t, err)
-				require.Equal(t, tc.expected.Reports, report.Reports)
+				require.Len(t, report.Reports, len(tc.expected.Reports))
+				for idx, expReport := range tc.expected.Reports {
+					require.Equal(t, expReport.ID, report.Reports[idx].ID)
+					require.True(t, expReport.Revenue.Equal(report.Reports[idx].Revenue))
+					require.True(t, expReport.Costs.Equal(report.Reports[idx].Costs))
+				}
 				require.Equal(t, tc.expected.Period, report.Period)
-				require.InEpsilon(t, tc.expected.Taxes, report.Taxes, eps)
-				require.InEpsilon(t, tc.expected.TaxLoad, report.TaxLoad, eps)
+				require.True(t, tc.expected.Taxes.Equal(report.Taxes))
+				require.True(t, tc.expected.TaxLoad.Equal(report.TaxLoad))
 			}
 		})
 	}
 }
 
+// Предприниматель владеет 40% первой компании и 60% второй: отчет, налоги и рейтинг считаются
+// пропорционально долям.
+func TestInteractor_PartialShares(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userRepo := mocks.NewMockIUserRepository(ctrl)
+	finRepo := mocks.NewMockIFinancialReportRepository(ctrl)
+	compRepo := mocks.NewMockICompanyRepository(ctrl)
+	actFieldRepo := mocks.NewMockIActivityFieldRepository(ctrl)
+	ownerRepo := mocks.NewMockICompanyOwnerRepository(ctrl)
+	taxRepo := mocks.NewMockITaxScheduleRepository(ctrl)
+	reviewRepo := mocks.NewMockIReviewRepository(ctrl)
+
+	log := logger.NewLogger(logger.InfoLevel, io.Discard)
+	interactor := NewInteractor(
+		user.NewService(userRepo, compRepo, actFieldRepo, log),
+		activity_field.NewService(actFieldRepo, compRepo, log),
+		company.NewService(compRepo, actFieldRepo, ownerRepo, log),
+		fin_report.NewService(finRepo, compRepo, ownerRepo, log),
+		tax_schedule.NewService(taxRepo, log),
+		review.NewService(reviewRepo, userRepo, log),
+		testClock,
+		domain.RatingStrategyCurrent,
+		log,
+	)
+
+	owners := ownerships(uuid.UUID{1}, uuid.UUID{1}, uuid.UUID{2})
+	owners[0].Share = decimal.NewFromInt(40)
+	owners[1].Share = decimal.NewFromInt(60)
+
+	ownerRepo.EXPECT().
+		GetAcceptedByUserId(context.Background(), uuid.UUID{1}).
+		Return(owners, nil).
+		AnyTimes()
+
+	// первая компания: выручка 4000, прибыль 2000; вторая: выручка 8000, прибыль 2000; налог 20%
+	finRepo.EXPECT().
+		GetByCompany(context.Background(), uuid.UUID{1}, period2023).
+		Return(yearReports(uuid.UUID{1}, 2023, 1000, 500), nil).
+		AnyTimes()
+	finRepo.EXPECT().
+		GetByCompany(context.Background(), uuid.UUID{2}, period2023).
+		Return(yearReports(uuid.UUID{2}, 2023, 2000, 1500), nil).
+		AnyTimes()
+	taxRepo.EXPECT().
+		GetByYear(context.Background(), 2023, domain.DefaultTaxRegime).
+		Return(flatTaxSchedule(2023, 20), nil).
+		AnyTimes()
+
+	t.Run("финансовый отчет", func(t *testing.T) {
+		report, err := interactor.GetUserFinancialReport(context.Background(), uuid.UUID{1}, period2023)
+
+		require.Nil(t, err)
+		require.Len(t, report.Reports, 8)
+		require.True(t, decimal.NewFromInt(400).Equal(report.Reports[0].Revenue))
+		require.True(t, decimal.NewFromInt(1200).Equal(report.Reports[4].Revenue))
+		// 40% от 4000 и 60% от 8000
+		require.True(t, decimal.NewFromInt(6400).Equal(report.Revenue()))
+		require.True(t, decimal.NewFromInt(2000).Equal(report.Profit()))
+		// 40% от налога 400 и 60% от налога 400
+		require.True(t, decimal.NewFromInt(400).Equal(report.Taxes))
+		require.True(t, decimal.RequireFromString("6.25").Equal(report.TaxLoad))
+	})
+
+	t.Run("рейтинг", func(t *testing.T) {
+		// наиболее прибыльна для предпринимателя вторая компания: 60% от 2000 больше 40% от 2000
+		compRepo.EXPECT().
+			GetById(context.Background(), uuid.UUID{2}).
+			Return(&domain.Company{ID: uuid.UUID{2}, ActivityFieldId: uuid.UUID{2}}, nil).
+			Times(2)
+		actFieldRepo.EXPECT().
+			GetById(context.Background(), uuid.UUID{2}).
+			Return(&domain.ActivityField{ID: uuid.UUID{2}, Cost: decimal.NewFromInt(5)}, nil)
+		actFieldRepo.EXPECT().
+			GetMaxCost(context.Background()).
+			Return(decimal.NewFromInt(10), nil)
+
+		breakdown, err := interactor.CalculateUserRating(context.Background(), uuid.UUID{1}, period2023, "")
+
+		require.Nil(t, err)
+		require.Equal(t, uuid.UUID{2}, breakdown.Company.ID)
+		require.True(t, decimal.NewFromInt(60).Equal(breakdown.Share))
+		require.True(t, decimal.NewFromInt(6400).Equal(breakdown.Revenue))
+		require.True(t, decimal.NewFromInt(2000).Equal(breakdown.Profit))
+		require.InDelta(t, float32(5.0/10.0/2), breakdown.CostContribution, eps)
+		require.InDelta(t, float32(2000.0/6400.0/2), breakdown.ProfitabilityContribution, eps)
+		require.InDelta(t, float32(5.0/10.0/2+2000.0/6400.0/2), breakdown.Rating, eps)
+	})
+}
+
 func Test_calcRating(t *testing.T) {
 	testCases := []struct {
 		name     string
-		profit   float32
-		revenue  float32
-		cost     float32
-		maxCost  float32
+		profit   decimal.Decimal
+		revenue  decimal.Decimal
+		cost     decimal.Decimal
+		maxCost  decimal.Decimal
 		expected float32
 	}{
 		{
 			name:     "успешное вычисление",
-			profit:   100,
-			revenue:  1000,
-			cost:     5.0,
-			maxCost:  13.5,
+			profit:   decimal.NewFromInt(100),
+			revenue:  decimal.NewFromInt(1000),
+			cost:     decimal.NewFromInt(5),
+			maxCost:  decimal.RequireFromString("13.5"),
 			expected: (5.0/13.5 + 100.0/1000.0) / 2.0,
 		},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			rating := calcRating(tc.profit, tc.revenue, tc.cost, tc.maxCost)
+			_, _, rating := calcRating(currentStrategy{}, &domain.RatingInput{
+				Profit:  tc.profit,
+				Revenue: tc.revenue,
+				Cost:    tc.cost,
+				MaxCost: tc.maxCost,
+			})
 
 			require.InEpsilon(t, tc.expected, rating, eps)
 		})
@@ -731,24 +1607,24 @@ func Test_calculateTaxes(t *testing.T) {
 						{
 							ID:        uuid.UUID{1},
 							CompanyID: uuid.UUID{1},
-							Revenue:   12432532,
-							Costs:     3213214,
+							Revenue:   decimal.NewFromInt(12432532),
+							Costs:     decimal.NewFromInt(3213214),
 							Year:      1,
 							Quarter:   2,
 						},
 						{
 							ID:        uuid.UUID{2},
 							CompanyID: uuid.UUID{1},
-							Revenue:   12432532,
-							Costs:     3213214,
+							Revenue:   decimal.NewFromInt(12432532),
+							Costs:     decimal.NewFromInt(3213214),
 							Year:      1,
 							Quarter:   3,
 						},
 						{
 							ID:        uuid.UUID{3},
 							CompanyID: uuid.UUID{1},
-							Revenue:   12432532,
-							Costs:     3213214,
+							Revenue:   decimal.NewFromInt(12432532),
+							Costs:     decimal.NewFromInt(3213214),
 							Year:      1,
 							Quarter:   4,
 						},
@@ -765,32 +1641,32 @@ func Test_calculateTaxes(t *testing.T) {
 						{
 							ID:        uuid.UUID{4},
 							CompanyID: uuid.UUID{1},
-							Revenue:   12432532,
-							Costs:     3213214,
+							Revenue:   decimal.NewFromInt(12432532),
+							Costs:     decimal.NewFromInt(3213214),
 							Year:      2,
 							Quarter:   1,
 						},
 						{
 							ID:        uuid.UUID{5},
 							CompanyID: uuid.UUID{1},
-							Revenue:   12432532,
-							Costs:     3213214,
+							Revenue:   decimal.NewFromInt(12432532),
+							Costs:     decimal.NewFromInt(3213214),
 							Year:      2,
 							Quarter:   2,
 						},
 						{
 							ID:        uuid.UUID{6},
 							CompanyID: uuid.UUID{1},
-							Revenue:   12432532,
-							Costs:     3213214,
+							Revenue:   decimal.NewFromInt(12432532),
+							Costs:     decimal.NewFromInt(3213214),
 							Year:      2,
 							Quarter:   3,
 						},
 						{
 							ID:        uuid.UUID{7},
 							CompanyID: uuid.UUID{1},
-							Revenue:   12432532,
-							Costs:     3213214,
+							Revenue:   decimal.NewFromInt(12432532),
+							Costs:     decimal.NewFromInt(3213214),
 							Year:      2,
 							Quarter:   4,
 						},
@@ -804,17 +1680,17 @@ func Test_calculateTaxes(t *testing.T) {
 				},
 			},
 			expected: &taxesData{
-				taxes:   (12432532 - 3213214) * 4.0 * 0.07,
-				revenue: 12432532 * 4,
+				taxes:   decimal.NewFromInt((12432532 - 3213214) * 4 * 7).Div(decimal.NewFromInt(100)),
+				revenue: decimal.NewFromInt(12432532 * 4),
 			},
 		},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			tax := calculateTaxes(tc.reports)
+			tax := calculateTaxes(tc.reports, map[int]*domain.TaxSchedule{1: flatTaxSchedule(1, 7), 2: flatTaxSchedule(2, 7)})
 
-			require.InEpsilon(t, tc.expected.taxes, tax.taxes, eps)
-			require.InEpsilon(t, tc.expected.revenue, tax.revenue, eps)
+			require.True(t, tc.expected.taxes.Equal(tax.taxes))
+			require.True(t, tc.expected.revenue.Equal(tax.revenue))
 		})
 	}
 }
@@ -833,56 +1709,56 @@ func Test_findFullYearReports(t *testing.T) {
 					{
 						ID:        uuid.UUID{1},
 						CompanyID: uuid.UUID{1},
-						Revenue:   12432532,
-						Costs:     3213214,
+						Revenue:   decimal.NewFromInt(12432532),
+						Costs:     decimal.NewFromInt(3213214),
 						Year:      1,
 						Quarter:   2,
 					},
 					{
 						ID:        uuid.UUID{2},
 						CompanyID: uuid.UUID{1},
-						Revenue:   12432532,
-						Costs:     3213214,
+						Revenue:   decimal.NewFromInt(12432532),
+						Costs:     decimal.NewFromInt(3213214),
 						Year:      1,
 						Quarter:   3,
 					},
 					{
 						ID:        uuid.UUID{3},
 						CompanyID: uuid.UUID{1},
-						Revenue:   12432532,
-						Costs:     3213214,
+						Revenue:   decimal.NewFromInt(12432532),
+						Costs:     decimal.NewFromInt(3213214),
 						Year:      1,
 						Quarter:   4,
 					},
 					{
 						ID:        uuid.UUID{4},
 						CompanyID: uuid.UUID{1},
-						Revenue:   12432532,
-						Costs:     3213214,
+						Revenue:   decimal.NewFromInt(12432532),
+						Costs:     decimal.NewFromInt(3213214),
 						Year:      2,
 						Quarter:   1,
 					},
 					{
 						ID:        uuid.UUID{5},
 						CompanyID: uuid.UUID{1},
-						Revenue:   12432532,
-						Costs:     3213214,
+						Revenue:   decimal.NewFromInt(12432532),
+						Costs:     decimal.NewFromInt(3213214),
 						Year:      2,
 						Quarter:   2,
 					},
 					{
 						ID:        uuid.UUID{6},
 						CompanyID: uuid.UUID{1},
-						Revenue:   12432532,
-						Costs:     3213214,
+						Revenue:   decimal.NewFromInt(12432532),
+						Costs:     decimal.NewFromInt(3213214),
 						Year:      2,
 						Quarter:   3,
 					},
 					{
 						ID:        uuid.UUID{7},
 						CompanyID: uuid.UUID{1},
-						Revenue:   12432532,
-						Costs:     3213214,
+						Revenue:   decimal.NewFromInt(12432532),
+						Costs:     decimal.NewFromInt(3213214),
 						Year:      2,
 						Quarter:   4,
 					},
@@ -906,32 +1782,32 @@ func Test_findFullYearReports(t *testing.T) {
 						{
 							ID:        uuid.UUID{4},
 							CompanyID: uuid.UUID{1},
-							Revenue:   12432532,
-							Costs:     3213214,
+							Revenue:   decimal.NewFromInt(12432532),
+							Costs:     decimal.NewFromInt(3213214),
 							Year:      2,
 							Quarter:   1,
 						},
 						{
 							ID:        uuid.UUID{5},
 							CompanyID: uuid.UUID{1},
-							Revenue:   12432532,
-							Costs:     3213214,
+							Revenue:   decimal.NewFromInt(12432532),
+							Costs:     decimal.NewFromInt(3213214),
 							Year:      2,
 							Quarter:   2,
 						},
 						{
 							ID:        uuid.UUID{6},
 							CompanyID: uuid.UUID{1},
-							Revenue:   12432532,
-							Costs:     3213214,
+							Revenue:   decimal.NewFromInt(12432532),
+							Costs:     decimal.NewFromInt(3213214),
 							Year:      2,
 							Quarter:   3,
 						},
 						{
 							ID:        uuid.UUID{7},
 							CompanyID: uuid.UUID{1},
-							Revenue:   12432532,
-							Costs:     3213214,
+							Revenue:   decimal.NewFromInt(12432532),
+							Costs:     decimal.NewFromInt(3213214),
 							Year:      2,
 							Quarter:   4,
 						},
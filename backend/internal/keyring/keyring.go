@@ -0,0 +1,176 @@
+package keyring
+
+import (
+	"context"
+	"fmt"
+	"ppo/domain"
+	"ppo/pkg/base"
+	"ppo/pkg/logger"
+	"sync"
+	"time"
+)
+
+const (
+	defaultRotationPeriod = 30 * 24 * time.Hour
+	// если срок жизни токенов не задан, выведенный из оборота ключ проверяет токены с запасом
+	defaultVerificationPeriod = time.Hour
+)
+
+// Keyring держит в памяти ключи подписи JWT из хранилища и ротирует их по расписанию.
+// Ключ, выведенный из оборота, остается пригодным для проверки, пока не истекут подписанные им токены.
+type Keyring struct {
+	repo               domain.ISigningKeyRepository
+	algorithm          string
+	rotationPeriod     time.Duration
+	verificationPeriod time.Duration
+	logger             logger.ILogger
+
+	mu               sync.RWMutex
+	signing          *base.JwtKey
+	signingCreatedAt time.Time
+	verification     []*base.JwtKey
+}
+
+func NewKeyring(
+	repo domain.ISigningKeyRepository,
+	algorithm string,
+	rotationPeriod time.Duration,
+	tokenTTL time.Duration,
+	logger logger.ILogger,
+) *Keyring {
+	if rotationPeriod == 0 {
+		rotationPeriod = defaultRotationPeriod
+	}
+	if tokenTTL == 0 {
+		tokenTTL = defaultVerificationPeriod
+	}
+
+	return &Keyring{
+		repo:               repo,
+		algorithm:          algorithm,
+		rotationPeriod:     rotationPeriod,
+		verificationPeriod: tokenTTL,
+		logger:             logger,
+	}
+}
+
+func (k *Keyring) SigningKey() (key *base.JwtKey, err error) {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+
+	if k.signing == nil {
+		return nil, fmt.Errorf("нет действующего ключа подписи")
+	}
+
+	return k.signing, nil
+}
+
+func (k *Keyring) VerificationKeys() (keys []*base.JwtKey) {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+
+	return k.verification
+}
+
+// Load перечитывает ключи из хранилища: так экземпляр узнает о ротации, выполненной другим.
+func (k *Keyring) Load(ctx context.Context) (err error) {
+	stored, err := k.repo.GetValid(ctx)
+	if err != nil {
+		return fmt.Errorf("получение ключей подписи: %w", err)
+	}
+
+	var signing *base.JwtKey
+	var signingCreatedAt time.Time
+	verification := make([]*base.JwtKey, 0, len(stored))
+	for _, s := range stored {
+		key, err := base.ParseJwtPrivateKey(s.ID, s.Algorithm, s.PrivateKey)
+		if err != nil {
+			return err
+		}
+
+		if s.RetiredAt.IsZero() && signing == nil {
+			signing = key
+			signingCreatedAt = s.CreatedAt
+		}
+		verification = append(verification, key)
+	}
+
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	k.signing = signing
+	k.signingCreatedAt = signingCreatedAt
+	k.verification = verification
+
+	return nil
+}
+
+// Rotate заменяет ключ подписи, если он старше периода ротации или создан для другого алгоритма,
+// после чего перечитывает ключи.
+func (k *Keyring) Rotate(ctx context.Context) (err error) {
+	prompt := "KeyringRotate"
+
+	// генерация ключа RSA заметно нагружает процессор, поэтому без нужды ключ не создается;
+	// окончательное решение принимается в хранилище под блокировкой
+	if !k.needsRotation() {
+		return k.Load(ctx)
+	}
+
+	key, err := base.GenerateJwtKey(k.algorithm)
+	if err != nil {
+		return err
+	}
+
+	der, err := base.MarshalJwtPrivateKey(key)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	rotated, err := k.repo.Rotate(
+		ctx,
+		&domain.SigningKey{
+			ID:         key.ID,
+			Algorithm:  key.Algorithm,
+			PrivateKey: der,
+		},
+		now.Add(-k.rotationPeriod),
+		now.Add(k.verificationPeriod),
+	)
+	if err != nil {
+		return fmt.Errorf("ротация ключа подписи: %w", err)
+	}
+
+	if rotated {
+		k.logger.Infof("%s: новый ключ подписи %s (%s)", prompt, key.ID, key.Algorithm)
+	}
+
+	return k.Load(ctx)
+}
+
+func (k *Keyring) needsRotation() bool {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+
+	return k.signing == nil ||
+		k.signing.Algorithm != k.algorithm ||
+		time.Since(k.signingCreatedAt) >= k.rotationPeriod
+}
+
+// Run проверяет необходимость ротации с интервалом interval до отмены контекста.
+func (k *Keyring) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			err := k.Rotate(ctx)
+			if err != nil {
+				k.logger.Errorf("KeyringRun: %v", err)
+			}
+		}
+	}
+}
@@ -0,0 +1,48 @@
+package notifier
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"ppo/domain"
+	"sync"
+	"time"
+)
+
+// FileNotifier дописывает уведомления в файл вместо отправки по почте: подходит для
+// разработки и окружений без доступа к почтовому серверу.
+type FileNotifier struct {
+	mu   sync.Mutex
+	path string
+}
+
+func NewFileNotifier(path string) domain.INotifier {
+	return &FileNotifier{
+		path: path,
+	}
+}
+
+func (n *FileNotifier) Notify(ctx context.Context, msg *domain.Notification) (err error) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	err = os.MkdirAll(filepath.Dir(n.path), 0755)
+	if err != nil {
+		return fmt.Errorf("создание директории для уведомлений: %w", err)
+	}
+
+	f, err := os.OpenFile(n.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return fmt.Errorf("открытие файла уведомлений: %w", err)
+	}
+	defer f.Close()
+
+	_, err = fmt.Fprintf(f, "[%s] кому: %s\nтема: %s\n%s\n\n",
+		time.Now().Format(time.RFC3339), msg.Recipient, msg.Subject, msg.Body)
+	if err != nil {
+		return fmt.Errorf("запись уведомления: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,154 @@
+package rating_snapshotter
+
+import (
+	"context"
+	"fmt"
+	"ppo/domain"
+	"ppo/pkg/base"
+	"ppo/pkg/logger"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Snapshotter по итогам каждого завершенного квартала сохраняет рейтинги всех предпринимателей,
+// вычисленные за четыре квартала, последний из которых - завершенный.
+type Snapshotter struct {
+	interactor      domain.IInteractor
+	userService     domain.IUserService
+	snapshotService domain.IRatingSnapshotService
+	clock           base.IClock
+	logger          logger.ILogger
+}
+
+func NewSnapshotter(
+	interactor domain.IInteractor,
+	userSvc domain.IUserService,
+	snapshotSvc domain.IRatingSnapshotService,
+	clock base.IClock,
+	logger logger.ILogger,
+) *Snapshotter {
+	return &Snapshotter{
+		interactor:      interactor,
+		userService:     userSvc,
+		snapshotService: snapshotSvc,
+		clock:           clock,
+		logger:          logger,
+	}
+}
+
+// Snapshot вычисляет стратегией strategy и сохраняет рейтинги всех предпринимателей
+// по итогам квартала quarter года year. Ошибка у одного предпринимателя не мешает сохранить
+// рейтинги остальных; квартал отмечается сохраненным, только если сохранены рейтинги всех.
+func (s *Snapshotter) Snapshot(ctx context.Context, year, quarter int, strategy string) (err error) {
+	prompt := "RatingSnapshotterSnapshot"
+
+	period := domain.TrailingYear(year, quarter)
+
+	ids, err := s.userService.GetEntrepreneurIds(ctx)
+	if err != nil {
+		return err
+	}
+
+	var failed int
+	for _, id := range ids {
+		err = s.snapshotUser(ctx, id, year, quarter, period, strategy)
+		if err != nil {
+			s.logger.Errorf("%s: %v", prompt, err)
+			failed++
+		}
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("не сохранены рейтинги %d из %d предпринимателей", failed, len(ids))
+	}
+
+	err = s.snapshotService.CompleteQuarter(ctx, year, quarter, strategy)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *Snapshotter) snapshotUser(ctx context.Context, userId uuid.UUID, year, quarter int, period *domain.Period, strategy string) (err error) {
+	breakdown, err := s.interactor.CalculateUserRating(ctx, userId, period, strategy)
+	if err != nil {
+		return fmt.Errorf("вычисление рейтинга предпринимателя %s: %w", userId, err)
+	}
+
+	err = s.snapshotService.Save(ctx, domain.NewRatingSnapshot(userId, year, quarter, breakdown))
+	if err != nil {
+		return fmt.Errorf("сохранение рейтинга предпринимателя %s: %w", userId, err)
+	}
+
+	return nil
+}
+
+// SnapshotLastQuarter сохраняет рейтинги, вычисленные стратегией по умолчанию, за все кварталы после последнего
+// сохраненного вплоть до последнего завершенного. Если сохраненных кварталов еще нет, сохраняется только
+// последний завершенный. Кварталы сохраняются по порядку, и ошибка прерывает сохранение: иначе
+// несохраненный квартал оказался бы раньше последнего сохраненного и больше не сохранялся.
+func (s *Snapshotter) SnapshotLastQuarter(ctx context.Context) (err error) {
+	prompt := "RatingSnapshotterSnapshotLastQuarter"
+
+	lastYear, lastQuarter := domain.LastCompletedQuarter(s.clock.Now())
+
+	strategy, err := s.interactor.ResolveStrategy("")
+	if err != nil {
+		return err
+	}
+
+	year, quarter, err := s.snapshotService.GetLastCompletedQuarter(ctx, strategy)
+	if err != nil {
+		return err
+	}
+
+	if year == 0 {
+		year, quarter = lastYear, lastQuarter-1
+	}
+
+	for {
+		quarter++
+		if quarter > 4 {
+			year++
+			quarter = 1
+		}
+
+		if year > lastYear || (year == lastYear && quarter > lastQuarter) {
+			return nil
+		}
+
+		err = s.Snapshot(ctx, year, quarter, strategy)
+		if err != nil {
+			return fmt.Errorf("сохранение рейтингов за %d квартал %d года: %w", quarter, year, err)
+		}
+
+		s.logger.Infof("%s: сохранены рейтинги за %d квартал %d года", prompt, quarter, year)
+	}
+}
+
+// Run сразу и затем с интервалом interval проверяет, сохранены ли рейтинги за все завершенные кварталы,
+// до отмены контекста. Квартал, рейтинги за который сохранены не для всех предпринимателей, не отмечается
+// сохраненным, поэтому при следующей проверке сохраняется заново.
+func (s *Snapshotter) Run(ctx context.Context, interval time.Duration) {
+	err := s.SnapshotLastQuarter(ctx)
+	if err != nil {
+		s.logger.Errorf("RatingSnapshotterRun: %v", err)
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			err = s.SnapshotLastQuarter(ctx)
+			if err != nil {
+				s.logger.Errorf("RatingSnapshotterRun: %v", err)
+			}
+		}
+	}
+}
@@ -0,0 +1,366 @@
+package rating_snapshotter
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"io"
+	"ppo/domain"
+	"ppo/mocks"
+	"ppo/pkg/logger"
+	"testing"
+	"time"
+)
+
+type fixedClock time.Time
+
+func (c fixedClock) Now() time.Time {
+	return time.Time(c)
+}
+
+// 15 мая 2024 года последний завершенный квартал - первый квартал 2024 года
+var testClock = fixedClock(time.Date(2024, time.May, 15, 12, 0, 0, 0, time.UTC))
+
+func TestSnapshotter_SnapshotLastQuarter(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	interactor := mocks.NewMockIInteractor(ctrl)
+	userSvc := mocks.NewMockIUserService(ctrl)
+	snapshotSvc := mocks.NewMockIRatingSnapshotService(ctrl)
+	snapshotter := NewSnapshotter(interactor, userSvc, snapshotSvc, testClock, logger.NewLogger(logger.InfoLevel, io.Discard))
+
+	period := &domain.Period{StartYear: 2023, StartQuarter: 2, EndYear: 2024, EndQuarter: 1}
+
+	testCases := []struct {
+		name       string
+		beforeTest func(
+			interactor mocks.MockIInteractor,
+			userSvc mocks.MockIUserService,
+			snapshotSvc mocks.MockIRatingSnapshotService,
+		)
+		wantErr bool
+		errStr  error
+	}{
+		{
+			name: "рейтинги за квартал уже сохранены",
+			beforeTest: func(interactor mocks.MockIInteractor, userSvc mocks.MockIUserService, snapshotSvc mocks.MockIRatingSnapshotService) {
+				interactor.EXPECT().
+					ResolveStrategy("").
+					Return(domain.RatingStrategyCurrent, nil)
+
+				snapshotSvc.EXPECT().
+					GetLastCompletedQuarter(context.Background(), domain.RatingStrategyCurrent).
+					Return(2024, 1, nil)
+			},
+		},
+		{
+			name: "сохранение рейтингов за квартал",
+			beforeTest: func(interactor mocks.MockIInteractor, userSvc mocks.MockIUserService, snapshotSvc mocks.MockIRatingSnapshotService) {
+				interactor.EXPECT().
+					ResolveStrategy("").
+					Return(domain.RatingStrategyCurrent, nil)
+
+				snapshotSvc.EXPECT().
+					GetLastCompletedQuarter(context.Background(), domain.RatingStrategyCurrent).
+					Return(2023, 4, nil)
+
+				userSvc.EXPECT().
+					GetEntrepreneurIds(context.Background()).
+					Return([]uuid.UUID{{1}, {2}}, nil)
+
+				interactor.EXPECT().
+					CalculateUserRating(context.Background(), uuid.UUID{1}, period, domain.RatingStrategyCurrent).
+					Return(&domain.RatingBreakdown{
+						Strategy:                  domain.RatingStrategyCurrent,
+						Period:                    period,
+						Company:                   &domain.Company{ID: uuid.UUID{5}},
+						Share:                     decimal.NewFromInt(100),
+						Cost:                      decimal.NewFromInt(5),
+						MaxCost:                   decimal.NewFromInt(10),
+						Revenue:                   decimal.NewFromInt(200),
+						Profit:                    decimal.NewFromInt(100),
+						CostContribution:          0.25,
+						ProfitabilityContribution: 0.25,
+						Rating:                    0.5,
+					}, nil)
+
+				interactor.EXPECT().
+					CalculateUserRating(context.Background(), uuid.UUID{2}, period, domain.RatingStrategyCurrent).
+					Return(&domain.RatingBreakdown{Strategy: domain.RatingStrategyCurrent, Period: period}, nil)
+
+				snapshotSvc.EXPECT().
+					Save(context.Background(), &domain.RatingSnapshot{
+						UserID:                    uuid.UUID{1},
+						Year:                      2024,
+						Quarter:                   1,
+						Strategy:                  domain.RatingStrategyCurrent,
+						CompanyID:                 uuid.UUID{5},
+						Share:                     decimal.NewFromInt(100),
+						Cost:                      decimal.NewFromInt(5),
+						MaxCost:                   decimal.NewFromInt(10),
+						Revenue:                   decimal.NewFromInt(200),
+						Profit:                    decimal.NewFromInt(100),
+						CostContribution:          0.25,
+						ProfitabilityContribution: 0.25,
+						Rating:                    0.5,
+					}).
+					Return(nil)
+
+				snapshotSvc.EXPECT().
+					Save(context.Background(), &domain.RatingSnapshot{
+						UserID:   uuid.UUID{2},
+						Year:     2024,
+						Quarter:  1,
+						Strategy: domain.RatingStrategyCurrent,
+					}).
+					Return(nil)
+
+				snapshotSvc.EXPECT().
+					CompleteQuarter(context.Background(), 2024, 1, domain.RatingStrategyCurrent).
+					Return(nil)
+			},
+		},
+		{
+			name: "ошибка вычисления рейтинга одного из предпринимателей",
+			beforeTest: func(interactor mocks.MockIInteractor, userSvc mocks.MockIUserService, snapshotSvc mocks.MockIRatingSnapshotService) {
+				interactor.EXPECT().
+					ResolveStrategy("").
+					Return(domain.RatingStrategyCurrent, nil)
+
+				snapshotSvc.EXPECT().
+					GetLastCompletedQuarter(context.Background(), domain.RatingStrategyCurrent).
+					Return(2023, 4, nil)
+
+				userSvc.EXPECT().
+					GetEntrepreneurIds(context.Background()).
+					Return([]uuid.UUID{{1}, {2}}, nil)
+
+				interactor.EXPECT().
+					CalculateUserRating(context.Background(), uuid.UUID{1}, period, domain.RatingStrategyCurrent).
+					Return(nil, errors.New("sql error"))
+
+				// рейтинг остальных предпринимателей сохраняется, но квартал не отмечается сохраненным
+				interactor.EXPECT().
+					CalculateUserRating(context.Background(), uuid.UUID{2}, period, domain.RatingStrategyCurrent).
+					Return(&domain.RatingBreakdown{Strategy: domain.RatingStrategyCurrent, Period: period}, nil)
+
+				snapshotSvc.EXPECT().
+					Save(context.Background(), &domain.RatingSnapshot{
+						UserID:   uuid.UUID{2},
+						Year:     2024,
+						Quarter:  1,
+						Strategy: domain.RatingStrategyCurrent,
+					}).
+					Return(nil)
+			},
+			wantErr: true,
+			errStr:  errors.New("сохранение рейтингов за 1 квартал 2024 года: не сохранены рейтинги 1 из 2 предпринимателей"),
+		},
+		{
+			name: "сохранение рейтингов за все пропущенные кварталы",
+			beforeTest: func(interactor mocks.MockIInteractor, userSvc mocks.MockIUserService, snapshotSvc mocks.MockIRatingSnapshotService) {
+				interactor.EXPECT().
+					ResolveStrategy("").
+					Return(domain.RatingStrategyCurrent, nil)
+
+				snapshotSvc.EXPECT().
+					GetLastCompletedQuarter(context.Background(), domain.RatingStrategyCurrent).
+					Return(2023, 3, nil)
+
+				userSvc.EXPECT().
+					GetEntrepreneurIds(context.Background()).
+					Return([]uuid.UUID{{1}}, nil).
+					Times(2)
+
+				prevPeriod := &domain.Period{StartYear: 2023, StartQuarter: 1, EndYear: 2023, EndQuarter: 4}
+				gomock.InOrder(
+					interactor.EXPECT().
+						CalculateUserRating(context.Background(), uuid.UUID{1}, prevPeriod, domain.RatingStrategyCurrent).
+						Return(&domain.RatingBreakdown{Strategy: domain.RatingStrategyCurrent, Period: prevPeriod}, nil),
+					snapshotSvc.EXPECT().
+						Save(context.Background(), &domain.RatingSnapshot{
+							UserID:   uuid.UUID{1},
+							Year:     2023,
+							Quarter:  4,
+							Strategy: domain.RatingStrategyCurrent,
+						}).
+						Return(nil),
+					snapshotSvc.EXPECT().
+						CompleteQuarter(context.Background(), 2023, 4, domain.RatingStrategyCurrent).
+						Return(nil),
+					interactor.EXPECT().
+						CalculateUserRating(context.Background(), uuid.UUID{1}, period, domain.RatingStrategyCurrent).
+						Return(&domain.RatingBreakdown{Strategy: domain.RatingStrategyCurrent, Period: period}, nil),
+					snapshotSvc.EXPECT().
+						Save(context.Background(), &domain.RatingSnapshot{
+							UserID:   uuid.UUID{1},
+							Year:     2024,
+							Quarter:  1,
+							Strategy: domain.RatingStrategyCurrent,
+						}).
+						Return(nil),
+					snapshotSvc.EXPECT().
+						CompleteQuarter(context.Background(), 2024, 1, domain.RatingStrategyCurrent).
+						Return(nil),
+				)
+			},
+		},
+		{
+			name: "ошибка в пропущенном квартале прерывает сохранение следующих",
+			beforeTest: func(interactor mocks.MockIInteractor, userSvc mocks.MockIUserService, snapshotSvc mocks.MockIRatingSnapshotService) {
+				interactor.EXPECT().
+					ResolveStrategy("").
+					Return(domain.RatingStrategyCurrent, nil)
+
+				snapshotSvc.EXPECT().
+					GetLastCompletedQuarter(context.Background(), domain.RatingStrategyCurrent).
+					Return(2023, 3, nil)
+
+				userSvc.EXPECT().
+					GetEntrepreneurIds(context.Background()).
+					Return([]uuid.UUID{{1}}, nil)
+
+				interactor.EXPECT().
+					CalculateUserRating(
+						context.Background(),
+						uuid.UUID{1},
+						&domain.Period{StartYear: 2023, StartQuarter: 1, EndYear: 2023, EndQuarter: 4},
+						domain.RatingStrategyCurrent,
+					).
+					Return(nil, errors.New("sql error"))
+			},
+			wantErr: true,
+			errStr:  errors.New("сохранение рейтингов за 4 квартал 2023 года: не сохранены рейтинги 1 из 1 предпринимателей"),
+		},
+		{
+			name: "сохраненных кварталов еще нет",
+			beforeTest: func(interactor mocks.MockIInteractor, userSvc mocks.MockIUserService, snapshotSvc mocks.MockIRatingSnapshotService) {
+				interactor.EXPECT().
+					ResolveStrategy("").
+					Return(domain.RatingStrategyCurrent, nil)
+
+				snapshotSvc.EXPECT().
+					GetLastCompletedQuarter(context.Background(), domain.RatingStrategyCurrent).
+					Return(0, 0, nil)
+
+				// сохраняется только последний завершенный квартал
+				userSvc.EXPECT().
+					GetEntrepreneurIds(context.Background()).
+					Return([]uuid.UUID{}, nil)
+
+				snapshotSvc.EXPECT().
+					CompleteQuarter(context.Background(), 2024, 1, domain.RatingStrategyCurrent).
+					Return(nil)
+			},
+		},
+		{
+			name: "ошибка получения списка предпринимателей",
+			beforeTest: func(interactor mocks.MockIInteractor, userSvc mocks.MockIUserService, snapshotSvc mocks.MockIRatingSnapshotService) {
+				interactor.EXPECT().
+					ResolveStrategy("").
+					Return(domain.RatingStrategyCurrent, nil)
+
+				snapshotSvc.EXPECT().
+					GetLastCompletedQuarter(context.Background(), domain.RatingStrategyCurrent).
+					Return(2023, 4, nil)
+
+				userSvc.EXPECT().
+					GetEntrepreneurIds(context.Background()).
+					Return(nil, errors.New("получение списка предпринимателей: sql error"))
+			},
+			wantErr: true,
+			errStr:  errors.New("сохранение рейтингов за 1 квартал 2024 года: получение списка предпринимателей: sql error"),
+		},
+		{
+			name: "ошибка получения последнего сохраненного квартала",
+			beforeTest: func(interactor mocks.MockIInteractor, userSvc mocks.MockIUserService, snapshotSvc mocks.MockIRatingSnapshotService) {
+				interactor.EXPECT().
+					ResolveStrategy("").
+					Return(domain.RatingStrategyCurrent, nil)
+
+				snapshotSvc.EXPECT().
+					GetLastCompletedQuarter(context.Background(), domain.RatingStrategyCurrent).
+					Return(0, 0, errors.New("получение последнего квартала с сохраненными рейтингами: sql error"))
+			},
+			wantErr: true,
+			errStr:  errors.New("получение последнего квартала с сохраненными рейтингами: sql error"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.beforeTest != nil {
+				tc.beforeTest(*interactor, *userSvc, *snapshotSvc)
+			}
+
+			err := snapshotter.SnapshotLastQuarter(context.Background())
+
+			if tc.wantErr {
+				require.Equal(t, tc.errStr.Error(), err.Error())
+			} else {
+				require.Nil(t, err)
+			}
+		})
+	}
+}
+
+func TestSnapshotter_SnapshotLastQuarterRetry(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	interactor := mocks.NewMockIInteractor(ctrl)
+	userSvc := mocks.NewMockIUserService(ctrl)
+	snapshotSvc := mocks.NewMockIRatingSnapshotService(ctrl)
+	snapshotter := NewSnapshotter(interactor, userSvc, snapshotSvc, testClock, logger.NewLogger(logger.InfoLevel, io.Discard))
+
+	period := &domain.Period{StartYear: 2023, StartQuarter: 2, EndYear: 2024, EndQuarter: 1}
+	breakdown := &domain.RatingBreakdown{Strategy: domain.RatingStrategyCurrent, Period: period}
+
+	interactor.EXPECT().
+		ResolveStrategy("").
+		Return(domain.RatingStrategyCurrent, nil).
+		Times(2)
+
+	snapshotSvc.EXPECT().
+		GetLastCompletedQuarter(context.Background(), domain.RatingStrategyCurrent).
+		Return(2023, 4, nil).
+		Times(2)
+
+	userSvc.EXPECT().
+		GetEntrepreneurIds(context.Background()).
+		Return([]uuid.UUID{{1}}, nil).
+		Times(2)
+
+	// первая проверка прерывается ошибкой, вторая сохраняет рейтинг и отмечает квартал
+	gomock.InOrder(
+		interactor.EXPECT().
+			CalculateUserRating(context.Background(), uuid.UUID{1}, period, domain.RatingStrategyCurrent).
+			Return(nil, errors.New("sql error")),
+		interactor.EXPECT().
+			CalculateUserRating(context.Background(), uuid.UUID{1}, period, domain.RatingStrategyCurrent).
+			Return(breakdown, nil),
+	)
+
+	snapshotSvc.EXPECT().
+		Save(context.Background(), &domain.RatingSnapshot{
+			UserID:   uuid.UUID{1},
+			Year:     2024,
+			Quarter:  1,
+			Strategy: domain.RatingStrategyCurrent,
+		}).
+		Return(nil)
+
+	snapshotSvc.EXPECT().
+		CompleteQuarter(context.Background(), 2024, 1, domain.RatingStrategyCurrent).
+		Return(nil)
+
+	err := snapshotter.SnapshotLastQuarter(context.Background())
+	require.Equal(t, "сохранение рейтингов за 1 квартал 2024 года: не сохранены рейтинги 1 из 1 предпринимателей", err.Error())
+
+	err = snapshotter.SnapshotLastQuarter(context.Background())
+	require.Nil(t, err)
+}
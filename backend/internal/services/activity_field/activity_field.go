@@ -3,11 +3,13 @@ package activity_field
 import (
 	"context"
 	"fmt"
-	"math"
 	"ppo/domain"
 	"ppo/pkg/logger"
+	"slices"
+	"sort"
 
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 )
 
 type Service struct {
@@ -40,11 +42,19 @@ func (s *Service) Create(ctx context.Context, data *domain.ActivityField) (err e
 		return fmt.Errorf("должно быть указано описание сферы деятельности")
 	}
 
-	if math.Abs(float64(data.Cost)) < 1e-7 {
+	if data.Cost.IsZero() {
 		s.logger.Infof("%s: вес сферы деятельности не может быть равен 0", prompt)
 		return fmt.Errorf("вес сферы деятельности не может быть равен 0")
 	}
 
+	if data.ParentID != uuid.Nil {
+		_, err = s.actFieldRepo.GetById(ctx, data.ParentID)
+		if err != nil {
+			s.logger.Infof("%s: получение родительской сферы деятельности: %v", prompt, err)
+			return fmt.Errorf("получение родительской сферы деятельности: %w", err)
+		}
+	}
+
 	err = s.actFieldRepo.Create(ctx, data)
 	if err != nil {
 		s.logger.Infof("%s: создание сферы деятельности: %v", prompt, err)
@@ -72,6 +82,59 @@ func (s *Service) DeleteById(ctx context.Context, id uuid.UUID) (err error) {
 	return nil
 }
 
+// checkParent проверяет, что новый родитель существует и не является самой сферой или ее потомком.
+func (s *Service) checkParent(ctx context.Context, id, parentId uuid.UUID) (err error) {
+	_, err = s.actFieldRepo.GetById(ctx, parentId)
+	if err != nil {
+		return fmt.Errorf("получение родительской сферы деятельности: %w", err)
+	}
+
+	subtree, err := s.actFieldRepo.GetSubtreeIds(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if slices.Contains(subtree, parentId) {
+		return fmt.Errorf("сфера деятельности не может быть вложена в саму себя или в свою подкатегорию")
+	}
+
+	return nil
+}
+
+// UpsertByCode используется при импорте классификатора: добавляет сферу деятельности или обновляет
+// название, описание и родителя сферы с тем же кодом.
+func (s *Service) UpsertByCode(ctx context.Context, data *domain.ActivityField) (err error) {
+	prompt := "ActivityFieldUpsertByCode"
+
+	if data.Code == "" {
+		s.logger.Infof("%s: должен быть указан код сферы деятельности", prompt)
+		return fmt.Errorf("должен быть указан код сферы деятельности")
+	}
+
+	if data.Name == "" {
+		s.logger.Infof("%s: должно быть указано название сферы деятельности", prompt)
+		return fmt.Errorf("должно быть указано название сферы деятельности %s", data.Code)
+	}
+
+	if data.Cost.IsNegative() {
+		s.logger.Infof("%s: вес сферы деятельности не может быть отрицательным", prompt)
+		return fmt.Errorf("вес сферы деятельности не может быть отрицательным")
+	}
+
+	// в классификаторе у многих позиций нет отдельного описания
+	if data.Description == "" {
+		data.Description = data.Name
+	}
+
+	err = s.actFieldRepo.UpsertByCode(ctx, data)
+	if err != nil {
+		s.logger.Infof("%s: %v", prompt, err)
+		return err
+	}
+
+	return nil
+}
+
 func (s *Service) Update(ctx context.Context, data *domain.ActivityField) (err error) {
 	prompt := "ActivityFieldUpdate"
 
@@ -81,6 +144,14 @@ func (s *Service) Update(ctx context.Context, data *domain.ActivityField) (err e
 		return fmt.Errorf("получение сферы деятельности по id: %w", err)
 	}
 
+	if data.ParentID != uuid.Nil {
+		err = s.checkParent(ctx, data.ID, data.ParentID)
+		if err != nil {
+			s.logger.Infof("%s: %v", prompt, err)
+			return err
+		}
+	}
+
 	err = s.actFieldRepo.Update(ctx, data)
 	if err != nil {
 		s.logger.Infof("%s: обновление информации о cфере деятельности: %v", prompt, err)
@@ -102,32 +173,91 @@ func (s *Service) GetById(ctx context.Context, id uuid.UUID) (data *domain.Activ
 	return data, nil
 }
 
-func (s *Service) GetCostByCompanyId(ctx context.Context, companyId uuid.UUID) (cost float32, err error) {
+// GetTree возвращает дерево сфер деятельности с корнем rootId или, если он не указан, весь классификатор.
+// Дочерние узлы упорядочены по коду.
+func (s *Service) GetTree(ctx context.Context, rootId uuid.UUID) (roots []*domain.ActivityFieldNode, err error) {
+	prompt := "ActivityFieldGetTree"
+
+	fields, _, err := s.actFieldRepo.GetAll(ctx, 0, false)
+	if err != nil {
+		s.logger.Infof("%s: получение списка сфер деятельности: %v", prompt, err)
+		return nil, fmt.Errorf("получение списка сфер деятельности: %w", err)
+	}
+
+	nodes := make(map[uuid.UUID]*domain.ActivityFieldNode, len(fields))
+	for _, field := range fields {
+		nodes[field.ID] = &domain.ActivityFieldNode{
+			ActivityField: field,
+			Children:      make([]*domain.ActivityFieldNode, 0),
+		}
+	}
+
+	roots = make([]*domain.ActivityFieldNode, 0)
+	for _, field := range fields {
+		parent, ok := nodes[field.ParentID]
+		if !ok {
+			roots = append(roots, nodes[field.ID])
+			continue
+		}
+
+		parent.Children = append(parent.Children, nodes[field.ID])
+	}
+
+	for _, node := range nodes {
+		sortNodes(node.Children)
+	}
+	sortNodes(roots)
+
+	if rootId == uuid.Nil {
+		return roots, nil
+	}
+
+	root, ok := nodes[rootId]
+	if !ok {
+		s.logger.Infof("%s: сфера деятельности %s не найдена", prompt, rootId)
+		return nil, fmt.Errorf("сфера деятельности %s не найдена", rootId)
+	}
+
+	return []*domain.ActivityFieldNode{root}, nil
+}
+
+func sortNodes(nodes []*domain.ActivityFieldNode) {
+	sort.Slice(nodes, func(i, j int) bool {
+		if nodes[i].Code != nodes[j].Code {
+			return nodes[i].Code < nodes[j].Code
+		}
+
+		return nodes[i].Name < nodes[j].Name
+	})
+}
+
+// GetCostByCompanyId возвращает вес основной сферы деятельности компании: дополнительные на рейтинг не влияют.
+func (s *Service) GetCostByCompanyId(ctx context.Context, companyId uuid.UUID) (cost decimal.Decimal, err error) {
 	prompt := "ActivityFieldGetCostByCompanyId"
 
 	company, err := s.compRepo.GetById(ctx, companyId)
 	if err != nil {
 		s.logger.Infof("%s: получение компании по id: %v", prompt, err)
-		return 0, fmt.Errorf("получение компании по id: %w", err)
+		return decimal.Zero, fmt.Errorf("получение компании по id: %w", err)
 	}
 
 	field, err := s.actFieldRepo.GetById(ctx, company.ActivityFieldId)
 	if err != nil {
 		s.logger.Infof("%s: получение сферы деятельности по id: %v", prompt, err)
-		return 0, fmt.Errorf("получение сферы деятельности по id: %w", err)
+		return decimal.Zero, fmt.Errorf("получение сферы деятельности по id: %w", err)
 	}
 	cost = field.Cost
 
 	return cost, nil
 }
 
-func (s *Service) GetMaxCost(ctx context.Context) (maxCost float32, err error) {
+func (s *Service) GetMaxCost(ctx context.Context) (maxCost decimal.Decimal, err error) {
 	prompt := "ActivityFieldGetMaxCost"
 
 	maxCost, err = s.actFieldRepo.GetMaxCost(ctx)
 	if err != nil {
 		s.logger.Infof("%s: получение максимального веса сферы деятельности: %v", prompt, err)
-		return 0, fmt.Errorf("получение максимального веса сферы деятельности: %w", err)
+		return decimal.Zero, fmt.Errorf("получение максимального веса сферы деятельности: %w", err)
 	}
 
 	return maxCost, nil
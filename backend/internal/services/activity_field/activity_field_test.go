@@ -5,10 +5,13 @@ import (
 	"errors"
 	"fmt"
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
+	"io"
 	"ppo/domain"
 	"ppo/mocks"
+	"ppo/pkg/logger"
 	"testing"
 )
 
@@ -18,7 +21,7 @@ func TestService_Create(t *testing.T) {
 
 	repo := mocks.NewMockIActivityFieldRepository(ctrl)
 	compRepo := mocks.NewMockICompanyRepository(ctrl)
-	svc := NewService(repo, compRepo)
+	svc := NewService(repo, compRepo, logger.NewLogger(logger.InfoLevel, io.Discard))
 
 	testCases := []struct {
 		name       string
@@ -32,7 +35,7 @@ func TestService_Create(t *testing.T) {
 			data: &domain.ActivityField{
 				Name:        "aaa",
 				Description: "aaa",
-				Cost:        0.3,
+				Cost:        decimal.RequireFromString("0.3"),
 			},
 			beforeTest: func(repo mocks.MockIActivityFieldRepository) {
 				repo.EXPECT().
@@ -41,7 +44,7 @@ func TestService_Create(t *testing.T) {
 						&domain.ActivityField{
 							Name:        "aaa",
 							Description: "aaa",
-							Cost:        0.3,
+							Cost:        decimal.RequireFromString("0.3"),
 						},
 					).Return(nil)
 			},
@@ -72,7 +75,7 @@ func TestService_Create(t *testing.T) {
 			data: &domain.ActivityField{
 				Name:        "aaa",
 				Description: "",
-				Cost:        0.3,
+				Cost:        decimal.RequireFromString("0.3"),
 			},
 			beforeTest: func(repo mocks.MockIActivityFieldRepository) {
 				repo.EXPECT().
@@ -81,7 +84,7 @@ func TestService_Create(t *testing.T) {
 						&domain.ActivityField{
 							Name:        "aaa",
 							Description: "",
-							Cost:        0.3,
+							Cost:        decimal.RequireFromString("0.3"),
 						},
 					).Return(nil).
 					AnyTimes()
@@ -114,7 +117,7 @@ func TestService_Create(t *testing.T) {
 			data: &domain.ActivityField{
 				Name:        "aaa",
 				Description: "aaa",
-				Cost:        3,
+				Cost:        decimal.NewFromInt(3),
 			},
 			beforeTest: func(repo mocks.MockIActivityFieldRepository) {
 				repo.EXPECT().
@@ -123,7 +126,7 @@ func TestService_Create(t *testing.T) {
 						&domain.ActivityField{
 							Name:        "aaa",
 							Description: "aaa",
-							Cost:        3,
+							Cost:        decimal.NewFromInt(3),
 						},
 					).Return(fmt.Errorf("sql error")).
 					AnyTimes()
@@ -156,7 +159,7 @@ func TestService_DeleteById(t *testing.T) {
 
 	repo := mocks.NewMockIActivityFieldRepository(ctrl)
 	compRepo := mocks.NewMockICompanyRepository(ctrl)
-	svc := NewService(repo, compRepo)
+	svc := NewService(repo, compRepo, logger.NewLogger(logger.InfoLevel, io.Discard))
 
 	curUuid := uuid.New()
 
@@ -171,6 +174,10 @@ func TestService_DeleteById(t *testing.T) {
 			name: "успешное удаление",
 			id:   curUuid,
 			beforeTest: func(repo mocks.MockIActivityFieldRepository) {
+				repo.EXPECT().
+					GetById(context.Background(), curUuid).
+					Return(&domain.ActivityField{ID: curUuid}, nil)
+
 				repo.EXPECT().
 					DeleteById(context.Background(), curUuid).
 					Return(nil)
@@ -181,6 +188,10 @@ func TestService_DeleteById(t *testing.T) {
 			name: "ошибка выполнения запроса в репозитории",
 			id:   curUuid,
 			beforeTest: func(repo mocks.MockIActivityFieldRepository) {
+				repo.EXPECT().
+					GetById(context.Background(), curUuid).
+					Return(&domain.ActivityField{ID: curUuid}, nil)
+
 				repo.EXPECT().
 					DeleteById(context.Background(), curUuid).
 					Return(fmt.Errorf("sql error"))
@@ -213,7 +224,7 @@ func TestService_GetById(t *testing.T) {
 
 	repo := mocks.NewMockIActivityFieldRepository(ctrl)
 	compRepo := mocks.NewMockICompanyRepository(ctrl)
-	svc := NewService(repo, compRepo)
+	svc := NewService(repo, compRepo, logger.NewLogger(logger.InfoLevel, io.Discard))
 
 	testCases := []struct {
 		name       string
@@ -285,7 +296,7 @@ func TestService_Update(t *testing.T) {
 
 	repo := mocks.NewMockIActivityFieldRepository(ctrl)
 	compRepo := mocks.NewMockICompanyRepository(ctrl)
-	svc := NewService(repo, compRepo)
+	svc := NewService(repo, compRepo, logger.NewLogger(logger.InfoLevel, io.Discard))
 
 	testCases := []struct {
 		name       string
@@ -301,6 +312,10 @@ func TestService_Update(t *testing.T) {
 				Name: "aaa",
 			},
 			beforeTest: func(repo mocks.MockIActivityFieldRepository) {
+				repo.EXPECT().
+					GetById(context.Background(), uuid.UUID{1}).
+					Return(&domain.ActivityField{ID: uuid.UUID{1}}, nil)
+
 				repo.EXPECT().
 					Update(
 						context.Background(),
@@ -312,6 +327,101 @@ func TestService_Update(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "перенос в другую сферу деятельности",
+			data: &domain.ActivityField{
+				ID:       uuid.UUID{1},
+				ParentID: uuid.UUID{4},
+				Name:     "aaa",
+			},
+			beforeTest: func(repo mocks.MockIActivityFieldRepository) {
+				repo.EXPECT().
+					GetById(context.Background(), uuid.UUID{1}).
+					Return(&domain.ActivityField{ID: uuid.UUID{1}}, nil)
+
+				repo.EXPECT().
+					GetById(context.Background(), uuid.UUID{4}).
+					Return(&domain.ActivityField{ID: uuid.UUID{4}}, nil)
+
+				repo.EXPECT().
+					GetSubtreeIds(context.Background(), uuid.UUID{1}).
+					Return([]uuid.UUID{{1}, {2}, {3}}, nil)
+
+				repo.EXPECT().
+					Update(
+						context.Background(),
+						&domain.ActivityField{
+							ID:       uuid.UUID{1},
+							ParentID: uuid.UUID{4},
+							Name:     "aaa",
+						},
+					).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "вложение в саму себя",
+			data: &domain.ActivityField{
+				ID:       uuid.UUID{1},
+				ParentID: uuid.UUID{1},
+				Name:     "aaa",
+			},
+			beforeTest: func(repo mocks.MockIActivityFieldRepository) {
+				repo.EXPECT().
+					GetById(context.Background(), uuid.UUID{1}).
+					Return(&domain.ActivityField{ID: uuid.UUID{1}}, nil).
+					Times(2)
+
+				repo.EXPECT().
+					GetSubtreeIds(context.Background(), uuid.UUID{1}).
+					Return([]uuid.UUID{{1}}, nil)
+			},
+			wantErr: true,
+			errStr:  errors.New("сфера деятельности не может быть вложена в саму себя или в свою подкатегорию"),
+		},
+		{
+			name: "вложение в подкатегорию второго уровня",
+			data: &domain.ActivityField{
+				ID:       uuid.UUID{1},
+				ParentID: uuid.UUID{3},
+				Name:     "aaa",
+			},
+			beforeTest: func(repo mocks.MockIActivityFieldRepository) {
+				repo.EXPECT().
+					GetById(context.Background(), uuid.UUID{1}).
+					Return(&domain.ActivityField{ID: uuid.UUID{1}}, nil)
+
+				repo.EXPECT().
+					GetById(context.Background(), uuid.UUID{3}).
+					Return(&domain.ActivityField{ID: uuid.UUID{3}, ParentID: uuid.UUID{2}}, nil)
+
+				// {3} - дочерняя сфера {2}, которая, в свою очередь, дочерняя сфера {1}
+				repo.EXPECT().
+					GetSubtreeIds(context.Background(), uuid.UUID{1}).
+					Return([]uuid.UUID{{1}, {2}, {3}}, nil)
+			},
+			wantErr: true,
+			errStr:  errors.New("сфера деятельности не может быть вложена в саму себя или в свою подкатегорию"),
+		},
+		{
+			name: "несуществующая родительская сфера деятельности",
+			data: &domain.ActivityField{
+				ID:       uuid.UUID{1},
+				ParentID: uuid.UUID{4},
+				Name:     "aaa",
+			},
+			beforeTest: func(repo mocks.MockIActivityFieldRepository) {
+				repo.EXPECT().
+					GetById(context.Background(), uuid.UUID{1}).
+					Return(&domain.ActivityField{ID: uuid.UUID{1}}, nil)
+
+				repo.EXPECT().
+					GetById(context.Background(), uuid.UUID{4}).
+					Return(nil, fmt.Errorf("no rows in result set"))
+			},
+			wantErr: true,
+			errStr:  errors.New("получение родительской сферы деятельности: no rows in result set"),
+		},
 		{
 			name: "ошибка выполнения запроса в репозитории",
 			data: &domain.ActivityField{
@@ -319,6 +429,10 @@ func TestService_Update(t *testing.T) {
 				Name: "aaa",
 			},
 			beforeTest: func(repo mocks.MockIActivityFieldRepository) {
+				repo.EXPECT().
+					GetById(context.Background(), uuid.UUID{1}).
+					Return(&domain.ActivityField{ID: uuid.UUID{1}}, nil)
+
 				repo.EXPECT().
 					Update(
 						context.Background(),
@@ -349,3 +463,212 @@ func TestService_Update(t *testing.T) {
 		})
 	}
 }
+
+func TestService_UpsertByCode(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mocks.NewMockIActivityFieldRepository(ctrl)
+	compRepo := mocks.NewMockICompanyRepository(ctrl)
+	svc := NewService(repo, compRepo, logger.NewLogger(logger.InfoLevel, io.Discard))
+
+	testCases := []struct {
+		name       string
+		data       *domain.ActivityField
+		beforeTest func(repo mocks.MockIActivityFieldRepository)
+		wantErr    bool
+		errStr     error
+	}{
+		{
+			name: "описание по умолчанию совпадает с названием",
+			data: &domain.ActivityField{
+				ParentID: uuid.UUID{1},
+				Code:     "01.1",
+				Name:     "aaa",
+				Cost:     decimal.NewFromFloat(0.3),
+			},
+			beforeTest: func(repo mocks.MockIActivityFieldRepository) {
+				repo.EXPECT().
+					UpsertByCode(
+						context.Background(),
+						&domain.ActivityField{
+							ParentID:    uuid.UUID{1},
+							Code:        "01.1",
+							Name:        "aaa",
+							Description: "aaa",
+							Cost:        decimal.NewFromFloat(0.3),
+						},
+					).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "пустой код сферы деятельности",
+			data: &domain.ActivityField{
+				Name: "aaa",
+				Cost: decimal.NewFromFloat(0.3),
+			},
+			wantErr: true,
+			errStr:  errors.New("должен быть указан код сферы деятельности"),
+		},
+		{
+			name: "пустое название сферы деятельности",
+			data: &domain.ActivityField{
+				Code: "01.1",
+				Cost: decimal.NewFromFloat(0.3),
+			},
+			wantErr: true,
+			errStr:  errors.New("должно быть указано название сферы деятельности 01.1"),
+		},
+		{
+			name: "отрицательный вес сферы деятельности",
+			data: &domain.ActivityField{
+				Code: "01.1",
+				Name: "aaa",
+				Cost: decimal.NewFromFloat(-0.3),
+			},
+			wantErr: true,
+			errStr:  errors.New("вес сферы деятельности не может быть отрицательным"),
+		},
+		{
+			name: "ошибка выполнения запроса в репозитории",
+			data: &domain.ActivityField{
+				Code:        "01.1",
+				Name:        "aaa",
+				Description: "bbb",
+				Cost:        decimal.NewFromFloat(0.3),
+			},
+			beforeTest: func(repo mocks.MockIActivityFieldRepository) {
+				repo.EXPECT().
+					UpsertByCode(
+						context.Background(),
+						&domain.ActivityField{
+							Code:        "01.1",
+							Name:        "aaa",
+							Description: "bbb",
+							Cost:        decimal.NewFromFloat(0.3),
+						},
+					).Return(fmt.Errorf("импорт сферы деятельности 01.1: sql error"))
+			},
+			wantErr: true,
+			errStr:  errors.New("импорт сферы деятельности 01.1: sql error"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tc.beforeTest != nil {
+				tc.beforeTest(*repo)
+			}
+
+			err := svc.UpsertByCode(ctx, tc.data)
+
+			if tc.wantErr {
+				require.Equal(t, tc.errStr.Error(), err.Error())
+			} else {
+				require.Nil(t, err)
+			}
+		})
+	}
+}
+
+func TestService_GetTree(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mocks.NewMockIActivityFieldRepository(ctrl)
+	compRepo := mocks.NewMockICompanyRepository(ctrl)
+	svc := NewService(repo, compRepo, logger.NewLogger(logger.InfoLevel, io.Discard))
+
+	// раздел A с группами 01 и 02, в группе 01 - класс 01.1; раздел B без подкатегорий
+	sectionA := &domain.ActivityField{ID: uuid.UUID{1}, Code: "A", Name: "a"}
+	group01 := &domain.ActivityField{ID: uuid.UUID{2}, ParentID: uuid.UUID{1}, Code: "01", Name: "01"}
+	group02 := &domain.ActivityField{ID: uuid.UUID{3}, ParentID: uuid.UUID{1}, Code: "02", Name: "02"}
+	class011 := &domain.ActivityField{ID: uuid.UUID{4}, ParentID: uuid.UUID{2}, Code: "01.1", Name: "01.1"}
+	sectionB := &domain.ActivityField{ID: uuid.UUID{5}, Code: "B", Name: "b"}
+	fields := []*domain.ActivityField{class011, sectionB, group02, sectionA, group01}
+
+	group01Node := &domain.ActivityFieldNode{
+		ActivityField: group01,
+		Children: []*domain.ActivityFieldNode{
+			{ActivityField: class011, Children: []*domain.ActivityFieldNode{}},
+		},
+	}
+	sectionANode := &domain.ActivityFieldNode{
+		ActivityField: sectionA,
+		Children: []*domain.ActivityFieldNode{
+			group01Node,
+			{ActivityField: group02, Children: []*domain.ActivityFieldNode{}},
+		},
+	}
+	sectionBNode := &domain.ActivityFieldNode{ActivityField: sectionB, Children: []*domain.ActivityFieldNode{}}
+
+	testCases := []struct {
+		name       string
+		rootId     uuid.UUID
+		beforeTest func(repo mocks.MockIActivityFieldRepository)
+		expected   []*domain.ActivityFieldNode
+		wantErr    bool
+		errStr     error
+	}{
+		{
+			name: "весь классификатор, упорядоченный по коду",
+			beforeTest: func(repo mocks.MockIActivityFieldRepository) {
+				repo.EXPECT().
+					GetAll(context.Background(), 0, false).
+					Return(fields, 0, nil)
+			},
+			expected: []*domain.ActivityFieldNode{sectionANode, sectionBNode},
+			wantErr:  false,
+		},
+		{
+			name:   "поддерево сферы деятельности",
+			rootId: uuid.UUID{2},
+			beforeTest: func(repo mocks.MockIActivityFieldRepository) {
+				repo.EXPECT().
+					GetAll(context.Background(), 0, false).
+					Return(fields, 0, nil)
+			},
+			expected: []*domain.ActivityFieldNode{group01Node},
+			wantErr:  false,
+		},
+		{
+			name:   "несуществующая сфера деятельности",
+			rootId: uuid.UUID{9},
+			beforeTest: func(repo mocks.MockIActivityFieldRepository) {
+				repo.EXPECT().
+					GetAll(context.Background(), 0, false).
+					Return(fields, 0, nil)
+			},
+			wantErr: true,
+			errStr:  fmt.Errorf("сфера деятельности %s не найдена", uuid.UUID{9}),
+		},
+		{
+			name: "ошибка получения данных в репозитории",
+			beforeTest: func(repo mocks.MockIActivityFieldRepository) {
+				repo.EXPECT().
+					GetAll(context.Background(), 0, false).
+					Return(nil, 0, fmt.Errorf("sql error"))
+			},
+			wantErr: true,
+			errStr:  errors.New("получение списка сфер деятельности: sql error"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tc.beforeTest != nil {
+				tc.beforeTest(*repo)
+			}
+
+			roots, err := svc.GetTree(ctx, tc.rootId)
+
+			if tc.wantErr {
+				require.Equal(t, tc.errStr.Error(), err.Error())
+			} else {
+				require.Nil(t, err)
+				require.Equal(t, tc.expected, roots)
+			}
+		})
+	}
+}
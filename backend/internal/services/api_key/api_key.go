@@ -0,0 +1,155 @@
+package api_key
+
+import (
+	"context"
+	"fmt"
+	"ppo/domain"
+	"ppo/pkg/base"
+	"ppo/pkg/logger"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// по префиксу ключ можно узнать в логах и при утечке в репозиторий кода
+	keyPrefix = "ppo_"
+	// столько символов ключа хранится в открытом виде, чтобы владелец мог отличать ключи
+	visiblePrefixLength = len(keyPrefix) + 8
+	maxNameLength       = 128
+)
+
+type Service struct {
+	keyRepo  domain.IApiKeyRepository
+	authRepo domain.IAuthRepository
+	logger   logger.ILogger
+}
+
+func NewService(
+	keyRepo domain.IApiKeyRepository,
+	authRepo domain.IAuthRepository,
+	logger logger.ILogger,
+) domain.IApiKeyService {
+	return &Service{
+		keyRepo:  keyRepo,
+		authRepo: authRepo,
+		logger:   logger,
+	}
+}
+
+func validateScopes(scopes []string) (err error) {
+	if len(scopes) == 0 {
+		return fmt.Errorf("должна быть указана хотя бы одна область действия ключа")
+	}
+
+	for _, scope := range scopes {
+		if !slices.Contains(domain.ApiKeyScopes, scope) {
+			return fmt.Errorf("неизвестная область действия ключа: %s", scope)
+		}
+	}
+
+	return nil
+}
+
+// Create выпускает ключ и возвращает его; повторно получить ключ невозможно, в БД хранится только хэш.
+func (s *Service) Create(ctx context.Context, key *domain.ApiKey) (apiKey string, err error) {
+	prompt := "ApiKeyCreate"
+
+	key.Name = strings.TrimSpace(key.Name)
+	if key.Name == "" {
+		s.logger.Infof("%s: должно быть указано название ключа", prompt)
+		return "", fmt.Errorf("должно быть указано название ключа")
+	}
+
+	if len([]rune(key.Name)) > maxNameLength {
+		s.logger.Infof("%s: слишком длинное название ключа", prompt)
+		return "", fmt.Errorf("название ключа не должно быть длиннее %d символов", maxNameLength)
+	}
+
+	slices.Sort(key.Scopes)
+	key.Scopes = slices.Compact(key.Scopes)
+	err = validateScopes(key.Scopes)
+	if err != nil {
+		s.logger.Infof("%s: %v", prompt, err)
+		return "", err
+	}
+
+	if !key.ExpiresAt.IsZero() && !key.ExpiresAt.After(time.Now()) {
+		s.logger.Infof("%s: срок действия ключа уже истек", prompt)
+		return "", fmt.Errorf("срок действия ключа должен быть в будущем")
+	}
+
+	token, _, err := base.GenerateToken()
+	if err != nil {
+		s.logger.Infof("%s: %v", prompt, err)
+		return "", err
+	}
+
+	apiKey = keyPrefix + token
+	key.Prefix = apiKey[:visiblePrefixLength]
+	key.Hash = base.HashToken(apiKey)
+
+	err = s.keyRepo.Create(ctx, key)
+	if err != nil {
+		s.logger.Infof("%s: %v", prompt, err)
+		return "", err
+	}
+
+	return apiKey, nil
+}
+
+func (s *Service) GetByUserId(ctx context.Context, userId uuid.UUID) (keys []*domain.ApiKey, err error) {
+	prompt := "ApiKeyGetByUserId"
+
+	keys, err = s.keyRepo.GetByUserId(ctx, userId)
+	if err != nil {
+		s.logger.Infof("%s: %v", prompt, err)
+		return nil, err
+	}
+
+	return keys, nil
+}
+
+func (s *Service) Revoke(ctx context.Context, id uuid.UUID, userId uuid.UUID) (err error) {
+	prompt := "ApiKeyRevoke"
+
+	err = s.keyRepo.Revoke(ctx, id, userId)
+	if err != nil {
+		s.logger.Infof("%s: %v", prompt, err)
+		return err
+	}
+
+	return nil
+}
+
+// Authenticate находит действующий ключ и его владельца. Роль владельца читается из БД
+// при каждом запросе, поэтому ее изменение сразу распространяется и на ключи.
+func (s *Service) Authenticate(ctx context.Context, apiKey string) (key *domain.ApiKey, userAuth *domain.UserAuth, err error) {
+	prompt := "ApiKeyAuthenticate"
+
+	if !strings.HasPrefix(apiKey, keyPrefix) {
+		s.logger.Infof("%s: неверный формат ключа", prompt)
+		return nil, nil, fmt.Errorf("недействительный API-ключ")
+	}
+
+	key, err = s.keyRepo.GetByHash(ctx, base.HashToken(apiKey))
+	if err != nil {
+		s.logger.Infof("%s: %v", prompt, err)
+		return nil, nil, fmt.Errorf("недействительный API-ключ")
+	}
+
+	userAuth, err = s.authRepo.GetById(ctx, key.UserID)
+	if err != nil {
+		s.logger.Infof("%s: получение владельца ключа: %v", prompt, err)
+		return nil, nil, fmt.Errorf("получение владельца ключа: %w", err)
+	}
+
+	err = s.keyRepo.Touch(ctx, key.ID)
+	if err != nil {
+		s.logger.Infof("%s: %v", prompt, err)
+	}
+
+	return key, userAuth, nil
+}
@@ -0,0 +1,178 @@
+package api_key
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"io"
+	"ppo/domain"
+	"ppo/mocks"
+	"ppo/pkg/base"
+	"ppo/pkg/logger"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestApiKeyService_Create(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	keyRepo := mocks.NewMockIApiKeyRepository(ctrl)
+	authRepo := mocks.NewMockIAuthRepository(ctrl)
+	svc := NewService(keyRepo, authRepo, logger.NewLogger(logger.InfoLevel, io.Discard))
+
+	testCases := []struct {
+		name       string
+		key        *domain.ApiKey
+		beforeTest func(keyRepo mocks.MockIApiKeyRepository)
+		wantErr    bool
+		errStr     error
+	}{
+		{
+			name: "успешное создание",
+			key: &domain.ApiKey{
+				UserID: uuid.UUID{1},
+				Name:   " выгрузка отчетов ",
+				Scopes: []string{domain.ScopeFinancials, domain.ScopeFinancials},
+			},
+			beforeTest: func(keyRepo mocks.MockIApiKeyRepository) {
+				keyRepo.EXPECT().
+					Create(context.Background(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, key *domain.ApiKey) error {
+						require.Equal(t, "выгрузка отчетов", key.Name)
+						require.Equal(t, []string{domain.ScopeFinancials}, key.Scopes)
+						return nil
+					})
+			},
+		},
+		{
+			name: "пустое название",
+			key: &domain.ApiKey{
+				UserID: uuid.UUID{1},
+				Scopes: []string{domain.ScopeFinancials},
+			},
+			wantErr: true,
+			errStr:  errors.New("должно быть указано название ключа"),
+		},
+		{
+			name: "без областей действия",
+			key: &domain.ApiKey{
+				UserID: uuid.UUID{1},
+				Name:   "ключ",
+			},
+			wantErr: true,
+			errStr:  errors.New("должна быть указана хотя бы одна область действия ключа"),
+		},
+		{
+			name: "неизвестная область действия",
+			key: &domain.ApiKey{
+				UserID: uuid.UUID{1},
+				Name:   "ключ",
+				Scopes: []string{"users"},
+			},
+			wantErr: true,
+			errStr:  errors.New("неизвестная область действия ключа: users"),
+		},
+		{
+			name: "срок действия в прошлом",
+			key: &domain.ApiKey{
+				UserID:    uuid.UUID{1},
+				Name:      "ключ",
+				Scopes:    []string{domain.ScopeFinancials},
+				ExpiresAt: time.Now().Add(-time.Hour),
+			},
+			wantErr: true,
+			errStr:  errors.New("срок действия ключа должен быть в будущем"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.beforeTest != nil {
+				tc.beforeTest(*keyRepo)
+			}
+
+			apiKey, err := svc.Create(context.Background(), tc.key)
+
+			if tc.wantErr {
+				require.Equal(t, tc.errStr.Error(), err.Error())
+			} else {
+				require.Nil(t, err)
+				require.True(t, strings.HasPrefix(apiKey, tc.key.Prefix))
+				require.Equal(t, base.HashToken(apiKey), tc.key.Hash)
+			}
+		})
+	}
+}
+
+func TestApiKeyService_Authenticate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	keyRepo := mocks.NewMockIApiKeyRepository(ctrl)
+	authRepo := mocks.NewMockIAuthRepository(ctrl)
+	svc := NewService(keyRepo, authRepo, logger.NewLogger(logger.InfoLevel, io.Discard))
+
+	testCases := []struct {
+		name       string
+		apiKey     string
+		beforeTest func(keyRepo mocks.MockIApiKeyRepository, authRepo mocks.MockIAuthRepository)
+		wantErr    bool
+		errStr     error
+	}{
+		{
+			name:   "действующий ключ",
+			apiKey: "ppo_secret",
+			beforeTest: func(keyRepo mocks.MockIApiKeyRepository, authRepo mocks.MockIAuthRepository) {
+				keyRepo.EXPECT().
+					GetByHash(context.Background(), base.HashToken("ppo_secret")).
+					Return(&domain.ApiKey{ID: uuid.UUID{2}, UserID: uuid.UUID{1}}, nil)
+
+				authRepo.EXPECT().
+					GetById(context.Background(), uuid.UUID{1}).
+					Return(&domain.UserAuth{ID: uuid.UUID{1}, Role: domain.RoleUser}, nil)
+
+				keyRepo.EXPECT().
+					Touch(context.Background(), uuid.UUID{2}).
+					Return(nil)
+			},
+		},
+		{
+			name:    "неверный формат ключа",
+			apiKey:  "secret",
+			wantErr: true,
+			errStr:  errors.New("недействительный API-ключ"),
+		},
+		{
+			name:   "отозванный или неизвестный ключ",
+			apiKey: "ppo_revoked",
+			beforeTest: func(keyRepo mocks.MockIApiKeyRepository, authRepo mocks.MockIAuthRepository) {
+				keyRepo.EXPECT().
+					GetByHash(context.Background(), base.HashToken("ppo_revoked")).
+					Return(nil, fmt.Errorf("no rows in result set"))
+			},
+			wantErr: true,
+			errStr:  errors.New("недействительный API-ключ"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.beforeTest != nil {
+				tc.beforeTest(*keyRepo, *authRepo)
+			}
+
+			key, userAuth, err := svc.Authenticate(context.Background(), tc.apiKey)
+
+			if tc.wantErr {
+				require.Equal(t, tc.errStr.Error(), err.Error())
+			} else {
+				require.Nil(t, err)
+				require.Equal(t, uuid.UUID{2}, key.ID)
+				require.Equal(t, domain.RoleUser, userAuth.Role)
+			}
+		})
+	}
+}
@@ -6,30 +6,83 @@ import (
 	"ppo/domain"
 	"ppo/pkg/base"
 	"ppo/pkg/logger"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	defaultAccessTokenTTL  = 15 * time.Minute
+	defaultRefreshTokenTTL = 30 * 24 * time.Hour
+	passwordResetTokenTTL  = time.Hour
+
+	// после стольких неудачных попыток подряд вход блокируется,
+	// каждая следующая неудача удваивает срок блокировки
+	accountFailuresBeforeLock = 5
+	ipFailuresBeforeLock      = 20
+	baseLockDuration          = time.Minute
+	maxLockDuration           = time.Hour
+	// неудачи, после которых прошло больше этого времени, не учитываются
+	failureWindow = time.Hour
+
+	// с этим хэшем сверяется пароль несуществующего пользователя, чтобы по времени ответа
+	// нельзя было определить, существует ли учётная запись
+	dummyPasswordHash = "$2a$10$zV8UrnBWsxe5DXkFZ/0lC.bOt6/qezTzDbP.eAGKw8ZsTzwHEbptK"
 )
 
 type Service struct {
-	authRepo domain.IAuthRepository
-	crypto   base.IHashCrypto
-	jwtKey   string
-	logger   logger.ILogger
+	authRepo        domain.IAuthRepository
+	sessionRepo     domain.ISessionRepository
+	resetRepo       domain.IPasswordResetRepository
+	attemptRepo     domain.ILoginAttemptRepository
+	mfaRepo         domain.IMfaRepository
+	crypto          base.IHashCrypto
+	notifier        domain.INotifier
+	keys            base.IJwtKeySet
+	accessTokenTTL  time.Duration
+	refreshTokenTTL time.Duration
+	logger          logger.ILogger
 }
 
 func NewService(
 	repo domain.IAuthRepository,
+	sessionRepo domain.ISessionRepository,
+	resetRepo domain.IPasswordResetRepository,
+	attemptRepo domain.ILoginAttemptRepository,
+	mfaRepo domain.IMfaRepository,
 	crypto base.IHashCrypto,
-	jwtKey string,
+	notifier domain.INotifier,
+	keys base.IJwtKeySet,
+	accessTokenTTL time.Duration,
+	refreshTokenTTL time.Duration,
 	logger logger.ILogger,
 ) domain.IAuthService {
+	if accessTokenTTL <= 0 {
+		accessTokenTTL = defaultAccessTokenTTL
+	}
+
+	if refreshTokenTTL <= 0 {
+		refreshTokenTTL = defaultRefreshTokenTTL
+	}
+
 	return &Service{
-		authRepo: repo,
-		crypto:   crypto,
-		jwtKey:   jwtKey,
-		logger:   logger,
+		authRepo:        repo,
+		sessionRepo:     sessionRepo,
+		resetRepo:       resetRepo,
+		attemptRepo:     attemptRepo,
+		mfaRepo:         mfaRepo,
+		crypto:          crypto,
+		notifier:        notifier,
+		keys:            keys,
+		accessTokenTTL:  accessTokenTTL,
+		refreshTokenTTL: refreshTokenTTL,
+		logger:          logger,
 	}
 }
 
-func (s *Service) Register(ctx context.Context, authInfo *domain.UserAuth) (err error) {
+// Register создаёт учётную запись вместе с полным профилем предпринимателя.
+func (s *Service) Register(ctx context.Context, authInfo *domain.UserAuth, profile *domain.User) (err error) {
 	prompt := "AuthRegister"
 	if authInfo.Username == "" {
 		s.logger.Infof("%s: должно быть указано имя пользователя", prompt)
@@ -41,6 +94,12 @@ func (s *Service) Register(ctx context.Context, authInfo *domain.UserAuth) (err
 		return fmt.Errorf("должен быть указан пароль")
 	}
 
+	err = profile.ValidateProfile()
+	if err != nil {
+		s.logger.Infof("%s: %v", prompt, err)
+		return err
+	}
+
 	hashedPass, err := s.crypto.GenerateHashPass(authInfo.Password)
 	if err != nil {
 		s.logger.Infof("%s: генерация хэша: %v", prompt, err)
@@ -49,7 +108,7 @@ func (s *Service) Register(ctx context.Context, authInfo *domain.UserAuth) (err
 
 	authInfo.HashedPass = hashedPass
 
-	err = s.authRepo.Register(ctx, authInfo)
+	err = s.authRepo.Register(ctx, authInfo, profile)
 	if err != nil {
 		s.logger.Infof("%s: регистрация пользователя: %v", prompt, err)
 		return fmt.Errorf("регистрация пользователя: %w", err)
@@ -58,41 +117,392 @@ func (s *Service) Register(ctx context.Context, authInfo *domain.UserAuth) (err
 	return nil
 }
 
-func (s *Service) Login(ctx context.Context, authInfo *domain.UserAuth) (token string, err error) {
+// Login проверяет пароль. Если у пользователя включена двухфакторная аутентификация, вместо токенов
+// возвращается токен ожидания второго фактора, который обменивается на токены в VerifyMfa.
+func (s *Service) Login(ctx context.Context, authInfo *domain.UserAuth, ip string) (result *domain.LoginResult, err error) {
 	prompt := "AuthLogin"
 
 	if authInfo.Username == "" {
 		s.logger.Infof("%s: должно быть указано имя пользователя", prompt)
-		return "", fmt.Errorf("должно быть указано имя пользователя")
+		return nil, fmt.Errorf("должно быть указано имя пользователя")
 	}
 
 	if authInfo.Password == "" {
 		s.logger.Infof("%s: должен быть указан пароль", prompt)
-		return "", fmt.Errorf("должен быть указан пароль")
+		return nil, fmt.Errorf("должен быть указан пароль")
+	}
+
+	keys := loginAttemptKeys(authInfo.Username, ip)
+	for _, key := range keys {
+		attempts, err := s.attemptRepo.Get(ctx, key.key)
+		if err != nil {
+			s.logger.Infof("%s: %v", prompt, err)
+			return nil, fmt.Errorf("проверка блокировки входа: %w", err)
+		}
+
+		if time.Now().Before(attempts.LockedUntil) {
+			s.logger.Infof("%s: вход по ключу %s заблокирован до %s", prompt, key.key, attempts.LockedUntil)
+			return nil, domain.ErrLoginLocked
+		}
 	}
 
 	userAuth, err := s.authRepo.GetByUsername(ctx, authInfo.Username)
 	if err != nil {
 		s.logger.Infof("%s: получение пользователя по username: %v", prompt, err)
-		return "", fmt.Errorf("получение пользователя по username: %w", err)
+		s.crypto.CheckPasswordHash(authInfo.Password, dummyPasswordHash)
+		s.registerLoginFailure(ctx, prompt, keys)
+		return nil, domain.ErrInvalidCredentials
 	}
 
 	if !s.crypto.CheckPasswordHash(authInfo.Password, userAuth.HashedPass) {
 		s.logger.Infof("%s: неверный пароль", prompt)
-		return "", fmt.Errorf("неверный пароль")
+		s.registerLoginFailure(ctx, prompt, keys)
+		return nil, domain.ErrInvalidCredentials
+	}
+
+	// счётчик по IP не сбрасывается: иначе вход в свою учётную запись позволял бы
+	// перебирать пароли чужих с того же адреса
+	err = s.attemptRepo.Reset(ctx, keys[0].key)
+	if err != nil {
+		s.logger.Infof("%s: %v", prompt, err)
+	}
+
+	mfa, err := s.mfaRepo.GetByUserId(ctx, userAuth.ID)
+	if err != nil {
+		s.logger.Infof("%s: %v", prompt, err)
+		return nil, err
+	}
+
+	if mfa != nil && !mfa.ConfirmedAt.IsZero() {
+		mfaToken, err := base.GenerateMfaPendingToken(userAuth.ID.String(), mfaPendingTokenTTL, s.keys)
+		if err != nil {
+			s.logger.Infof("%s: генерация токена ожидания второго фактора: %v", prompt, err)
+			return nil, fmt.Errorf("генерация токена ожидания второго фактора: %w", err)
+		}
+
+		return &domain.LoginResult{MfaToken: mfaToken}, nil
+	}
+
+	tokens, err := s.startSession(ctx, userAuth)
+	if err != nil {
+		s.logger.Infof("%s: %v", prompt, err)
+		return nil, err
+	}
+
+	return &domain.LoginResult{Tokens: tokens}, nil
+}
+
+func (s *Service) startSession(ctx context.Context, userAuth *domain.UserAuth) (tokens *domain.TokenPair, err error) {
+	sessionId, err := s.sessionRepo.Create(ctx, userAuth.ID)
+	if err != nil {
+		return nil, fmt.Errorf("создание сессии: %w", err)
+	}
+
+	return s.issueTokens(ctx, userAuth, sessionId)
+}
+
+type loginAttemptKey struct {
+	key             string
+	failuresForLock int
+}
+
+// loginAttemptKeys возвращает ключи, по которым считаются неудачные попытки входа: первым - ключ
+// учётной записи (он заводится и для несуществующих имён, чтобы блокировка не выдавала их отсутствие).
+func loginAttemptKeys(username, ip string) (keys []loginAttemptKey) {
+	keys = []loginAttemptKey{{key: "user:" + strings.ToLower(username), failuresForLock: accountFailuresBeforeLock}}
+	if ip != "" {
+		keys = append(keys, loginAttemptKey{key: "ip:" + ip, failuresForLock: ipFailuresBeforeLock})
+	}
+
+	return keys
+}
+
+func lockDuration(failures, failuresForLock int) time.Duration {
+	if failures < failuresForLock {
+		return 0
+	}
+
+	d := baseLockDuration
+	for i := failuresForLock; i < failures && d < maxLockDuration; i++ {
+		d *= 2
+	}
+
+	return min(d, maxLockDuration)
+}
+
+// registerLoginFailure учитывает неудачную попытку по всем ключам. Ошибки только логируются:
+// пользователь в любом случае получает ответ о неверных учётных данных.
+func (s *Service) registerLoginFailure(ctx context.Context, prompt string, keys []loginAttemptKey) {
+	for _, key := range keys {
+		failures, err := s.attemptRepo.RegisterFailure(ctx, key.key, time.Now().Add(-failureWindow))
+		if err != nil {
+			s.logger.Infof("%s: %v", prompt, err)
+			continue
+		}
+
+		d := lockDuration(failures, key.failuresForLock)
+		if d == 0 {
+			continue
+		}
+
+		s.logger.Warnf("%s: вход по ключу %s заблокирован на %s после %d неудачных попыток", prompt, key.key, d, failures)
+		err = s.attemptRepo.Lock(ctx, key.key, time.Now().Add(d))
+		if err != nil {
+			s.logger.Infof("%s: %v", prompt, err)
+		}
+	}
+}
+
+// issueTokens выдаёт access-токен сессии и следующий refresh-токен её семейства.
+func (s *Service) issueTokens(ctx context.Context, userAuth *domain.UserAuth, sessionId uuid.UUID) (tokens *domain.TokenPair, err error) {
+	accessToken, err := base.GenerateAuthToken(userAuth.ID.String(), userAuth.Role, sessionId.String(), s.accessTokenTTL, s.keys)
+	if err != nil {
+		return nil, fmt.Errorf("генерация токена: %w", err)
+	}
+
+	_, err = base.VerifyAuthToken(accessToken, s.keys)
+	if err != nil {
+		return nil, fmt.Errorf("проверка JWT-токена: %w", err)
+	}
+
+	refreshToken, hash, err := base.GenerateToken()
+	if err != nil {
+		return nil, fmt.Errorf("генерация refresh-токена: %w", err)
+	}
+
+	err = s.sessionRepo.CreateRefreshToken(ctx, &domain.RefreshToken{
+		SessionID: sessionId,
+		UserID:    userAuth.ID,
+		Hash:      hash,
+		ExpiresAt: time.Now().Add(s.refreshTokenTTL),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("сохранение refresh-токена: %w", err)
+	}
+
+	return &domain.TokenPair{
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+	}, nil
+}
+
+func (s *Service) Refresh(ctx context.Context, refreshToken string) (tokens *domain.TokenPair, err error) {
+	prompt := "AuthRefresh"
+
+	if refreshToken == "" {
+		s.logger.Infof("%s: должен быть указан refresh-токен", prompt)
+		return nil, fmt.Errorf("должен быть указан refresh-токен")
+	}
+
+	stored, err := s.sessionRepo.GetRefreshTokenByHash(ctx, base.HashToken(refreshToken))
+	if err != nil {
+		s.logger.Infof("%s: получение refresh-токена: %v", prompt, err)
+		return nil, fmt.Errorf("недействительный refresh-токен")
+	}
+
+	// предъявление уже использованного токена означает, что он мог быть похищен:
+	// отзывается вся сессия, чтобы ни одна из сторон не смогла продолжить ею пользоваться
+	used := stored.Used
+	if !used {
+		ok, err := s.sessionRepo.UseRefreshToken(ctx, stored.ID)
+		if err != nil {
+			s.logger.Infof("%s: использование refresh-токена: %v", prompt, err)
+			return nil, fmt.Errorf("использование refresh-токена: %w", err)
+		}
+		used = !ok
+	}
+
+	if used {
+		s.logger.Infof("%s: повторное использование refresh-токена сессии %s", prompt, stored.SessionID)
+
+		err = s.sessionRepo.Revoke(ctx, stored.SessionID)
+		if err != nil {
+			s.logger.Infof("%s: отзыв сессии: %v", prompt, err)
+			return nil, fmt.Errorf("отзыв сессии: %w", err)
+		}
+
+		return nil, fmt.Errorf("недействительный refresh-токен")
+	}
+
+	if time.Now().After(stored.ExpiresAt) {
+		s.logger.Infof("%s: срок действия refresh-токена истёк", prompt)
+		return nil, fmt.Errorf("срок действия refresh-токена истёк")
+	}
+
+	active, err := s.sessionRepo.IsActive(ctx, stored.SessionID)
+	if err != nil {
+		s.logger.Infof("%s: проверка активности сессии: %v", prompt, err)
+		return nil, fmt.Errorf("проверка активности сессии: %w", err)
+	}
+
+	if !active {
+		s.logger.Infof("%s: сессия %s отозвана", prompt, stored.SessionID)
+		return nil, fmt.Errorf("сессия отозвана")
+	}
+
+	userAuth, err := s.authRepo.GetById(ctx, stored.UserID)
+	if err != nil {
+		s.logger.Infof("%s: получение пользователя по id: %v", prompt, err)
+		return nil, fmt.Errorf("получение пользователя по id: %w", err)
+	}
+
+	tokens, err = s.issueTokens(ctx, userAuth, stored.SessionID)
+	if err != nil {
+		s.logger.Infof("%s: %v", prompt, err)
+		return nil, err
+	}
+
+	return tokens, nil
+}
+
+func (s *Service) Logout(ctx context.Context, sessionId uuid.UUID) (err error) {
+	prompt := "AuthLogout"
+
+	err = s.sessionRepo.Revoke(ctx, sessionId)
+	if err != nil {
+		s.logger.Infof("%s: отзыв сессии: %v", prompt, err)
+		return fmt.Errorf("отзыв сессии: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Service) IsSessionActive(ctx context.Context, sessionId uuid.UUID) (active bool, err error) {
+	prompt := "AuthIsSessionActive"
+
+	active, err = s.sessionRepo.IsActive(ctx, sessionId)
+	if err != nil {
+		s.logger.Infof("%s: проверка активности сессии: %v", prompt, err)
+		return false, fmt.Errorf("проверка активности сессии: %w", err)
+	}
+
+	return active, nil
+}
+
+func (s *Service) ChangePassword(ctx context.Context, userId uuid.UUID, oldPassword, newPassword string) (err error) {
+	prompt := "AuthChangePassword"
+
+	if oldPassword == "" {
+		s.logger.Infof("%s: должен быть указан текущий пароль", prompt)
+		return fmt.Errorf("должен быть указан текущий пароль")
+	}
+
+	if newPassword == "" {
+		s.logger.Infof("%s: должен быть указан новый пароль", prompt)
+		return fmt.Errorf("должен быть указан новый пароль")
+	}
+
+	userAuth, err := s.authRepo.GetById(ctx, userId)
+	if err != nil {
+		s.logger.Infof("%s: получение пользователя по id: %v", prompt, err)
+		return fmt.Errorf("получение пользователя по id: %w", err)
+	}
+
+	if !s.crypto.CheckPasswordHash(oldPassword, userAuth.HashedPass) {
+		s.logger.Infof("%s: неверный пароль", prompt)
+		return fmt.Errorf("неверный пароль")
 	}
 
-	token, err = base.GenerateAuthToken(userAuth.ID.String(), s.jwtKey, userAuth.Role)
+	err = s.setPassword(ctx, userId, newPassword)
 	if err != nil {
-		s.logger.Infof("%s: генерация токена: %v", prompt, err)
-		return "", fmt.Errorf("генерация токена: %w", err)
+		s.logger.Infof("%s: %v", prompt, err)
+		return err
 	}
 
-	_, err = base.VerifyAuthToken(token, s.jwtKey)
+	return nil
+}
+
+func (s *Service) setPassword(ctx context.Context, userId uuid.UUID, password string) (err error) {
+	hashedPass, err := s.crypto.GenerateHashPass(password)
 	if err != nil {
-		s.logger.Infof("%s: проверка JWT-токена: %v", prompt, err)
-		return "", fmt.Errorf("%s: проверка JWT-токена: %w", prompt, err)
+		return fmt.Errorf("генерация хэша: %w", err)
 	}
 
-	return token, nil
+	err = s.authRepo.UpdatePassword(ctx, userId, hashedPass)
+	if err != nil {
+		return fmt.Errorf("обновление пароля: %w", err)
+	}
+
+	return nil
+}
+
+// RequestPasswordReset отправляет пользователю токен сброса пароля. Для несуществующего
+// пользователя ошибка не возвращается, чтобы по ответу нельзя было проверить наличие учётной записи.
+func (s *Service) RequestPasswordReset(ctx context.Context, username string) (err error) {
+	prompt := "AuthRequestPasswordReset"
+
+	if username == "" {
+		s.logger.Infof("%s: должно быть указано имя пользователя", prompt)
+		return fmt.Errorf("должно быть указано имя пользователя")
+	}
+
+	userAuth, err := s.authRepo.GetByUsername(ctx, username)
+	if err != nil {
+		s.logger.Infof("%s: получение пользователя по username: %v", prompt, err)
+		return nil
+	}
+
+	token, hash, err := base.GenerateToken()
+	if err != nil {
+		s.logger.Infof("%s: генерация токена сброса пароля: %v", prompt, err)
+		return fmt.Errorf("генерация токена сброса пароля: %w", err)
+	}
+
+	err = s.resetRepo.Create(ctx, &domain.PasswordResetToken{
+		UserID:    userAuth.ID,
+		Hash:      hash,
+		ExpiresAt: time.Now().Add(passwordResetTokenTTL),
+	})
+	if err != nil {
+		s.logger.Infof("%s: сохранение токена сброса пароля: %v", prompt, err)
+		return fmt.Errorf("сохранение токена сброса пароля: %w", err)
+	}
+
+	err = s.notifier.Notify(ctx, &domain.Notification{
+		Recipient: username,
+		Subject:   "Сброс пароля",
+		Body: fmt.Sprintf("Для сброса пароля используйте токен %s. Токен действителен %s.",
+			token, passwordResetTokenTTL),
+	})
+	if err != nil {
+		s.logger.Infof("%s: отправка уведомления: %v", prompt, err)
+		return fmt.Errorf("отправка уведомления: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Service) ResetPassword(ctx context.Context, token, newPassword string) (err error) {
+	prompt := "AuthResetPassword"
+
+	if token == "" {
+		s.logger.Infof("%s: должен быть указан токен сброса пароля", prompt)
+		return fmt.Errorf("должен быть указан токен сброса пароля")
+	}
+
+	if newPassword == "" {
+		s.logger.Infof("%s: должен быть указан новый пароль", prompt)
+		return fmt.Errorf("должен быть указан новый пароль")
+	}
+
+	resetToken, err := s.resetRepo.Use(ctx, base.HashToken(token))
+	if err != nil {
+		s.logger.Infof("%s: использование токена сброса пароля: %v", prompt, err)
+		return fmt.Errorf("недействительный или просроченный токен сброса пароля")
+	}
+
+	err = s.setPassword(ctx, resetToken.UserID, newPassword)
+	if err != nil {
+		s.logger.Infof("%s: %v", prompt, err)
+		return err
+	}
+
+	// после сброса пароля все ранее выданные сессии считаются скомпрометированными
+	err = s.sessionRepo.RevokeByUserId(ctx, resetToken.UserID)
+	if err != nil {
+		s.logger.Infof("%s: отзыв сессий пользователя: %v", prompt, err)
+		return fmt.Errorf("отзыв сессий пользователя: %w", err)
+	}
+
+	return nil
 }
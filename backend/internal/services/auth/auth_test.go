@@ -4,27 +4,37 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
+	"io"
 	"ppo/domain"
 	"ppo/mocks"
 	"ppo/pkg/base"
+	"ppo/pkg/logger"
 	"testing"
+	"time"
 )
 
 func TestAuthService_Login(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
-	jwtKey := "abcdefgh123"
+	keys := newTestKeySet(t)
 	repo := mocks.NewMockIAuthRepository(ctrl)
+	sessionRepo := mocks.NewMockISessionRepository(ctrl)
+	resetRepo := mocks.NewMockIPasswordResetRepository(ctrl)
 	crypto := mocks.NewMockIHashCrypto(ctrl)
-	svc := NewService(repo, crypto, jwtKey)
+	notifier := mocks.NewMockINotifier(ctrl)
+	attemptRepo := mocks.NewMockILoginAttemptRepository(ctrl)
+	mfaRepo := mocks.NewMockIMfaRepository(ctrl)
+	svc := NewService(repo, sessionRepo, resetRepo, attemptRepo, mfaRepo, crypto, notifier, keys, 0, 0, logger.NewLogger(logger.InfoLevel, io.Discard))
 
 	testCases := []struct {
 		name       string
 		authInfo   *domain.UserAuth
-		beforeTest func(authRepo mocks.MockIAuthRepository, crypto mocks.MockIHashCrypto)
+		beforeTest func(authRepo mocks.MockIAuthRepository, sessionRepo mocks.MockISessionRepository, attemptRepo mocks.MockILoginAttemptRepository, crypto mocks.MockIHashCrypto)
+		wantMfa    bool
 		wantErr    bool
 		errStr     error
 	}{
@@ -34,7 +44,9 @@ func TestAuthService_Login(t *testing.T) {
 				Username: "test123",
 				Password: "pass123",
 			},
-			beforeTest: func(authRepo mocks.MockIAuthRepository, crypto mocks.MockIHashCrypto) {
+			beforeTest: func(authRepo mocks.MockIAuthRepository, sessionRepo mocks.MockISessionRepository, attemptRepo mocks.MockILoginAttemptRepository, crypto mocks.MockIHashCrypto) {
+				expectNotLocked(attemptRepo)
+
 				authRepo.EXPECT().
 					GetByUsername(
 						context.Background(),
@@ -49,9 +61,63 @@ func TestAuthService_Login(t *testing.T) {
 				crypto.EXPECT().
 					CheckPasswordHash("pass123", "hashedPass123").
 					Return(true)
+
+				attemptRepo.EXPECT().
+					Reset(context.Background(), "user:test123").
+					Return(nil)
+
+				mfaRepo.EXPECT().
+					GetByUserId(context.Background(), uuid.UUID{}).
+					Return(nil, nil)
+
+				sessionRepo.EXPECT().
+					Create(context.Background(), gomock.Any()).
+					Return(uuid.UUID{1}, nil)
+
+				sessionRepo.EXPECT().
+					CreateRefreshToken(context.Background(), gomock.Any()).
+					Return(nil)
 			},
 			wantErr: false,
 		},
+		{
+			name: "требуется второй фактор",
+			authInfo: &domain.UserAuth{
+				Username: "test123",
+				Password: "pass123",
+			},
+			beforeTest: func(authRepo mocks.MockIAuthRepository, sessionRepo mocks.MockISessionRepository, attemptRepo mocks.MockILoginAttemptRepository, crypto mocks.MockIHashCrypto) {
+				expectNotLocked(attemptRepo)
+
+				authRepo.EXPECT().
+					GetByUsername(
+						context.Background(),
+						"test123",
+					).
+					Return(&domain.UserAuth{
+						ID:         uuid.UUID{2},
+						Username:   "test123",
+						HashedPass: "hashedPass123",
+					}, nil)
+
+				crypto.EXPECT().
+					CheckPasswordHash("pass123", "hashedPass123").
+					Return(true)
+
+				attemptRepo.EXPECT().
+					Reset(context.Background(), "user:test123").
+					Return(nil)
+
+				mfaRepo.EXPECT().
+					GetByUserId(context.Background(), uuid.UUID{2}).
+					Return(&domain.UserMfa{
+						UserID:      uuid.UUID{2},
+						Secret:      "secret",
+						ConfirmedAt: time.Now(),
+					}, nil)
+			},
+			wantMfa: true,
+		},
 		{
 			name: "пустое имя пользователя",
 			authInfo: &domain.UserAuth{
@@ -67,16 +133,24 @@ func TestAuthService_Login(t *testing.T) {
 				Username: "test123",
 				Password: "pass123",
 			},
-			beforeTest: func(authRepo mocks.MockIAuthRepository, crypto mocks.MockIHashCrypto) {
+			beforeTest: func(authRepo mocks.MockIAuthRepository, sessionRepo mocks.MockISessionRepository, attemptRepo mocks.MockILoginAttemptRepository, crypto mocks.MockIHashCrypto) {
+				expectNotLocked(attemptRepo)
+
 				authRepo.EXPECT().
 					GetByUsername(
 						context.Background(),
 						"test123",
 					).
 					Return(nil, fmt.Errorf("sql error"))
+
+				crypto.EXPECT().
+					CheckPasswordHash("pass123", dummyPasswordHash).
+					Return(false)
+
+				expectFailures(attemptRepo, 1, 1)
 			},
 			wantErr: true,
-			errStr:  errors.New("получение пользователя по username: sql error"),
+			errStr:  domain.ErrInvalidCredentials,
 		},
 		{
 			name: "неверный пароль",
@@ -84,7 +158,9 @@ func TestAuthService_Login(t *testing.T) {
 				Username: "test123",
 				Password: "pass123",
 			},
-			beforeTest: func(authRepo mocks.MockIAuthRepository, crypto mocks.MockIHashCrypto) {
+			beforeTest: func(authRepo mocks.MockIAuthRepository, sessionRepo mocks.MockISessionRepository, attemptRepo mocks.MockILoginAttemptRepository, crypto mocks.MockIHashCrypto) {
+				expectNotLocked(attemptRepo)
+
 				authRepo.EXPECT().
 					GetByUsername(
 						context.Background(),
@@ -99,26 +175,116 @@ func TestAuthService_Login(t *testing.T) {
 				crypto.EXPECT().
 					CheckPasswordHash("pass123", "hashedPass123").
 					Return(false)
+
+				expectFailures(attemptRepo, 1, 1)
 			},
 			wantErr: true,
-			errStr:  errors.New("неверный пароль"),
+			errStr:  domain.ErrInvalidCredentials,
+		},
+		{
+			name: "блокировка после пятой неудачной попытки",
+			authInfo: &domain.UserAuth{
+				Username: "Test123",
+				Password: "pass123",
+			},
+			beforeTest: func(authRepo mocks.MockIAuthRepository, sessionRepo mocks.MockISessionRepository, attemptRepo mocks.MockILoginAttemptRepository, crypto mocks.MockIHashCrypto) {
+				expectNotLocked(attemptRepo)
+
+				authRepo.EXPECT().
+					GetByUsername(
+						context.Background(),
+						"Test123",
+					).
+					Return(&domain.UserAuth{
+						Username:   "test123",
+						HashedPass: "hashedPass123",
+					}, nil)
+
+				crypto.EXPECT().
+					CheckPasswordHash("pass123", "hashedPass123").
+					Return(false)
+
+				expectFailures(attemptRepo, 5, 1)
+
+				attemptRepo.EXPECT().
+					Lock(context.Background(), "user:test123", gomock.Any()).
+					DoAndReturn(func(ctx context.Context, key string, until time.Time) error {
+						require.WithinDuration(t, time.Now().Add(baseLockDuration), until, time.Second)
+						return nil
+					})
+			},
+			wantErr: true,
+			errStr:  domain.ErrInvalidCredentials,
+		},
+		{
+			name: "учётная запись заблокирована",
+			authInfo: &domain.UserAuth{
+				Username: "test123",
+				Password: "pass123",
+			},
+			beforeTest: func(authRepo mocks.MockIAuthRepository, sessionRepo mocks.MockISessionRepository, attemptRepo mocks.MockILoginAttemptRepository, crypto mocks.MockIHashCrypto) {
+				attemptRepo.EXPECT().
+					Get(context.Background(), "user:test123").
+					Return(&domain.LoginAttempts{
+						Key:         "user:test123",
+						Failures:    5,
+						LockedUntil: time.Now().Add(time.Minute),
+					}, nil)
+			},
+			wantErr: true,
+			errStr:  domain.ErrLoginLocked,
+		},
+		{
+			name: "адрес заблокирован",
+			authInfo: &domain.UserAuth{
+				Username: "test123",
+				Password: "pass123",
+			},
+			beforeTest: func(authRepo mocks.MockIAuthRepository, sessionRepo mocks.MockISessionRepository, attemptRepo mocks.MockILoginAttemptRepository, crypto mocks.MockIHashCrypto) {
+				attemptRepo.EXPECT().
+					Get(context.Background(), "user:test123").
+					Return(&domain.LoginAttempts{Key: "user:test123"}, nil)
+
+				attemptRepo.EXPECT().
+					Get(context.Background(), "ip:10.0.0.1").
+					Return(&domain.LoginAttempts{
+						Key:         "ip:10.0.0.1",
+						Failures:    20,
+						LockedUntil: time.Now().Add(time.Minute),
+					}, nil)
+			},
+			wantErr: true,
+			errStr:  domain.ErrLoginLocked,
 		},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			ctx := context.Background()
 			if tc.beforeTest != nil {
-				tc.beforeTest(*repo, *crypto)
+				tc.beforeTest(*repo, *sessionRepo, *attemptRepo, *crypto)
 			}
 
-			token, err := svc.Login(ctx, tc.authInfo)
-			_, verifErr := base.VerifyAuthToken(token, jwtKey)
+			result, err := svc.Login(ctx, tc.authInfo, "10.0.0.1")
 
 			if tc.wantErr {
 				require.Equal(t, tc.errStr.Error(), err.Error())
+			} else if tc.wantMfa {
+				require.Nil(t, err)
+				require.Nil(t, result.Tokens)
+
+				id, verifErr := base.VerifyMfaPendingToken(result.MfaToken, keys)
+				require.Nil(t, verifErr)
+				require.Equal(t, uuid.UUID{2}.String(), id)
+
+				_, verifErr = base.VerifyAuthToken(result.MfaToken, keys)
+				require.NotNil(t, verifErr)
 			} else {
 				require.Nil(t, err)
+
+				payload, verifErr := base.VerifyAuthToken(result.Tokens.AccessToken, keys)
 				require.Nil(t, verifErr)
+				require.Equal(t, uuid.UUID{1}.String(), payload.SessionID)
+				require.NotEmpty(t, result.Tokens.RefreshToken)
 			}
 		})
 	}
@@ -129,12 +295,26 @@ func TestAuthService_Register(t *testing.T) {
 	defer ctrl.Finish()
 
 	repo := mocks.NewMockIAuthRepository(ctrl)
+	sessionRepo := mocks.NewMockISessionRepository(ctrl)
+	resetRepo := mocks.NewMockIPasswordResetRepository(ctrl)
 	crypto := mocks.NewMockIHashCrypto(ctrl)
-	svc := NewService(repo, crypto, "abcdefgh123")
+	notifier := mocks.NewMockINotifier(ctrl)
+	attemptRepo := mocks.NewMockILoginAttemptRepository(ctrl)
+	mfaRepo := mocks.NewMockIMfaRepository(ctrl)
+	svc := NewService(repo, sessionRepo, resetRepo, attemptRepo, mfaRepo, crypto, notifier, newTestKeySet(t), 0, 0, logger.NewLogger(logger.InfoLevel, io.Discard))
+
+	profile := &domain.User{
+		Username: "test123",
+		FullName: "Иванов Иван Иванович",
+		Gender:   "m",
+		Birthday: time.Date(1990, 1, 1, 0, 0, 0, 0, time.UTC),
+		City:     "Москва",
+	}
 
 	testCases := []struct {
 		name       string
 		authInfo   *domain.UserAuth
+		profile    *domain.User
 		beforeTest func(authRepo mocks.MockIAuthRepository, crypto mocks.MockIHashCrypto)
 		expected   *domain.UserAuth
 		wantErr    bool
@@ -146,6 +326,7 @@ func TestAuthService_Register(t *testing.T) {
 				Username: "test123",
 				Password: "pass123",
 			},
+			profile: profile,
 			beforeTest: func(authRepo mocks.MockIAuthRepository, crypto mocks.MockIHashCrypto) {
 				crypto.EXPECT().
 					GenerateHashPass("pass123").
@@ -159,6 +340,7 @@ func TestAuthService_Register(t *testing.T) {
 							Password:   "pass123",
 							HashedPass: "hashedPass123",
 						},
+						profile,
 					).
 					Return(nil)
 			},
@@ -175,6 +357,7 @@ func TestAuthService_Register(t *testing.T) {
 				Username: "",
 				Password: "pass123",
 			},
+			profile: profile,
 			wantErr: true,
 			errStr:  errors.New("должно быть указано имя пользователя"),
 		},
@@ -184,15 +367,32 @@ func TestAuthService_Register(t *testing.T) {
 				Username: "test123",
 				Password: "",
 			},
+			profile: profile,
 			wantErr: true,
 			errStr:  errors.New("должен быть указан пароль"),
 		},
+		{
+			name: "не заполнен профиль",
+			authInfo: &domain.UserAuth{
+				Username: "test123",
+				Password: "pass123",
+			},
+			profile: &domain.User{
+				Username: "test123",
+				FullName: "Иванов Иван Иванович",
+				Gender:   "m",
+				Birthday: time.Date(1990, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+			wantErr: true,
+			errStr:  errors.New("должно быть указано название города"),
+		},
 		{
 			name: "ошибка выполнения запроса в репозитории",
 			authInfo: &domain.UserAuth{
 				Username: "test123",
 				Password: "pass123",
 			},
+			profile: profile,
 			beforeTest: func(authRepo mocks.MockIAuthRepository, crypto mocks.MockIHashCrypto) {
 				crypto.EXPECT().
 					GenerateHashPass("pass123").
@@ -206,6 +406,7 @@ func TestAuthService_Register(t *testing.T) {
 							Password:   "pass123",
 							HashedPass: "hashedPass123",
 						},
+						profile,
 					).
 					Return(fmt.Errorf("sql error"))
 			},
@@ -220,7 +421,267 @@ func TestAuthService_Register(t *testing.T) {
 				tc.beforeTest(*repo, *crypto)
 			}
 
-			err := svc.Register(ctx, tc.authInfo)
+			err := svc.Register(ctx, tc.authInfo, tc.profile)
+
+			if tc.wantErr {
+				require.Equal(t, tc.errStr.Error(), err.Error())
+			} else {
+				require.Nil(t, err)
+			}
+		})
+	}
+}
+
+func TestAuthService_Refresh(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	keys := newTestKeySet(t)
+	repo := mocks.NewMockIAuthRepository(ctrl)
+	sessionRepo := mocks.NewMockISessionRepository(ctrl)
+	resetRepo := mocks.NewMockIPasswordResetRepository(ctrl)
+	crypto := mocks.NewMockIHashCrypto(ctrl)
+	notifier := mocks.NewMockINotifier(ctrl)
+	attemptRepo := mocks.NewMockILoginAttemptRepository(ctrl)
+	mfaRepo := mocks.NewMockIMfaRepository(ctrl)
+	svc := NewService(repo, sessionRepo, resetRepo, attemptRepo, mfaRepo, crypto, notifier, keys, 0, 0, logger.NewLogger(logger.InfoLevel, io.Discard))
+
+	testCases := []struct {
+		name         string
+		refreshToken string
+		beforeTest   func(authRepo mocks.MockIAuthRepository, sessionRepo mocks.MockISessionRepository)
+		wantErr      bool
+		errStr       error
+	}{
+		{
+			name:         "успешное обновление",
+			refreshToken: "token1",
+			beforeTest: func(authRepo mocks.MockIAuthRepository, sessionRepo mocks.MockISessionRepository) {
+				sessionRepo.EXPECT().
+					GetRefreshTokenByHash(context.Background(), base.HashToken("token1")).
+					Return(&domain.RefreshToken{
+						ID:        uuid.UUID{1},
+						SessionID: uuid.UUID{2},
+						UserID:    uuid.UUID{3},
+						ExpiresAt: time.Now().Add(time.Hour),
+					}, nil)
+
+				sessionRepo.EXPECT().
+					UseRefreshToken(context.Background(), uuid.UUID{1}).
+					Return(true, nil)
+
+				sessionRepo.EXPECT().
+					IsActive(context.Background(), uuid.UUID{2}).
+					Return(true, nil)
+
+				authRepo.EXPECT().
+					GetById(context.Background(), uuid.UUID{3}).
+					Return(&domain.UserAuth{ID: uuid.UUID{3}, Role: "user"}, nil)
+
+				sessionRepo.EXPECT().
+					CreateRefreshToken(context.Background(), gomock.Any()).
+					Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name:         "повторное использование токена",
+			refreshToken: "token2",
+			beforeTest: func(authRepo mocks.MockIAuthRepository, sessionRepo mocks.MockISessionRepository) {
+				sessionRepo.EXPECT().
+					GetRefreshTokenByHash(context.Background(), base.HashToken("token2")).
+					Return(&domain.RefreshToken{
+						ID:        uuid.UUID{1},
+						SessionID: uuid.UUID{2},
+						UserID:    uuid.UUID{3},
+						ExpiresAt: time.Now().Add(time.Hour),
+						Used:      true,
+					}, nil)
+
+				sessionRepo.EXPECT().
+					Revoke(context.Background(), uuid.UUID{2}).
+					Return(nil)
+			},
+			wantErr: true,
+			errStr:  errors.New("недействительный refresh-токен"),
+		},
+		{
+			name:         "токен использован параллельным запросом",
+			refreshToken: "token3",
+			beforeTest: func(authRepo mocks.MockIAuthRepository, sessionRepo mocks.MockISessionRepository) {
+				sessionRepo.EXPECT().
+					GetRefreshTokenByHash(context.Background(), base.HashToken("token3")).
+					Return(&domain.RefreshToken{
+						ID:        uuid.UUID{1},
+						SessionID: uuid.UUID{2},
+						UserID:    uuid.UUID{3},
+						ExpiresAt: time.Now().Add(time.Hour),
+					}, nil)
+
+				sessionRepo.EXPECT().
+					UseRefreshToken(context.Background(), uuid.UUID{1}).
+					Return(false, nil)
+
+				sessionRepo.EXPECT().
+					Revoke(context.Background(), uuid.UUID{2}).
+					Return(nil)
+			},
+			wantErr: true,
+			errStr:  errors.New("недействительный refresh-токен"),
+		},
+		{
+			name:         "истёк срок действия",
+			refreshToken: "token4",
+			beforeTest: func(authRepo mocks.MockIAuthRepository, sessionRepo mocks.MockISessionRepository) {
+				sessionRepo.EXPECT().
+					GetRefreshTokenByHash(context.Background(), base.HashToken("token4")).
+					Return(&domain.RefreshToken{
+						ID:        uuid.UUID{1},
+						SessionID: uuid.UUID{2},
+						UserID:    uuid.UUID{3},
+						ExpiresAt: time.Now().Add(-time.Hour),
+					}, nil)
+
+				sessionRepo.EXPECT().
+					UseRefreshToken(context.Background(), uuid.UUID{1}).
+					Return(true, nil)
+			},
+			wantErr: true,
+			errStr:  errors.New("срок действия refresh-токена истёк"),
+		},
+		{
+			name:         "сессия отозвана",
+			refreshToken: "token5",
+			beforeTest: func(authRepo mocks.MockIAuthRepository, sessionRepo mocks.MockISessionRepository) {
+				sessionRepo.EXPECT().
+					GetRefreshTokenByHash(context.Background(), base.HashToken("token5")).
+					Return(&domain.RefreshToken{
+						ID:        uuid.UUID{1},
+						SessionID: uuid.UUID{2},
+						UserID:    uuid.UUID{3},
+						ExpiresAt: time.Now().Add(time.Hour),
+					}, nil)
+
+				sessionRepo.EXPECT().
+					UseRefreshToken(context.Background(), uuid.UUID{1}).
+					Return(true, nil)
+
+				sessionRepo.EXPECT().
+					IsActive(context.Background(), uuid.UUID{2}).
+					Return(false, nil)
+			},
+			wantErr: true,
+			errStr:  errors.New("сессия отозвана"),
+		},
+		{
+			name:         "неизвестный токен",
+			refreshToken: "token6",
+			beforeTest: func(authRepo mocks.MockIAuthRepository, sessionRepo mocks.MockISessionRepository) {
+				sessionRepo.EXPECT().
+					GetRefreshTokenByHash(context.Background(), base.HashToken("token6")).
+					Return(nil, fmt.Errorf("no rows in result set"))
+			},
+			wantErr: true,
+			errStr:  errors.New("недействительный refresh-токен"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.beforeTest != nil {
+				tc.beforeTest(*repo, *sessionRepo)
+			}
+
+			tokens, err := svc.Refresh(context.Background(), tc.refreshToken)
+
+			if tc.wantErr {
+				require.Equal(t, tc.errStr.Error(), err.Error())
+			} else {
+				require.Nil(t, err)
+
+				payload, verifErr := base.VerifyAuthToken(tokens.AccessToken, keys)
+				require.Nil(t, verifErr)
+				require.Equal(t, uuid.UUID{2}.String(), payload.SessionID)
+				require.NotEqual(t, tc.refreshToken, tokens.RefreshToken)
+			}
+		})
+	}
+}
+
+func TestAuthService_ChangePassword(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mocks.NewMockIAuthRepository(ctrl)
+	sessionRepo := mocks.NewMockISessionRepository(ctrl)
+	resetRepo := mocks.NewMockIPasswordResetRepository(ctrl)
+	crypto := mocks.NewMockIHashCrypto(ctrl)
+	notifier := mocks.NewMockINotifier(ctrl)
+	attemptRepo := mocks.NewMockILoginAttemptRepository(ctrl)
+	mfaRepo := mocks.NewMockIMfaRepository(ctrl)
+	svc := NewService(repo, sessionRepo, resetRepo, attemptRepo, mfaRepo, crypto, notifier, newTestKeySet(t), 0, 0, logger.NewLogger(logger.InfoLevel, io.Discard))
+
+	testCases := []struct {
+		name        string
+		oldPassword string
+		newPassword string
+		beforeTest  func(authRepo mocks.MockIAuthRepository, crypto mocks.MockIHashCrypto)
+		wantErr     bool
+		errStr      error
+	}{
+		{
+			name:        "успешная смена пароля",
+			oldPassword: "old",
+			newPassword: "new",
+			beforeTest: func(authRepo mocks.MockIAuthRepository, crypto mocks.MockIHashCrypto) {
+				authRepo.EXPECT().
+					GetById(context.Background(), uuid.UUID{1}).
+					Return(&domain.UserAuth{ID: uuid.UUID{1}, HashedPass: "hashedOld"}, nil)
+
+				crypto.EXPECT().
+					CheckPasswordHash("old", "hashedOld").
+					Return(true)
+
+				crypto.EXPECT().
+					GenerateHashPass("new").
+					Return("hashedNew", nil)
+
+				authRepo.EXPECT().
+					UpdatePassword(context.Background(), uuid.UUID{1}, "hashedNew").
+					Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name:        "неверный текущий пароль",
+			oldPassword: "wrong",
+			newPassword: "new",
+			beforeTest: func(authRepo mocks.MockIAuthRepository, crypto mocks.MockIHashCrypto) {
+				authRepo.EXPECT().
+					GetById(context.Background(), uuid.UUID{1}).
+					Return(&domain.UserAuth{ID: uuid.UUID{1}, HashedPass: "hashedOld"}, nil)
+
+				crypto.EXPECT().
+					CheckPasswordHash("wrong", "hashedOld").
+					Return(false)
+			},
+			wantErr: true,
+			errStr:  errors.New("неверный пароль"),
+		},
+		{
+			name:        "пустой новый пароль",
+			oldPassword: "old",
+			newPassword: "",
+			wantErr:     true,
+			errStr:      errors.New("должен быть указан новый пароль"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.beforeTest != nil {
+				tc.beforeTest(*repo, *crypto)
+			}
+
+			err := svc.ChangePassword(context.Background(), uuid.UUID{1}, tc.oldPassword, tc.newPassword)
 
 			if tc.wantErr {
 				require.Equal(t, tc.errStr.Error(), err.Error())
@@ -230,3 +691,255 @@ func TestAuthService_Register(t *testing.T) {
 		})
 	}
 }
+
+func TestAuthService_ResetPassword(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mocks.NewMockIAuthRepository(ctrl)
+	sessionRepo := mocks.NewMockISessionRepository(ctrl)
+	resetRepo := mocks.NewMockIPasswordResetRepository(ctrl)
+	crypto := mocks.NewMockIHashCrypto(ctrl)
+	notifier := mocks.NewMockINotifier(ctrl)
+	attemptRepo := mocks.NewMockILoginAttemptRepository(ctrl)
+	mfaRepo := mocks.NewMockIMfaRepository(ctrl)
+	svc := NewService(repo, sessionRepo, resetRepo, attemptRepo, mfaRepo, crypto, notifier, newTestKeySet(t), 0, 0, logger.NewLogger(logger.InfoLevel, io.Discard))
+
+	testCases := []struct {
+		name       string
+		token      string
+		beforeTest func(authRepo mocks.MockIAuthRepository, sessionRepo mocks.MockISessionRepository, resetRepo mocks.MockIPasswordResetRepository, crypto mocks.MockIHashCrypto)
+		wantErr    bool
+		errStr     error
+	}{
+		{
+			name:  "успешный сброс пароля",
+			token: "token1",
+			beforeTest: func(authRepo mocks.MockIAuthRepository, sessionRepo mocks.MockISessionRepository, resetRepo mocks.MockIPasswordResetRepository, crypto mocks.MockIHashCrypto) {
+				resetRepo.EXPECT().
+					Use(context.Background(), base.HashToken("token1")).
+					Return(&domain.PasswordResetToken{ID: uuid.UUID{2}, UserID: uuid.UUID{1}}, nil)
+
+				crypto.EXPECT().
+					GenerateHashPass("new").
+					Return("hashedNew", nil)
+
+				authRepo.EXPECT().
+					UpdatePassword(context.Background(), uuid.UUID{1}, "hashedNew").
+					Return(nil)
+
+				sessionRepo.EXPECT().
+					RevokeByUserId(context.Background(), uuid.UUID{1}).
+					Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name:  "использованный или просроченный токен",
+			token: "token2",
+			beforeTest: func(authRepo mocks.MockIAuthRepository, sessionRepo mocks.MockISessionRepository, resetRepo mocks.MockIPasswordResetRepository, crypto mocks.MockIHashCrypto) {
+				resetRepo.EXPECT().
+					Use(context.Background(), base.HashToken("token2")).
+					Return(nil, fmt.Errorf("no rows in result set"))
+			},
+			wantErr: true,
+			errStr:  errors.New("недействительный или просроченный токен сброса пароля"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.beforeTest != nil {
+				tc.beforeTest(*repo, *sessionRepo, *resetRepo, *crypto)
+			}
+
+			err := svc.ResetPassword(context.Background(), tc.token, "new")
+
+			if tc.wantErr {
+				require.Equal(t, tc.errStr.Error(), err.Error())
+			} else {
+				require.Nil(t, err)
+			}
+		})
+	}
+}
+
+func TestAuthService_VerifyMfa(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	keys := newTestKeySet(t)
+	repo := mocks.NewMockIAuthRepository(ctrl)
+	sessionRepo := mocks.NewMockISessionRepository(ctrl)
+	resetRepo := mocks.NewMockIPasswordResetRepository(ctrl)
+	crypto := mocks.NewMockIHashCrypto(ctrl)
+	notifier := mocks.NewMockINotifier(ctrl)
+	attemptRepo := mocks.NewMockILoginAttemptRepository(ctrl)
+	mfaRepo := mocks.NewMockIMfaRepository(ctrl)
+	svc := NewService(repo, sessionRepo, resetRepo, attemptRepo, mfaRepo, crypto, notifier, keys, 0, 0, logger.NewLogger(logger.InfoLevel, io.Discard))
+
+	userId := uuid.UUID{2}
+	mfaKey := "mfa:" + userId.String()
+	mfa := &domain.UserMfa{UserID: userId, Secret: "JBSWY3DPEHPK3PXP", ConfirmedAt: time.Now()}
+
+	mfaToken, err := base.GenerateMfaPendingToken(userId.String(), time.Minute, keys)
+	require.Nil(t, err)
+
+	accessToken, err := base.GenerateAuthToken(userId.String(), domain.RoleUser, uuid.UUID{1}.String(), time.Minute, keys)
+	require.Nil(t, err)
+
+	testCases := []struct {
+		name       string
+		mfaToken   string
+		code       string
+		beforeTest func()
+		wantErr    bool
+		errStr     error
+	}{
+		{
+			name:     "вход по коду восстановления",
+			mfaToken: mfaToken,
+			code:     "ABCDE-FGHIJ",
+			beforeTest: func() {
+				attemptRepo.EXPECT().
+					Get(context.Background(), mfaKey).
+					Return(&domain.LoginAttempts{Key: mfaKey}, nil)
+
+				mfaRepo.EXPECT().
+					GetByUserId(context.Background(), userId).
+					Return(mfa, nil)
+
+				mfaRepo.EXPECT().
+					UseRecoveryCode(context.Background(), userId, base.HashRecoveryCode("ABCDE-FGHIJ")).
+					Return(true, nil)
+
+				attemptRepo.EXPECT().
+					Reset(context.Background(), mfaKey).
+					Return(nil)
+
+				repo.EXPECT().
+					GetById(context.Background(), userId).
+					Return(&domain.UserAuth{ID: userId, Username: "test123", Role: domain.RoleUser}, nil)
+
+				sessionRepo.EXPECT().
+					Create(context.Background(), gomock.Any()).
+					Return(uuid.UUID{1}, nil)
+
+				sessionRepo.EXPECT().
+					CreateRefreshToken(context.Background(), gomock.Any()).
+					Return(nil)
+			},
+		},
+		{
+			name:     "неверный код",
+			mfaToken: mfaToken,
+			code:     "wrong",
+			beforeTest: func() {
+				attemptRepo.EXPECT().
+					Get(context.Background(), mfaKey).
+					Return(&domain.LoginAttempts{Key: mfaKey}, nil)
+
+				mfaRepo.EXPECT().
+					GetByUserId(context.Background(), userId).
+					Return(mfa, nil)
+
+				mfaRepo.EXPECT().
+					UseRecoveryCode(context.Background(), userId, base.HashRecoveryCode("wrong")).
+					Return(false, nil)
+
+				attemptRepo.EXPECT().
+					RegisterFailure(context.Background(), mfaKey, gomock.Any()).
+					Return(1, nil)
+			},
+			wantErr: true,
+			errStr:  domain.ErrInvalidMfaCode,
+		},
+		{
+			name:     "проверка заблокирована",
+			mfaToken: mfaToken,
+			code:     "123456",
+			beforeTest: func() {
+				attemptRepo.EXPECT().
+					Get(context.Background(), mfaKey).
+					Return(&domain.LoginAttempts{
+						Key:         mfaKey,
+						Failures:    5,
+						LockedUntil: time.Now().Add(time.Minute),
+					}, nil)
+			},
+			wantErr: true,
+			errStr:  domain.ErrLoginLocked,
+		},
+		{
+			name:     "access-токен вместо токена ожидания",
+			mfaToken: accessToken,
+			code:     "123456",
+			wantErr:  true,
+			errStr:   errors.New("недействительный токен ожидания второго фактора"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.beforeTest != nil {
+				tc.beforeTest()
+			}
+
+			tokens, err := svc.VerifyMfa(context.Background(), tc.mfaToken, tc.code)
+
+			if tc.wantErr {
+				require.Equal(t, tc.errStr.Error(), err.Error())
+			} else {
+				require.Nil(t, err)
+
+				payload, verifErr := base.VerifyAuthToken(tokens.AccessToken, keys)
+				require.Nil(t, verifErr)
+				require.Equal(t, userId.String(), payload.ID)
+			}
+		})
+	}
+}
+
+func expectNotLocked(attemptRepo mocks.MockILoginAttemptRepository) {
+	attemptRepo.EXPECT().
+		Get(context.Background(), "user:test123").
+		Return(&domain.LoginAttempts{Key: "user:test123"}, nil)
+
+	attemptRepo.EXPECT().
+		Get(context.Background(), "ip:10.0.0.1").
+		Return(&domain.LoginAttempts{Key: "ip:10.0.0.1"}, nil)
+}
+
+func expectFailures(attemptRepo mocks.MockILoginAttemptRepository, accountFailures, ipFailures int) {
+	attemptRepo.EXPECT().
+		RegisterFailure(context.Background(), "user:test123", gomock.Any()).
+		Return(accountFailures, nil)
+
+	attemptRepo.EXPECT().
+		RegisterFailure(context.Background(), "ip:10.0.0.1", gomock.Any()).
+		Return(ipFailures, nil)
+}
+
+func TestLockDuration(t *testing.T) {
+	require.Equal(t, time.Duration(0), lockDuration(4, accountFailuresBeforeLock))
+	require.Equal(t, time.Minute, lockDuration(5, accountFailuresBeforeLock))
+	require.Equal(t, 4*time.Minute, lockDuration(7, accountFailuresBeforeLock))
+	require.Equal(t, time.Hour, lockDuration(100, accountFailuresBeforeLock))
+}
+
+type testKeySet struct {
+	key *base.JwtKey
+}
+
+func newTestKeySet(t *testing.T) *testKeySet {
+	key, err := base.GenerateJwtKey(base.AlgEdDSA)
+	require.Nil(t, err)
+
+	return &testKeySet{key: key}
+}
+
+func (k *testKeySet) SigningKey() (*base.JwtKey, error) {
+	return k.key, nil
+}
+
+func (k *testKeySet) VerificationKeys() []*base.JwtKey {
+	return []*base.JwtKey{k.key}
+}
@@ -0,0 +1,195 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"ppo/domain"
+	"ppo/pkg/base"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// за это время нужно ввести код второго фактора после проверки пароля
+	mfaPendingTokenTTL = 5 * time.Minute
+	totpIssuer         = "Entrepreneurs"
+	recoveryCodeCount  = 10
+)
+
+// VerifyMfa завершает двухэтапный вход: по токену ожидания второго фактора и TOTP-коду
+// или коду восстановления создает сессию. Перебор кодов ограничивается так же, как перебор паролей.
+func (s *Service) VerifyMfa(ctx context.Context, mfaToken, code string) (tokens *domain.TokenPair, err error) {
+	prompt := "AuthVerifyMfa"
+
+	userId, err := base.VerifyMfaPendingToken(mfaToken, s.keys)
+	if err != nil {
+		s.logger.Infof("%s: %v", prompt, err)
+		return nil, fmt.Errorf("недействительный токен ожидания второго фактора")
+	}
+
+	userIdUuid, err := uuid.Parse(userId)
+	if err != nil {
+		s.logger.Infof("%s: преобразование id к uuid: %v", prompt, err)
+		return nil, fmt.Errorf("недействительный токен ожидания второго фактора")
+	}
+
+	keys := []loginAttemptKey{{key: "mfa:" + userId, failuresForLock: accountFailuresBeforeLock}}
+	attempts, err := s.attemptRepo.Get(ctx, keys[0].key)
+	if err != nil {
+		s.logger.Infof("%s: %v", prompt, err)
+		return nil, fmt.Errorf("проверка блокировки входа: %w", err)
+	}
+
+	if time.Now().Before(attempts.LockedUntil) {
+		s.logger.Infof("%s: проверка второго фактора для %s заблокирована до %s", prompt, userId, attempts.LockedUntil)
+		return nil, domain.ErrLoginLocked
+	}
+
+	mfa, err := s.mfaRepo.GetByUserId(ctx, userIdUuid)
+	if err != nil {
+		s.logger.Infof("%s: %v", prompt, err)
+		return nil, err
+	}
+
+	if mfa == nil || mfa.ConfirmedAt.IsZero() {
+		s.logger.Infof("%s: двухфакторная аутентификация не подключена", prompt)
+		return nil, fmt.Errorf("двухфакторная аутентификация не подключена")
+	}
+
+	ok, err := s.checkMfaCode(ctx, mfa, code)
+	if err != nil {
+		s.logger.Infof("%s: %v", prompt, err)
+		return nil, err
+	}
+
+	if !ok {
+		s.logger.Infof("%s: неверный код подтверждения", prompt)
+		s.registerLoginFailure(ctx, prompt, keys)
+		return nil, domain.ErrInvalidMfaCode
+	}
+
+	err = s.attemptRepo.Reset(ctx, keys[0].key)
+	if err != nil {
+		s.logger.Infof("%s: %v", prompt, err)
+	}
+
+	userAuth, err := s.authRepo.GetById(ctx, userIdUuid)
+	if err != nil {
+		s.logger.Infof("%s: получение пользователя по id: %v", prompt, err)
+		return nil, fmt.Errorf("получение пользователя по id: %w", err)
+	}
+
+	tokens, err = s.startSession(ctx, userAuth)
+	if err != nil {
+		s.logger.Infof("%s: %v", prompt, err)
+		return nil, err
+	}
+
+	return tokens, nil
+}
+
+// checkMfaCode принимает TOTP-код, если он не использовался ранее, либо неиспользованный код восстановления.
+func (s *Service) checkMfaCode(ctx context.Context, mfa *domain.UserMfa, code string) (ok bool, err error) {
+	step, valid := base.ValidateTOTP(mfa.Secret, code, time.Now())
+	if valid {
+		return s.mfaRepo.UseStep(ctx, mfa.UserID, step)
+	}
+
+	return s.mfaRepo.UseRecoveryCode(ctx, mfa.UserID, base.HashRecoveryCode(code))
+}
+
+// EnrollMfa начинает подключение двухфакторной аутентификации: секрет начинает действовать
+// только после подтверждения кодом из приложения-аутентификатора.
+func (s *Service) EnrollMfa(ctx context.Context, userId uuid.UUID) (enrollment *domain.MfaEnrollment, err error) {
+	prompt := "AuthEnrollMfa"
+
+	userAuth, err := s.authRepo.GetById(ctx, userId)
+	if err != nil {
+		s.logger.Infof("%s: получение пользователя по id: %v", prompt, err)
+		return nil, fmt.Errorf("получение пользователя по id: %w", err)
+	}
+
+	secret, err := base.GenerateTOTPSecret()
+	if err != nil {
+		s.logger.Infof("%s: %v", prompt, err)
+		return nil, err
+	}
+
+	err = s.mfaRepo.Save(ctx, &domain.UserMfa{UserID: userId, Secret: secret})
+	if err != nil {
+		s.logger.Infof("%s: %v", prompt, err)
+		return nil, err
+	}
+
+	return &domain.MfaEnrollment{
+		Secret: secret,
+		URI:    base.TOTPURI(totpIssuer, userAuth.Username, secret),
+	}, nil
+}
+
+// ConfirmMfa включает двухфакторную аутентификацию и возвращает коды восстановления.
+// Коды показываются один раз: в БД хранятся только их хэши.
+func (s *Service) ConfirmMfa(ctx context.Context, userId uuid.UUID, code string) (recoveryCodes []string, err error) {
+	prompt := "AuthConfirmMfa"
+
+	mfa, err := s.mfaRepo.GetByUserId(ctx, userId)
+	if err != nil {
+		s.logger.Infof("%s: %v", prompt, err)
+		return nil, err
+	}
+
+	if mfa == nil || !mfa.ConfirmedAt.IsZero() {
+		s.logger.Infof("%s: нет неподтвержденного подключения двухфакторной аутентификации", prompt)
+		return nil, fmt.Errorf("нет неподтвержденного подключения двухфакторной аутентификации")
+	}
+
+	step, ok := base.ValidateTOTP(mfa.Secret, code, time.Now())
+	if !ok {
+		s.logger.Infof("%s: неверный код подтверждения", prompt)
+		return nil, domain.ErrInvalidMfaCode
+	}
+
+	recoveryCodes, hashes, err := base.GenerateRecoveryCodes(recoveryCodeCount)
+	if err != nil {
+		s.logger.Infof("%s: %v", prompt, err)
+		return nil, err
+	}
+
+	err = s.mfaRepo.Confirm(ctx, userId, hashes)
+	if err != nil {
+		s.logger.Infof("%s: %v", prompt, err)
+		return nil, err
+	}
+
+	// код, которым подтверждено подключение, не должен подойти для входа
+	_, err = s.mfaRepo.UseStep(ctx, userId, step)
+	if err != nil {
+		s.logger.Infof("%s: %v", prompt, err)
+	}
+
+	return recoveryCodes, nil
+}
+
+func (s *Service) DisableMfa(ctx context.Context, userId uuid.UUID, password string) (err error) {
+	prompt := "AuthDisableMfa"
+
+	userAuth, err := s.authRepo.GetById(ctx, userId)
+	if err != nil {
+		s.logger.Infof("%s: получение пользователя по id: %v", prompt, err)
+		return fmt.Errorf("получение пользователя по id: %w", err)
+	}
+
+	if !s.crypto.CheckPasswordHash(password, userAuth.HashedPass) {
+		s.logger.Infof("%s: неверный пароль", prompt)
+		return fmt.Errorf("неверный пароль")
+	}
+
+	err = s.mfaRepo.Delete(ctx, userId)
+	if err != nil {
+		s.logger.Infof("%s: %v", prompt, err)
+		return err
+	}
+
+	return nil
+}
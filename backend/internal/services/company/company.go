@@ -4,28 +4,84 @@ import (
 	"context"
 	"fmt"
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 	"ppo/domain"
+	"ppo/pkg/base"
 	"ppo/pkg/logger"
 )
 
 type Service struct {
 	actFieldRepo domain.IActivityFieldRepository
 	companyRepo  domain.ICompanyRepository
+	ownerRepo    domain.ICompanyOwnerRepository
 	logger       logger.ILogger
 }
 
 func NewService(
 	companyRepo domain.ICompanyRepository,
 	actFieldRepo domain.IActivityFieldRepository,
+	ownerRepo domain.ICompanyOwnerRepository,
 	logger logger.ILogger,
 ) domain.ICompanyService {
 	return &Service{
 		companyRepo:  companyRepo,
 		actFieldRepo: actFieldRepo,
+		ownerRepo:    ownerRepo,
 		logger:       logger,
 	}
 }
 
+// validateRegistryIds проверяет контрольные суммы реквизитов и их согласованность: у юридического лица
+// 10-значный ИНН, ОГРН и КПП, у индивидуального предпринимателя 12-значный ИНН, ОГРНИП и нет КПП.
+func validateRegistryIds(company *domain.Company) (err error) {
+	err = base.ValidateINN(company.INN)
+	if err != nil {
+		return err
+	}
+
+	err = base.ValidateOGRN(company.OGRN)
+	if err != nil {
+		return err
+	}
+
+	if len(company.INN) == base.LegalEntityINNLength {
+		if len(company.OGRN) != base.OGRNLength {
+			return fmt.Errorf("у юридического лица должен быть указан ОГРН, а не ОГРНИП")
+		}
+
+		return base.ValidateKPP(company.KPP)
+	}
+
+	if len(company.OGRN) != base.OGRNIPLength {
+		return fmt.Errorf("у индивидуального предпринимателя должен быть указан ОГРНИП, а не ОГРН")
+	}
+
+	if company.KPP != "" {
+		return fmt.Errorf("у индивидуального предпринимателя нет КПП")
+	}
+
+	return nil
+}
+
+// checkActivityFields проверяет, что сферы деятельности существуют и не повторяются,
+// в том числе что основная сфера не указана среди дополнительных.
+func (s *Service) checkActivityFields(ctx context.Context, company *domain.Company) (err error) {
+	seen := make(map[uuid.UUID]struct{})
+	for _, fieldId := range company.ActivityFieldIds() {
+		if _, ok := seen[fieldId]; ok {
+			return fmt.Errorf("сфера деятельности %s указана несколько раз", fieldId)
+		}
+		seen[fieldId] = struct{}{}
+
+		_, err = s.actFieldRepo.GetById(ctx, fieldId)
+		if err != nil {
+			return fmt.Errorf("поиск сферы деятельности: %w", err)
+		}
+	}
+
+	return nil
+}
+
 func (s *Service) Create(ctx context.Context, company *domain.Company) (err error) {
 	prompt := "CompanyCreate"
 
@@ -39,10 +95,16 @@ func (s *Service) Create(ctx context.Context, company *domain.Company) (err erro
 		return fmt.Errorf("должно быть указано название города")
 	}
 
-	_, err = s.actFieldRepo.GetById(ctx, company.ActivityFieldId)
+	err = validateRegistryIds(company)
+	if err != nil {
+		s.logger.Infof("%s: %v", prompt, err)
+		return err
+	}
+
+	err = s.checkActivityFields(ctx, company)
 	if err != nil {
-		s.logger.Infof("%s: поиск сферы деятельности: %v", prompt, err)
-		return fmt.Errorf("добавление компании (поиск сферы деятельности): %w", err)
+		s.logger.Infof("%s: %v", prompt, err)
+		return fmt.Errorf("добавление компании: %w", err)
 	}
 
 	err = s.companyRepo.Create(ctx, company)
@@ -66,6 +128,24 @@ func (s *Service) GetById(ctx context.Context, id uuid.UUID) (company *domain.Co
 	return company, nil
 }
 
+func (s *Service) GetByINN(ctx context.Context, inn string) (company *domain.Company, err error) {
+	prompt := "CompanyGetByINN"
+
+	err = base.ValidateINN(inn)
+	if err != nil {
+		s.logger.Infof("%s: %v", prompt, err)
+		return nil, err
+	}
+
+	company, err = s.companyRepo.GetByINN(ctx, inn)
+	if err != nil {
+		s.logger.Infof("%s: получение компании по ИНН: %v", prompt, err)
+		return nil, fmt.Errorf("получение компании по ИНН: %w", err)
+	}
+
+	return company, nil
+}
+
 func (s *Service) GetByOwnerId(ctx context.Context, id uuid.UUID, page int, isPaginated bool) (companies []*domain.Company, numPages int, err error) {
 	prompt := "CompanyGetByOwnerId"
 
@@ -78,6 +158,34 @@ func (s *Service) GetByOwnerId(ctx context.Context, id uuid.UUID, page int, isPa
 	return companies, numPages, nil
 }
 
+// GetByActivityFieldTree возвращает компании, работающие в сфере деятельности или любой из ее подкатегорий.
+func (s *Service) GetByActivityFieldTree(ctx context.Context, fieldId uuid.UUID, page int) (companies []*domain.Company, numPages int, err error) {
+	prompt := "CompanyGetByActivityFieldTree"
+
+	if page < 1 {
+		s.logger.Infof("%s: номер страницы должен быть положительным", prompt)
+		return nil, 0, fmt.Errorf("номер страницы должен быть положительным")
+	}
+
+	ids, err := s.actFieldRepo.GetSubtreeIds(ctx, fieldId)
+	if err != nil {
+		s.logger.Infof("%s: %v", prompt, err)
+		return nil, 0, err
+	}
+	if len(ids) == 0 {
+		s.logger.Infof("%s: сфера деятельности %s не найдена", prompt, fieldId)
+		return nil, 0, fmt.Errorf("сфера деятельности %s не найдена", fieldId)
+	}
+
+	companies, numPages, err = s.companyRepo.GetByActivityFieldIds(ctx, ids, page)
+	if err != nil {
+		s.logger.Infof("%s: %v", prompt, err)
+		return nil, 0, err
+	}
+
+	return companies, numPages, nil
+}
+
 func (s *Service) GetAll(ctx context.Context, page int) (companies []*domain.Company, err error) {
 	prompt := "CompanyGetAll"
 
@@ -104,11 +212,48 @@ func (s *Service) Update(ctx context.Context, company *domain.Company, userId uu
 		return fmt.Errorf("только владелец может обновлять информацию о своих компаниях")
 	}
 
-	if company.ActivityFieldId.ID() != 0 {
-		_, err = s.actFieldRepo.GetById(ctx, company.ActivityFieldId)
+	if company.OwnerID.ID() != 0 && company.OwnerID != compDb.OwnerID {
+		s.logger.Infof("%s: смена владельца через обновление компании", prompt)
+		return fmt.Errorf("владелец компании меняется только через передачу компании")
+	}
+
+	// реквизиты проверяются вместе с текущими значениями из БД, так как меняться может только часть из них
+	if company.INN != "" || company.OGRN != "" || company.KPP != "" {
+		ids := &domain.Company{INN: compDb.INN, OGRN: compDb.OGRN, KPP: compDb.KPP}
+		if company.INN != "" {
+			ids.INN = company.INN
+			ids.KPP = company.KPP
+		}
+		if company.OGRN != "" {
+			ids.OGRN = company.OGRN
+		}
+		if company.KPP != "" {
+			ids.KPP = company.KPP
+		}
+
+		err = validateRegistryIds(ids)
+		if err != nil {
+			s.logger.Infof("%s: %v", prompt, err)
+			return err
+		}
+	}
+
+	if company.ActivityFieldId.ID() != 0 || company.SecondaryActivityFieldIds != nil {
+		fields := &domain.Company{
+			ActivityFieldId:           compDb.ActivityFieldId,
+			SecondaryActivityFieldIds: compDb.SecondaryActivityFieldIds,
+		}
+		if company.ActivityFieldId.ID() != 0 {
+			fields.ActivityFieldId = company.ActivityFieldId
+		}
+		if company.SecondaryActivityFieldIds != nil {
+			fields.SecondaryActivityFieldIds = company.SecondaryActivityFieldIds
+		}
+
+		err = s.checkActivityFields(ctx, fields)
 		if err != nil {
-			s.logger.Infof("%s: поиск сферы деятельности: %v", prompt, err)
-			return fmt.Errorf("обновление информации о компании (поиск сферы деятельности): %w", err)
+			s.logger.Infof("%s: %v", prompt, err)
+			return fmt.Errorf("обновление информации о компании: %w", err)
 		}
 	}
 
@@ -143,3 +288,116 @@ func (s *Service) DeleteById(ctx context.Context, id uuid.UUID, ownerId uuid.UUI
 
 	return nil
 }
+
+// доли хранятся с точностью до сотых процента
+const sharePrecision = 2
+
+func validateShare(share decimal.Decimal) (err error) {
+	if !share.IsPositive() || share.GreaterThan(domain.MaxOwnerShare) {
+		return fmt.Errorf("доля владельца должна быть больше 0 и не больше 100%%")
+	}
+
+	if !share.Equal(share.Round(sharePrecision)) {
+		return fmt.Errorf("доля владельца указывается с точностью до сотых процента")
+	}
+
+	return nil
+}
+
+func (s *Service) GetOwners(ctx context.Context, companyId uuid.UUID) (owners []*domain.CompanyOwner, err error) {
+	prompt := "CompanyGetOwners"
+
+	owners, err = s.ownerRepo.GetByCompanyId(ctx, companyId)
+	if err != nil {
+		s.logger.Infof("%s: %v", prompt, err)
+		return nil, err
+	}
+
+	return owners, nil
+}
+
+// GetOwnerships возвращает доли пользователя в компаниях, приглашения в которые он принял.
+func (s *Service) GetOwnerships(ctx context.Context, userId uuid.UUID) (owners []*domain.CompanyOwner, err error) {
+	prompt := "CompanyGetOwnerships"
+
+	owners, err = s.ownerRepo.GetAcceptedByUserId(ctx, userId)
+	if err != nil {
+		s.logger.Infof("%s: %v", prompt, err)
+		return nil, err
+	}
+
+	return owners, nil
+}
+
+// InviteOwner приглашает пользователя в совладельцы; приглашать может только основной владелец.
+func (s *Service) InviteOwner(ctx context.Context, owner *domain.CompanyOwner) (err error) {
+	prompt := "CompanyInviteOwner"
+
+	err = validateShare(owner.Share)
+	if err != nil {
+		s.logger.Infof("%s: %v", prompt, err)
+		return err
+	}
+
+	company, err := s.companyRepo.GetById(ctx, owner.CompanyID)
+	if err != nil {
+		s.logger.Infof("%s: получение компании по id: %v", prompt, err)
+		return fmt.Errorf("получение компании по id: %w", err)
+	}
+
+	if company.OwnerID != owner.InvitedBy {
+		s.logger.Infof("%s: только владелец может приглашать совладельцев", prompt)
+		return fmt.Errorf("только владелец может приглашать совладельцев")
+	}
+
+	err = s.ownerRepo.Invite(ctx, owner)
+	if err != nil {
+		s.logger.Infof("%s: %v", prompt, err)
+		return err
+	}
+
+	return nil
+}
+
+func (s *Service) AcceptOwnership(ctx context.Context, companyId uuid.UUID, userId uuid.UUID) (err error) {
+	prompt := "CompanyAcceptOwnership"
+
+	err = s.ownerRepo.Accept(ctx, companyId, userId)
+	if err != nil {
+		s.logger.Infof("%s: %v", prompt, err)
+		return err
+	}
+
+	return nil
+}
+
+// SetOwnerShare меняет долю совладельца, в том числе самого основного владельца:
+// например, чтобы освободить долю для нового совладельца.
+func (s *Service) SetOwnerShare(ctx context.Context, owner *domain.CompanyOwner, requesterId uuid.UUID) (err error) {
+	prompt := "CompanySetOwnerShare"
+
+	err = validateShare(owner.Share)
+	if err != nil {
+		s.logger.Infof("%s: %v", prompt, err)
+		return err
+	}
+
+	company, err := s.companyRepo.GetById(ctx, owner.CompanyID)
+	if err != nil {
+		s.logger.Infof("%s: получение компании по id: %v", prompt, err)
+		return fmt.Errorf("получение компании по id: %w", err)
+	}
+
+	if company.OwnerID != requesterId {
+		s.logger.Infof("%s: только владелец может менять доли совладельцев", prompt)
+		return fmt.Errorf("только владелец может менять доли совладельцев")
+	}
+
+	err = s.ownerRepo.SetShare(ctx, owner.CompanyID, owner.UserID, owner.Share)
+	if err != nil {
+		s.logger.Infof("%s: %v", prompt, err)
+		return err
+	}
+
+	return nil
+}
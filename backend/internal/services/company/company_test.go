@@ -5,61 +5,179 @@ import (
 	"errors"
 	"fmt"
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
+	"io"
 	"ppo/domain"
 	"ppo/mocks"
+	"ppo/pkg/logger"
 	"testing"
 )
 
+// реквизиты юридического лица с верными контрольными суммами
+const (
+	testINN  = "7707083893"
+	testOGRN = "1027700132195"
+	testKPP  = "773601001"
+)
+
+// реквизиты индивидуального предпринимателя с верными контрольными суммами
+const (
+	testPersonINN = "500123456750"
+	testOGRNIP    = "318502700123455"
+)
+
 func TestCompanyService_Create(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
 	compRepo := mocks.NewMockICompanyRepository(ctrl)
-	svc := NewService(compRepo)
+	actFieldRepo := mocks.NewMockIActivityFieldRepository(ctrl)
+	svc := NewService(compRepo, actFieldRepo, mocks.NewMockICompanyOwnerRepository(ctrl), logger.NewLogger(logger.InfoLevel, io.Discard))
 
 	testCases := []struct {
 		name       string
 		company    *domain.Company
-		beforeTest func(compRepo mocks.MockICompanyRepository)
+		beforeTest func(compRepo mocks.MockICompanyRepository, actFieldRepo mocks.MockIActivityFieldRepository)
 		wantErr    bool
 		errStr     error
 	}{
 		{
 			name: "успешное добавление",
 			company: &domain.Company{
-				Name: "aaa",
-				City: "ccc",
+				ActivityFieldId: uuid.UUID{1},
+				Name:            "aaa",
+				City:            "ccc",
+				INN:             testINN,
+				OGRN:            testOGRN,
+				KPP:             testKPP,
 			},
-			beforeTest: func(compRepo mocks.MockICompanyRepository) {
+			beforeTest: func(compRepo mocks.MockICompanyRepository, actFieldRepo mocks.MockIActivityFieldRepository) {
+				actFieldRepo.EXPECT().
+					GetById(context.Background(), uuid.UUID{1}).
+					Return(&domain.ActivityField{ID: uuid.UUID{1}}, nil)
+
 				compRepo.EXPECT().
 					Create(
 						context.Background(),
 						&domain.Company{
-							Name: "aaa",
-							City: "ccc",
+							ActivityFieldId: uuid.UUID{1},
+							Name:            "aaa",
+							City:            "ccc",
+							INN:             testINN,
+							OGRN:            testOGRN,
+							KPP:             testKPP,
 						},
 					).Return(nil)
 			},
 			wantErr: false,
 		},
 		{
-			name: "пустое название компании",
+			name: "добавление с дополнительными сферами деятельности",
 			company: &domain.Company{
-				Name: "",
-				City: "ccc",
+				ActivityFieldId:           uuid.UUID{1},
+				SecondaryActivityFieldIds: []uuid.UUID{{2}, {3}},
+				Name:                      "aaa",
+				City:                      "ccc",
+				INN:                       testINN,
+				OGRN:                      testOGRN,
+				KPP:                       testKPP,
 			},
-			beforeTest: func(compRepo mocks.MockICompanyRepository) {
+			beforeTest: func(compRepo mocks.MockICompanyRepository, actFieldRepo mocks.MockIActivityFieldRepository) {
+				for _, id := range []uuid.UUID{{1}, {2}, {3}} {
+					actFieldRepo.EXPECT().
+						GetById(context.Background(), id).
+						Return(&domain.ActivityField{ID: id}, nil)
+				}
+
 				compRepo.EXPECT().
 					Create(
 						context.Background(),
 						&domain.Company{
-							Name: "",
-							City: "ccc",
+							ActivityFieldId:           uuid.UUID{1},
+							SecondaryActivityFieldIds: []uuid.UUID{{2}, {3}},
+							Name:                      "aaa",
+							City:                      "ccc",
+							INN:                       testINN,
+							OGRN:                      testOGRN,
+							KPP:                       testKPP,
 						},
-					).Return(nil).
-					AnyTimes()
+					).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "дополнительная сфера деятельности указана дважды",
+			company: &domain.Company{
+				ActivityFieldId:           uuid.UUID{1},
+				SecondaryActivityFieldIds: []uuid.UUID{{2}, {2}},
+				Name:                      "aaa",
+				City:                      "ccc",
+				INN:                       testINN,
+				OGRN:                      testOGRN,
+				KPP:                       testKPP,
+			},
+			beforeTest: func(compRepo mocks.MockICompanyRepository, actFieldRepo mocks.MockIActivityFieldRepository) {
+				for _, id := range []uuid.UUID{{1}, {2}} {
+					actFieldRepo.EXPECT().
+						GetById(context.Background(), id).
+						Return(&domain.ActivityField{ID: id}, nil)
+				}
+			},
+			wantErr: true,
+			errStr: errors.New("добавление компании: " +
+				"сфера деятельности 02000000-0000-0000-0000-000000000000 указана несколько раз"),
+		},
+		{
+			name: "основная сфера деятельности среди дополнительных",
+			company: &domain.Company{
+				ActivityFieldId:           uuid.UUID{1},
+				SecondaryActivityFieldIds: []uuid.UUID{{1}},
+				Name:                      "aaa",
+				City:                      "ccc",
+				INN:                       testINN,
+				OGRN:                      testOGRN,
+				KPP:                       testKPP,
+			},
+			beforeTest: func(compRepo mocks.MockICompanyRepository, actFieldRepo mocks.MockIActivityFieldRepository) {
+				actFieldRepo.EXPECT().
+					GetById(context.Background(), uuid.UUID{1}).
+					Return(&domain.ActivityField{ID: uuid.UUID{1}}, nil)
+			},
+			wantErr: true,
+			errStr: errors.New("добавление компании: " +
+				"сфера деятельности 01000000-0000-0000-0000-000000000000 указана несколько раз"),
+		},
+		{
+			name: "неизвестная сфера деятельности",
+			company: &domain.Company{
+				ActivityFieldId:           uuid.UUID{1},
+				SecondaryActivityFieldIds: []uuid.UUID{{2}},
+				Name:                      "aaa",
+				City:                      "ccc",
+				INN:                       testINN,
+				OGRN:                      testOGRN,
+				KPP:                       testKPP,
+			},
+			beforeTest: func(compRepo mocks.MockICompanyRepository, actFieldRepo mocks.MockIActivityFieldRepository) {
+				actFieldRepo.EXPECT().
+					GetById(context.Background(), uuid.UUID{1}).
+					Return(&domain.ActivityField{ID: uuid.UUID{1}}, nil)
+
+				actFieldRepo.EXPECT().
+					GetById(context.Background(), uuid.UUID{2}).
+					Return(nil, fmt.Errorf("получение сферы деятельности по id: no rows in result set"))
+			},
+			wantErr: true,
+			errStr: errors.New("добавление компании: поиск сферы деятельности: " +
+				"получение сферы деятельности по id: no rows in result set"),
+		},
+		{
+			name: "пустое название компании",
+			company: &domain.Company{
+				Name: "",
+				City: "ccc",
 			},
 			wantErr: true,
 			errStr:  errors.New("должно быть указано название компании"),
@@ -70,36 +188,105 @@ func TestCompanyService_Create(t *testing.T) {
 				Name: "aaa",
 				City: "",
 			},
-			beforeTest: func(compRepo mocks.MockICompanyRepository) {
+			wantErr: true,
+			errStr:  errors.New("должно быть указано название города"),
+		},
+		{
+			name: "неверная контрольная цифра ИНН",
+			company: &domain.Company{
+				ActivityFieldId: uuid.UUID{1},
+				Name:            "aaa",
+				City:            "ccc",
+				INN:             "7707083894",
+				OGRN:            testOGRN,
+				KPP:             testKPP,
+			},
+			wantErr: true,
+			errStr:  errors.New("неверная контрольная цифра ИНН"),
+		},
+		{
+			name: "неверная контрольная цифра ОГРН",
+			company: &domain.Company{
+				ActivityFieldId: uuid.UUID{1},
+				Name:            "aaa",
+				City:            "ccc",
+				INN:             testINN,
+				OGRN:            "1027700132194",
+				KPP:             testKPP,
+			},
+			wantErr: true,
+			errStr:  errors.New("неверная контрольная цифра ОГРН"),
+		},
+		{
+			name: "у индивидуального предпринимателя указан ОГРН",
+			company: &domain.Company{
+				ActivityFieldId: uuid.UUID{1},
+				Name:            "aaa",
+				City:            "ccc",
+				INN:             testPersonINN,
+				OGRN:            testOGRN,
+			},
+			wantErr: true,
+			errStr:  errors.New("у индивидуального предпринимателя должен быть указан ОГРНИП, а не ОГРН"),
+		},
+		{
+			name: "компания с таким ИНН или ОГРН уже зарегистрирована",
+			company: &domain.Company{
+				ActivityFieldId: uuid.UUID{1},
+				Name:            "aaa",
+				City:            "ccc",
+				INN:             testINN,
+				OGRN:            testOGRN,
+				KPP:             testKPP,
+			},
+			beforeTest: func(compRepo mocks.MockICompanyRepository, actFieldRepo mocks.MockIActivityFieldRepository) {
+				actFieldRepo.EXPECT().
+					GetById(context.Background(), uuid.UUID{1}).
+					Return(&domain.ActivityField{ID: uuid.UUID{1}}, nil)
+
 				compRepo.EXPECT().
 					Create(
 						context.Background(),
 						&domain.Company{
-							Name: "aaa",
-							City: "",
+							ActivityFieldId: uuid.UUID{1},
+							Name:            "aaa",
+							City:            "ccc",
+							INN:             testINN,
+							OGRN:            testOGRN,
+							KPP:             testKPP,
 						},
-					).Return(nil).
-					AnyTimes()
+					).Return(fmt.Errorf("компания с таким ИНН или ОГРН уже зарегистрирована"))
 			},
 			wantErr: true,
-			errStr:  errors.New("должно быть указано название города"),
+			errStr:  errors.New("добавление компании: компания с таким ИНН или ОГРН уже зарегистрирована"),
 		},
 		{
 			name: "ошибка выполнения запроса в репозитории",
 			company: &domain.Company{
-				Name: "aaa",
-				City: "ccc",
+				ActivityFieldId: uuid.UUID{1},
+				Name:            "aaa",
+				City:            "ccc",
+				INN:             testINN,
+				OGRN:            testOGRN,
+				KPP:             testKPP,
 			},
-			beforeTest: func(compRepo mocks.MockICompanyRepository) {
+			beforeTest: func(compRepo mocks.MockICompanyRepository, actFieldRepo mocks.MockIActivityFieldRepository) {
+				actFieldRepo.EXPECT().
+					GetById(context.Background(), uuid.UUID{1}).
+					Return(&domain.ActivityField{ID: uuid.UUID{1}}, nil)
+
 				compRepo.EXPECT().
 					Create(
 						context.Background(),
 						&domain.Company{
-							Name: "aaa",
-							City: "ccc",
+							ActivityFieldId: uuid.UUID{1},
+							Name:            "aaa",
+							City:            "ccc",
+							INN:             testINN,
+							OGRN:            testOGRN,
+							KPP:             testKPP,
 						},
-					).Return(fmt.Errorf("sql error")).
-					AnyTimes()
+					).Return(fmt.Errorf("sql error"))
 			},
 			wantErr: true,
 			errStr:  errors.New("добавление компании: sql error"),
@@ -109,7 +296,7 @@ func TestCompanyService_Create(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			ctx := context.Background()
 			if tc.beforeTest != nil {
-				tc.beforeTest(*compRepo)
+				tc.beforeTest(*compRepo, *actFieldRepo)
 			}
 
 			err := svc.Create(ctx, tc.company)
@@ -128,21 +315,27 @@ func TestCompanyService_DeleteById(t *testing.T) {
 	defer ctrl.Finish()
 
 	compRepo := mocks.NewMockICompanyRepository(ctrl)
-	svc := NewService(compRepo)
+	svc := NewService(compRepo, mocks.NewMockIActivityFieldRepository(ctrl), mocks.NewMockICompanyOwnerRepository(ctrl), logger.NewLogger(logger.InfoLevel, io.Discard))
 
 	curUuid := uuid.New()
 
 	testCases := []struct {
 		name       string
 		id         uuid.UUID
+		ownerId    uuid.UUID
 		beforeTest func(compRepo mocks.MockICompanyRepository)
 		wantErr    bool
 		errStr     error
 	}{
 		{
-			name: "успешное удаление",
-			id:   curUuid,
+			name:    "успешное удаление",
+			id:      curUuid,
+			ownerId: uuid.UUID{1},
 			beforeTest: func(compRepo mocks.MockICompanyRepository) {
+				compRepo.EXPECT().
+					GetById(context.Background(), curUuid).
+					Return(&domain.Company{ID: curUuid, OwnerID: uuid.UUID{1}}, nil)
+
 				compRepo.EXPECT().
 					DeleteById(context.Background(), curUuid).
 					Return(nil)
@@ -150,9 +343,26 @@ func TestCompanyService_DeleteById(t *testing.T) {
 			wantErr: false,
 		},
 		{
-			name: "ошибка выполнения запроса в репозитории",
-			id:   curUuid,
+			name:    "удаление чужой компании",
+			id:      curUuid,
+			ownerId: uuid.UUID{2},
+			beforeTest: func(compRepo mocks.MockICompanyRepository) {
+				compRepo.EXPECT().
+					GetById(context.Background(), curUuid).
+					Return(&domain.Company{ID: curUuid, OwnerID: uuid.UUID{1}}, nil)
+			},
+			wantErr: true,
+			errStr:  errors.New("только владелец может удалять свои компании"),
+		},
+		{
+			name:    "ошибка выполнения запроса в репозитории",
+			id:      curUuid,
+			ownerId: uuid.UUID{1},
 			beforeTest: func(compRepo mocks.MockICompanyRepository) {
+				compRepo.EXPECT().
+					GetById(context.Background(), curUuid).
+					Return(&domain.Company{ID: curUuid, OwnerID: uuid.UUID{1}}, nil)
+
 				compRepo.EXPECT().
 					DeleteById(context.Background(), curUuid).
 					Return(fmt.Errorf("sql error"))
@@ -168,7 +378,7 @@ func TestCompanyService_DeleteById(t *testing.T) {
 				tc.beforeTest(*compRepo)
 			}
 
-			err := svc.DeleteById(ctx, tc.id)
+			err := svc.DeleteById(ctx, tc.id, tc.ownerId)
 
 			if tc.wantErr {
 				require.Equal(t, tc.errStr.Error(), err.Error())
@@ -184,7 +394,7 @@ func TestCompanyService_GetAll(t *testing.T) {
 	defer ctrl.Finish()
 
 	compRepo := mocks.NewMockICompanyRepository(ctrl)
-	svc := NewService(compRepo)
+	svc := NewService(compRepo, mocks.NewMockIActivityFieldRepository(ctrl), mocks.NewMockICompanyOwnerRepository(ctrl), logger.NewLogger(logger.InfoLevel, io.Discard))
 
 	testCases := []struct {
 		name       string
@@ -270,7 +480,7 @@ func TestCompanyService_GetById(t *testing.T) {
 	defer ctrl.Finish()
 
 	compRepo := mocks.NewMockICompanyRepository(ctrl)
-	svc := NewService(compRepo)
+	svc := NewService(compRepo, mocks.NewMockIActivityFieldRepository(ctrl), mocks.NewMockICompanyOwnerRepository(ctrl), logger.NewLogger(logger.InfoLevel, io.Discard))
 
 	testCases := []struct {
 		name       string
@@ -341,7 +551,7 @@ func TestCompanyService_GetByOwnerId(t *testing.T) {
 	defer ctrl.Finish()
 
 	compRepo := mocks.NewMockICompanyRepository(ctrl)
-	svc := NewService(compRepo)
+	svc := NewService(compRepo, mocks.NewMockIActivityFieldRepository(ctrl), mocks.NewMockICompanyOwnerRepository(ctrl), logger.NewLogger(logger.InfoLevel, io.Discard))
 
 	testCases := []struct {
 		name       string
@@ -381,7 +591,7 @@ func TestCompanyService_GetByOwnerId(t *testing.T) {
 							Name:    "c",
 							City:    "c",
 						},
-					}, nil)
+					}, 1, nil)
 			},
 			expected: []*domain.Company{
 				{
@@ -416,7 +626,7 @@ func TestCompanyService_GetByOwnerId(t *testing.T) {
 						1,
 						true,
 					).
-					Return(nil, fmt.Errorf("sql error"))
+					Return(nil, 0, fmt.Errorf("sql error"))
 			},
 			wantErr: true,
 			errStr:  errors.New("получение списка компаний по id владельца: sql error"),
@@ -429,7 +639,7 @@ func TestCompanyService_GetByOwnerId(t *testing.T) {
 				tc.beforeTest(*compRepo)
 			}
 
-			companies, err := svc.GetByOwnerId(ctx, tc.id, 1, true)
+			companies, _, err := svc.GetByOwnerId(ctx, tc.id, 1, true)
 
 			if tc.wantErr {
 				require.Equal(t, tc.errStr.Error(), err.Error())
@@ -441,17 +651,123 @@ func TestCompanyService_GetByOwnerId(t *testing.T) {
 	}
 }
 
+func TestCompanyService_GetByActivityFieldTree(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	compRepo := mocks.NewMockICompanyRepository(ctrl)
+	actFieldRepo := mocks.NewMockIActivityFieldRepository(ctrl)
+	svc := NewService(compRepo, actFieldRepo, mocks.NewMockICompanyOwnerRepository(ctrl), logger.NewLogger(logger.InfoLevel, io.Discard))
+
+	testCases := []struct {
+		name             string
+		fieldId          uuid.UUID
+		page             int
+		beforeTest       func(compRepo mocks.MockICompanyRepository, actFieldRepo mocks.MockIActivityFieldRepository)
+		expected         []*domain.Company
+		expectedNumPages int
+		wantErr          bool
+		errStr           error
+	}{
+		{
+			name:    "компании сферы деятельности и ее подкатегорий",
+			fieldId: uuid.UUID{1},
+			page:    1,
+			beforeTest: func(compRepo mocks.MockICompanyRepository, actFieldRepo mocks.MockIActivityFieldRepository) {
+				actFieldRepo.EXPECT().
+					GetSubtreeIds(context.Background(), uuid.UUID{1}).
+					Return([]uuid.UUID{{1}, {2}, {3}}, nil)
+
+				compRepo.EXPECT().
+					GetByActivityFieldIds(context.Background(), []uuid.UUID{{1}, {2}, {3}}, 1).
+					Return([]*domain.Company{
+						{ID: uuid.UUID{4}, ActivityFieldId: uuid.UUID{1}},
+						{ID: uuid.UUID{5}, ActivityFieldId: uuid.UUID{3}},
+					}, 1, nil)
+			},
+			expected: []*domain.Company{
+				{ID: uuid.UUID{4}, ActivityFieldId: uuid.UUID{1}},
+				{ID: uuid.UUID{5}, ActivityFieldId: uuid.UUID{3}},
+			},
+			expectedNumPages: 1,
+			wantErr:          false,
+		},
+		{
+			name:    "несуществующая сфера деятельности",
+			fieldId: uuid.UUID{1},
+			page:    1,
+			beforeTest: func(compRepo mocks.MockICompanyRepository, actFieldRepo mocks.MockIActivityFieldRepository) {
+				actFieldRepo.EXPECT().
+					GetSubtreeIds(context.Background(), uuid.UUID{1}).
+					Return([]uuid.UUID{}, nil)
+			},
+			wantErr: true,
+			errStr:  fmt.Errorf("сфера деятельности %s не найдена", uuid.UUID{1}),
+		},
+		{
+			name:    "неположительный номер страницы",
+			fieldId: uuid.UUID{1},
+			page:    0,
+			wantErr: true,
+			errStr:  errors.New("номер страницы должен быть положительным"),
+		},
+		{
+			name:    "ошибка получения данных в репозитории",
+			fieldId: uuid.UUID{1},
+			page:    1,
+			beforeTest: func(compRepo mocks.MockICompanyRepository, actFieldRepo mocks.MockIActivityFieldRepository) {
+				actFieldRepo.EXPECT().
+					GetSubtreeIds(context.Background(), uuid.UUID{1}).
+					Return(nil, fmt.Errorf("получение подкатегорий сферы деятельности: sql error"))
+			},
+			wantErr: true,
+			errStr:  errors.New("получение подкатегорий сферы деятельности: sql error"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tc.beforeTest != nil {
+				tc.beforeTest(*compRepo, *actFieldRepo)
+			}
+
+			companies, numPages, err := svc.GetByActivityFieldTree(ctx, tc.fieldId, tc.page)
+
+			if tc.wantErr {
+				require.Equal(t, tc.errStr.Error(), err.Error())
+			} else {
+				require.Nil(t, err)
+				require.Equal(t, tc.expected, companies)
+				require.Equal(t, tc.expectedNumPages, numPages)
+			}
+		})
+	}
+}
+
 func TestCompanyService_Update(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
 	compRepo := mocks.NewMockICompanyRepository(ctrl)
-	svc := NewService(compRepo)
+	actFieldRepo := mocks.NewMockIActivityFieldRepository(ctrl)
+	svc := NewService(compRepo, actFieldRepo, mocks.NewMockICompanyOwnerRepository(ctrl), logger.NewLogger(logger.InfoLevel, io.Discard))
+
+	compDb := &domain.Company{
+		ID:              uuid.UUID{1},
+		OwnerID:         uuid.UUID{1},
+		ActivityFieldId: uuid.UUID{1},
+		Name:            "a",
+		City:            "a",
+		INN:             testINN,
+		OGRN:            testOGRN,
+		KPP:             testKPP,
+	}
 
 	testCases := []struct {
 		name       string
 		company    *domain.Company
-		beforeTest func(compRepo mocks.MockICompanyRepository)
+		userId     uuid.UUID
+		beforeTest func(compRepo mocks.MockICompanyRepository, actFieldRepo mocks.MockIActivityFieldRepository)
 		wantErr    bool
 		errStr     error
 	}{
@@ -461,7 +777,12 @@ func TestCompanyService_Update(t *testing.T) {
 				ID:   uuid.UUID{1},
 				Name: "aaa",
 			},
-			beforeTest: func(compRepo mocks.MockICompanyRepository) {
+			userId: uuid.UUID{1},
+			beforeTest: func(compRepo mocks.MockICompanyRepository, actFieldRepo mocks.MockIActivityFieldRepository) {
+				compRepo.EXPECT().
+					GetById(context.Background(), uuid.UUID{1}).
+					Return(compDb, nil)
+
 				compRepo.EXPECT().
 					Update(
 						context.Background(),
@@ -473,13 +794,112 @@ func TestCompanyService_Update(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "обновление только КПП с сохранением ИНН и ОГРН",
+			company: &domain.Company{
+				ID:  uuid.UUID{1},
+				KPP: "773601002",
+			},
+			userId: uuid.UUID{1},
+			beforeTest: func(compRepo mocks.MockICompanyRepository, actFieldRepo mocks.MockIActivityFieldRepository) {
+				compRepo.EXPECT().
+					GetById(context.Background(), uuid.UUID{1}).
+					Return(compDb, nil)
+
+				compRepo.EXPECT().
+					Update(
+						context.Background(),
+						&domain.Company{
+							ID:  uuid.UUID{1},
+							KPP: "773601002",
+						},
+					).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "ОГРНИП у юридического лица с прежним ИНН",
+			company: &domain.Company{
+				ID:   uuid.UUID{1},
+				OGRN: testOGRNIP,
+			},
+			userId: uuid.UUID{1},
+			beforeTest: func(compRepo mocks.MockICompanyRepository, actFieldRepo mocks.MockIActivityFieldRepository) {
+				compRepo.EXPECT().
+					GetById(context.Background(), uuid.UUID{1}).
+					Return(compDb, nil)
+			},
+			wantErr: true,
+			errStr:  errors.New("у юридического лица должен быть указан ОГРН, а не ОГРНИП"),
+		},
+		{
+			name: "смена на индивидуального предпринимателя вместе с ОГРНИП",
+			company: &domain.Company{
+				ID:   uuid.UUID{1},
+				INN:  testPersonINN,
+				OGRN: testOGRNIP,
+			},
+			userId: uuid.UUID{1},
+			beforeTest: func(compRepo mocks.MockICompanyRepository, actFieldRepo mocks.MockIActivityFieldRepository) {
+				compRepo.EXPECT().
+					GetById(context.Background(), uuid.UUID{1}).
+					Return(compDb, nil)
+
+				compRepo.EXPECT().
+					Update(
+						context.Background(),
+						&domain.Company{
+							ID:   uuid.UUID{1},
+							INN:  testPersonINN,
+							OGRN: testOGRNIP,
+						},
+					).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "неверная контрольная цифра нового ИНН",
+			company: &domain.Company{
+				ID:  uuid.UUID{1},
+				INN: "7707083894",
+				KPP: testKPP,
+			},
+			userId: uuid.UUID{1},
+			beforeTest: func(compRepo mocks.MockICompanyRepository, actFieldRepo mocks.MockIActivityFieldRepository) {
+				compRepo.EXPECT().
+					GetById(context.Background(), uuid.UUID{1}).
+					Return(compDb, nil)
+			},
+			wantErr: true,
+			errStr:  errors.New("неверная контрольная цифра ИНН"),
+		},
+		{
+			name: "обновление чужой компании",
+			company: &domain.Company{
+				ID:   uuid.UUID{1},
+				Name: "aaa",
+			},
+			userId: uuid.UUID{2},
+			beforeTest: func(compRepo mocks.MockICompanyRepository, actFieldRepo mocks.MockIActivityFieldRepository) {
+				compRepo.EXPECT().
+					GetById(context.Background(), uuid.UUID{1}).
+					Return(compDb, nil)
+			},
+			wantErr: true,
+			errStr:  errors.New("только владелец может обновлять информацию о своих компаниях"),
+		},
 		{
 			name: "ошибка выполнения запроса в репозитории",
 			company: &domain.Company{
 				ID:   uuid.UUID{1},
 				Name: "aaa",
 			},
-			beforeTest: func(compRepo mocks.MockICompanyRepository) {
+			userId: uuid.UUID{1},
+			beforeTest: func(compRepo mocks.MockICompanyRepository, actFieldRepo mocks.MockIActivityFieldRepository) {
+				compRepo.EXPECT().
+					GetById(context.Background(), uuid.UUID{1}).
+					Return(compDb, nil)
+
 				compRepo.EXPECT().
 					Update(
 						context.Background(),
@@ -497,10 +917,124 @@ func TestCompanyService_Update(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			ctx := context.Background()
 			if tc.beforeTest != nil {
-				tc.beforeTest(*compRepo)
+				tc.beforeTest(*compRepo, *actFieldRepo)
+			}
+
+			err := svc.Update(ctx, tc.company, tc.userId)
+
+			if tc.wantErr {
+				require.Equal(t, tc.errStr.Error(), err.Error())
+			} else {
+				require.Nil(t, err)
+			}
+		})
+	}
+}
+
+func TestCompanyService_InviteOwner(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	compRepo := mocks.NewMockICompanyRepository(ctrl)
+	ownerRepo := mocks.NewMockICompanyOwnerRepository(ctrl)
+	svc := NewService(compRepo, mocks.NewMockIActivityFieldRepository(ctrl), ownerRepo, logger.NewLogger(logger.InfoLevel, io.Discard))
+
+	testCases := []struct {
+		name       string
+		owner      *domain.CompanyOwner
+		beforeTest func(compRepo mocks.MockICompanyRepository, ownerRepo mocks.MockICompanyOwnerRepository)
+		wantErr    bool
+		errStr     error
+	}{
+		{
+			name: "успешное приглашение",
+			owner: &domain.CompanyOwner{
+				CompanyID: uuid.UUID{1},
+				UserID:    uuid.UUID{2},
+				Share:     decimal.NewFromInt(40),
+				InvitedBy: uuid.UUID{1},
+			},
+			beforeTest: func(compRepo mocks.MockICompanyRepository, ownerRepo mocks.MockICompanyOwnerRepository) {
+				compRepo.EXPECT().
+					GetById(context.Background(), uuid.UUID{1}).
+					Return(&domain.Company{ID: uuid.UUID{1}, OwnerID: uuid.UUID{1}}, nil)
+
+				ownerRepo.EXPECT().
+					Invite(context.Background(), &domain.CompanyOwner{
+						CompanyID: uuid.UUID{1},
+						UserID:    uuid.UUID{2},
+						Share:     decimal.NewFromInt(40),
+						InvitedBy: uuid.UUID{1},
+					}).
+					Return(nil)
+			},
+		},
+		{
+			name: "сумма долей превышает 100%",
+			owner: &domain.CompanyOwner{
+				CompanyID: uuid.UUID{1},
+				UserID:    uuid.UUID{2},
+				Share:     decimal.NewFromInt(40),
+				InvitedBy: uuid.UUID{1},
+			},
+			beforeTest: func(compRepo mocks.MockICompanyRepository, ownerRepo mocks.MockICompanyOwnerRepository) {
+				compRepo.EXPECT().
+					GetById(context.Background(), uuid.UUID{1}).
+					Return(&domain.Company{ID: uuid.UUID{1}, OwnerID: uuid.UUID{1}}, nil)
+
+				ownerRepo.EXPECT().
+					Invite(context.Background(), gomock.Any()).
+					Return(domain.ErrSharesExceed)
+			},
+			wantErr: true,
+			errStr:  domain.ErrSharesExceed,
+		},
+		{
+			name: "приглашение не от владельца",
+			owner: &domain.CompanyOwner{
+				CompanyID: uuid.UUID{1},
+				UserID:    uuid.UUID{3},
+				Share:     decimal.NewFromInt(10),
+				InvitedBy: uuid.UUID{2},
+			},
+			beforeTest: func(compRepo mocks.MockICompanyRepository, ownerRepo mocks.MockICompanyOwnerRepository) {
+				compRepo.EXPECT().
+					GetById(context.Background(), uuid.UUID{1}).
+					Return(&domain.Company{ID: uuid.UUID{1}, OwnerID: uuid.UUID{1}}, nil)
+			},
+			wantErr: true,
+			errStr:  errors.New("только владелец может приглашать совладельцев"),
+		},
+		{
+			name: "доля больше 100%",
+			owner: &domain.CompanyOwner{
+				CompanyID: uuid.UUID{1},
+				UserID:    uuid.UUID{2},
+				Share:     decimal.NewFromInt(101),
+				InvitedBy: uuid.UUID{1},
+			},
+			wantErr: true,
+			errStr:  errors.New("доля владельца должна быть больше 0 и не больше 100%"),
+		},
+		{
+			name: "доля точнее сотых процента",
+			owner: &domain.CompanyOwner{
+				CompanyID: uuid.UUID{1},
+				UserID:    uuid.UUID{2},
+				Share:     decimal.RequireFromString("33.333"),
+				InvitedBy: uuid.UUID{1},
+			},
+			wantErr: true,
+			errStr:  errors.New("доля владельца указывается с точностью до сотых процента"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.beforeTest != nil {
+				tc.beforeTest(*compRepo, *ownerRepo)
 			}
 
-			err := svc.Update(ctx, tc.company)
+			err := svc.InviteOwner(context.Background(), tc.owner)
 
 			if tc.wantErr {
 				require.Equal(t, tc.errStr.Error(), err.Error())
@@ -510,3 +1044,150 @@ func TestCompanyService_Update(t *testing.T) {
 		})
 	}
 }
+
+func TestCompanyService_SetOwnerShare(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	compRepo := mocks.NewMockICompanyRepository(ctrl)
+	ownerRepo := mocks.NewMockICompanyOwnerRepository(ctrl)
+	svc := NewService(compRepo, mocks.NewMockIActivityFieldRepository(ctrl), ownerRepo, logger.NewLogger(logger.InfoLevel, io.Discard))
+
+	testCases := []struct {
+		name        string
+		owner       *domain.CompanyOwner
+		requesterId uuid.UUID
+		beforeTest  func(compRepo mocks.MockICompanyRepository, ownerRepo mocks.MockICompanyOwnerRepository)
+		wantErr     bool
+		errStr      error
+	}{
+		{
+			name: "владелец уменьшает свою долю",
+			owner: &domain.CompanyOwner{
+				CompanyID: uuid.UUID{1},
+				UserID:    uuid.UUID{1},
+				Share:     decimal.NewFromInt(60),
+			},
+			requesterId: uuid.UUID{1},
+			beforeTest: func(compRepo mocks.MockICompanyRepository, ownerRepo mocks.MockICompanyOwnerRepository) {
+				compRepo.EXPECT().
+					GetById(context.Background(), uuid.UUID{1}).
+					Return(&domain.Company{ID: uuid.UUID{1}, OwnerID: uuid.UUID{1}}, nil)
+
+				ownerRepo.EXPECT().
+					SetShare(context.Background(), uuid.UUID{1}, uuid.UUID{1}, decimal.NewFromInt(60)).
+					Return(nil)
+			},
+		},
+		{
+			name: "сумма долей превышает 100%",
+			owner: &domain.CompanyOwner{
+				CompanyID: uuid.UUID{1},
+				UserID:    uuid.UUID{2},
+				Share:     decimal.NewFromInt(50),
+			},
+			requesterId: uuid.UUID{1},
+			beforeTest: func(compRepo mocks.MockICompanyRepository, ownerRepo mocks.MockICompanyOwnerRepository) {
+				compRepo.EXPECT().
+					GetById(context.Background(), uuid.UUID{1}).
+					Return(&domain.Company{ID: uuid.UUID{1}, OwnerID: uuid.UUID{1}}, nil)
+
+				ownerRepo.EXPECT().
+					SetShare(context.Background(), uuid.UUID{1}, uuid.UUID{2}, decimal.NewFromInt(50)).
+					Return(domain.ErrSharesExceed)
+			},
+			wantErr: true,
+			errStr:  domain.ErrSharesExceed,
+		},
+		{
+			name: "совладелец меняет свою долю",
+			owner: &domain.CompanyOwner{
+				CompanyID: uuid.UUID{1},
+				UserID:    uuid.UUID{2},
+				Share:     decimal.NewFromInt(90),
+			},
+			requesterId: uuid.UUID{2},
+			beforeTest: func(compRepo mocks.MockICompanyRepository, ownerRepo mocks.MockICompanyOwnerRepository) {
+				compRepo.EXPECT().
+					GetById(context.Background(), uuid.UUID{1}).
+					Return(&domain.Company{ID: uuid.UUID{1}, OwnerID: uuid.UUID{1}}, nil)
+			},
+			wantErr: true,
+			errStr:  errors.New("только владелец может менять доли совладельцев"),
+		},
+		{
+			name: "нулевая доля",
+			owner: &domain.CompanyOwner{
+				CompanyID: uuid.UUID{1},
+				UserID:    uuid.UUID{2},
+				Share:     decimal.Zero,
+			},
+			requesterId: uuid.UUID{1},
+			wantErr:     true,
+			errStr:      errors.New("доля владельца должна быть больше 0 и не больше 100%"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.beforeTest != nil {
+				tc.beforeTest(*compRepo, *ownerRepo)
+			}
+
+			err := svc.SetOwnerShare(context.Background(), tc.owner, tc.requesterId)
+
+			if tc.wantErr {
+				require.Equal(t, tc.errStr.Error(), err.Error())
+			} else {
+				require.Nil(t, err)
+			}
+		})
+	}
+}
+
+// Пока основной владелец не уменьшит свою долю, новому совладельцу доля не достается;
+// после уменьшения приглашение на освободившуюся долю проходит.
+func TestCompanyService_FreeShareForNewOwner(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	compRepo := mocks.NewMockICompanyRepository(ctrl)
+	ownerRepo := mocks.NewMockICompanyOwnerRepository(ctrl)
+	svc := NewService(compRepo, mocks.NewMockIActivityFieldRepository(ctrl), ownerRepo, logger.NewLogger(logger.InfoLevel, io.Discard))
+
+	invite := &domain.CompanyOwner{
+		CompanyID: uuid.UUID{1},
+		UserID:    uuid.UUID{2},
+		Share:     decimal.NewFromInt(40),
+		InvitedBy: uuid.UUID{1},
+	}
+
+	compRepo.EXPECT().
+		GetById(context.Background(), uuid.UUID{1}).
+		Return(&domain.Company{ID: uuid.UUID{1}, OwnerID: uuid.UUID{1}}, nil).
+		Times(3)
+
+	gomock.InOrder(
+		ownerRepo.EXPECT().
+			Invite(context.Background(), invite).
+			Return(domain.ErrSharesExceed),
+		ownerRepo.EXPECT().
+			SetShare(context.Background(), uuid.UUID{1}, uuid.UUID{1}, decimal.NewFromInt(60)).
+			Return(nil),
+		ownerRepo.EXPECT().
+			Invite(context.Background(), invite).
+			Return(nil),
+	)
+
+	err := svc.InviteOwner(context.Background(), invite)
+	require.True(t, errors.Is(err, domain.ErrSharesExceed))
+
+	err = svc.SetOwnerShare(context.Background(), &domain.CompanyOwner{
+		CompanyID: uuid.UUID{1},
+		UserID:    uuid.UUID{1},
+		Share:     decimal.NewFromInt(60),
+	}, uuid.UUID{1})
+	require.Nil(t, err)
+
+	err = svc.InviteOwner(context.Background(), invite)
+	require.Nil(t, err)
+}
@@ -0,0 +1,146 @@
+package company_transfer
+
+import (
+	"context"
+	"fmt"
+	"ppo/domain"
+	"ppo/pkg/logger"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// столько получатель может принять передачу, после чего ее нужно начинать заново
+const transferTTL = 7 * 24 * time.Hour
+
+type Service struct {
+	transferRepo domain.ICompanyTransferRepository
+	companyRepo  domain.ICompanyRepository
+	logger       logger.ILogger
+}
+
+func NewService(
+	transferRepo domain.ICompanyTransferRepository,
+	companyRepo domain.ICompanyRepository,
+	logger logger.ILogger,
+) domain.ICompanyTransferService {
+	return &Service{
+		transferRepo: transferRepo,
+		companyRepo:  companyRepo,
+		logger:       logger,
+	}
+}
+
+// Initiate начинает передачу компании; начать ее может только владелец.
+func (s *Service) Initiate(ctx context.Context, transfer *domain.CompanyTransfer) (err error) {
+	prompt := "CompanyTransferInitiate"
+
+	if transfer.ToUserID == uuid.Nil {
+		s.logger.Infof("%s: не указан получатель компании", prompt)
+		return fmt.Errorf("должен быть указан получатель компании")
+	}
+
+	if transfer.ToUserID == transfer.FromUserID {
+		s.logger.Infof("%s: передача компании самому себе", prompt)
+		return fmt.Errorf("нельзя передать компанию самому себе")
+	}
+
+	company, err := s.companyRepo.GetById(ctx, transfer.CompanyID)
+	if err != nil {
+		s.logger.Infof("%s: получение компании по id: %v", prompt, err)
+		return fmt.Errorf("получение компании по id: %w", err)
+	}
+
+	if company.OwnerID != transfer.FromUserID {
+		s.logger.Infof("%s: только владелец может передать компанию", prompt)
+		return fmt.Errorf("только владелец может передать компанию")
+	}
+
+	transfer.ExpiresAt = time.Now().Add(transferTTL)
+
+	err = s.transferRepo.Create(ctx, transfer)
+	if err != nil {
+		s.logger.Infof("%s: %v", prompt, err)
+		return err
+	}
+
+	return nil
+}
+
+func (s *Service) GetHistory(ctx context.Context, companyId uuid.UUID) (transfers []*domain.CompanyTransfer, err error) {
+	prompt := "CompanyTransferGetHistory"
+
+	transfers, err = s.transferRepo.GetByCompanyId(ctx, companyId)
+	if err != nil {
+		s.logger.Infof("%s: %v", prompt, err)
+		return nil, err
+	}
+
+	return transfers, nil
+}
+
+// GetIncoming возвращает передачи, ожидающие решения пользователя.
+func (s *Service) GetIncoming(ctx context.Context, userId uuid.UUID) (transfers []*domain.CompanyTransfer, err error) {
+	prompt := "CompanyTransferGetIncoming"
+
+	transfers, err = s.transferRepo.GetPendingByUserId(ctx, userId)
+	if err != nil {
+		s.logger.Infof("%s: %v", prompt, err)
+		return nil, err
+	}
+
+	return transfers, nil
+}
+
+func (s *Service) checkRecipient(ctx context.Context, id uuid.UUID, userId uuid.UUID) (err error) {
+	transfer, err := s.transferRepo.GetById(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if transfer.ToUserID != userId {
+		return fmt.Errorf("передача адресована другому пользователю")
+	}
+
+	if transfer.Status != domain.TransferPending {
+		return fmt.Errorf("передача уже завершена или истекла")
+	}
+
+	return nil
+}
+
+func (s *Service) Accept(ctx context.Context, id uuid.UUID, userId uuid.UUID) (err error) {
+	prompt := "CompanyTransferAccept"
+
+	err = s.checkRecipient(ctx, id, userId)
+	if err != nil {
+		s.logger.Infof("%s: %v", prompt, err)
+		return err
+	}
+
+	err = s.transferRepo.Accept(ctx, id, userId)
+	if err != nil {
+		s.logger.Infof("%s: %v", prompt, err)
+		return err
+	}
+
+	return nil
+}
+
+func (s *Service) Decline(ctx context.Context, id uuid.UUID, userId uuid.UUID) (err error) {
+	prompt := "CompanyTransferDecline"
+
+	err = s.checkRecipient(ctx, id, userId)
+	if err != nil {
+		s.logger.Infof("%s: %v", prompt, err)
+		return err
+	}
+
+	err = s.transferRepo.Decline(ctx, id, userId)
+	if err != nil {
+		s.logger.Infof("%s: %v", prompt, err)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,161 @@
+package company_transfer
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"io"
+	"ppo/domain"
+	"ppo/mocks"
+	"ppo/pkg/logger"
+	"testing"
+	"time"
+)
+
+func TestCompanyTransferService_Initiate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	transferRepo := mocks.NewMockICompanyTransferRepository(ctrl)
+	companyRepo := mocks.NewMockICompanyRepository(ctrl)
+	svc := NewService(transferRepo, companyRepo, logger.NewLogger(logger.InfoLevel, io.Discard))
+
+	testCases := []struct {
+		name       string
+		transfer   *domain.CompanyTransfer
+		beforeTest func(transferRepo mocks.MockICompanyTransferRepository, companyRepo mocks.MockICompanyRepository)
+		wantErr    bool
+		errStr     error
+	}{
+		{
+			name: "успешное начало передачи",
+			transfer: &domain.CompanyTransfer{
+				CompanyID:  uuid.UUID{1},
+				FromUserID: uuid.UUID{2},
+				ToUserID:   uuid.UUID{3},
+			},
+			beforeTest: func(transferRepo mocks.MockICompanyTransferRepository, companyRepo mocks.MockICompanyRepository) {
+				companyRepo.EXPECT().
+					GetById(context.Background(), uuid.UUID{1}).
+					Return(&domain.Company{ID: uuid.UUID{1}, OwnerID: uuid.UUID{2}}, nil)
+
+				transferRepo.EXPECT().
+					Create(context.Background(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, transfer *domain.CompanyTransfer) error {
+						require.WithinDuration(t, time.Now().Add(transferTTL), transfer.ExpiresAt, time.Second)
+						return nil
+					})
+			},
+		},
+		{
+			name: "передача самому себе",
+			transfer: &domain.CompanyTransfer{
+				CompanyID:  uuid.UUID{1},
+				FromUserID: uuid.UUID{2},
+				ToUserID:   uuid.UUID{2},
+			},
+			wantErr: true,
+			errStr:  errors.New("нельзя передать компанию самому себе"),
+		},
+		{
+			name: "передачу начинает не владелец",
+			transfer: &domain.CompanyTransfer{
+				CompanyID:  uuid.UUID{1},
+				FromUserID: uuid.UUID{3},
+				ToUserID:   uuid.UUID{4},
+			},
+			beforeTest: func(transferRepo mocks.MockICompanyTransferRepository, companyRepo mocks.MockICompanyRepository) {
+				companyRepo.EXPECT().
+					GetById(context.Background(), uuid.UUID{1}).
+					Return(&domain.Company{ID: uuid.UUID{1}, OwnerID: uuid.UUID{2}}, nil)
+			},
+			wantErr: true,
+			errStr:  errors.New("только владелец может передать компанию"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.beforeTest != nil {
+				tc.beforeTest(*transferRepo, *companyRepo)
+			}
+
+			err := svc.Initiate(context.Background(), tc.transfer)
+
+			if tc.wantErr {
+				require.Equal(t, tc.errStr.Error(), err.Error())
+			} else {
+				require.Nil(t, err)
+			}
+		})
+	}
+}
+
+func TestCompanyTransferService_Accept(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	transferRepo := mocks.NewMockICompanyTransferRepository(ctrl)
+	companyRepo := mocks.NewMockICompanyRepository(ctrl)
+	svc := NewService(transferRepo, companyRepo, logger.NewLogger(logger.InfoLevel, io.Discard))
+
+	testCases := []struct {
+		name       string
+		userId     uuid.UUID
+		beforeTest func(transferRepo mocks.MockICompanyTransferRepository)
+		wantErr    bool
+		errStr     error
+	}{
+		{
+			name:   "получатель принимает передачу",
+			userId: uuid.UUID{3},
+			beforeTest: func(transferRepo mocks.MockICompanyTransferRepository) {
+				transferRepo.EXPECT().
+					GetById(context.Background(), uuid.UUID{9}).
+					Return(&domain.CompanyTransfer{ID: uuid.UUID{9}, ToUserID: uuid.UUID{3}, Status: domain.TransferPending}, nil)
+
+				transferRepo.EXPECT().
+					Accept(context.Background(), uuid.UUID{9}, uuid.UUID{3}).
+					Return(nil)
+			},
+		},
+		{
+			name:   "передачу принимает не получатель",
+			userId: uuid.UUID{4},
+			beforeTest: func(transferRepo mocks.MockICompanyTransferRepository) {
+				transferRepo.EXPECT().
+					GetById(context.Background(), uuid.UUID{9}).
+					Return(&domain.CompanyTransfer{ID: uuid.UUID{9}, ToUserID: uuid.UUID{3}, Status: domain.TransferPending}, nil)
+			},
+			wantErr: true,
+			errStr:  errors.New("передача адресована другому пользователю"),
+		},
+		{
+			name:   "передача истекла",
+			userId: uuid.UUID{3},
+			beforeTest: func(transferRepo mocks.MockICompanyTransferRepository) {
+				transferRepo.EXPECT().
+					GetById(context.Background(), uuid.UUID{9}).
+					Return(&domain.CompanyTransfer{ID: uuid.UUID{9}, ToUserID: uuid.UUID{3}, Status: domain.TransferExpired}, nil)
+			},
+			wantErr: true,
+			errStr:  errors.New("передача уже завершена или истекла"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.beforeTest != nil {
+				tc.beforeTest(*transferRepo)
+			}
+
+			err := svc.Accept(context.Background(), uuid.UUID{9}, tc.userId)
+
+			if tc.wantErr {
+				require.Equal(t, tc.errStr.Error(), err.Error())
+			} else {
+				require.Nil(t, err)
+			}
+		})
+	}
+}
@@ -10,28 +10,35 @@ import (
 )
 
 type Service struct {
-	finRepo  domain.IFinancialReportRepository
-	compRepo domain.ICompanyRepository
-	logger   logger.ILogger
+	finRepo   domain.IFinancialReportRepository
+	compRepo  domain.ICompanyRepository
+	ownerRepo domain.ICompanyOwnerRepository
+	logger    logger.ILogger
 }
 
-func NewService(finRepo domain.IFinancialReportRepository, compRepo domain.ICompanyRepository, logger logger.ILogger) domain.IFinancialReportService {
+func NewService(
+	finRepo domain.IFinancialReportRepository,
+	compRepo domain.ICompanyRepository,
+	ownerRepo domain.ICompanyOwnerRepository,
+	logger logger.ILogger,
+) domain.IFinancialReportService {
 	return &Service{
-		finRepo:  finRepo,
-		compRepo: compRepo,
-		logger:   logger,
+		finRepo:   finRepo,
+		compRepo:  compRepo,
+		ownerRepo: ownerRepo,
+		logger:    logger,
 	}
 }
 
 func (s *Service) Create(ctx context.Context, finReport *domain.FinancialReport) (err error) {
 	prompt := "FinReportCreate"
 
-	if finReport.Revenue < 0 {
+	if finReport.Revenue.IsNegative() {
 		s.logger.Infof("%s: выручка не может быть отрицательной", prompt)
 		return fmt.Errorf("выручка не может быть отрицательной")
 	}
 
-	if finReport.Costs < 0 {
+	if finReport.Costs.IsNegative() {
 		s.logger.Infof("%s: расходы не могут быть отрицательными", prompt)
 		return fmt.Errorf("расходы не могут быть отрицательными")
 	}
@@ -106,6 +113,30 @@ func (s *Service) GetByCompany(ctx context.Context, companyId uuid.UUID, period
 	return finReport, nil
 }
 
+func (s *Service) GetAll(ctx context.Context, filter *domain.FinancialReportFilter, page int) (
+	reports []*domain.FinancialReport, numPages int, err error) {
+	prompt := "FinReportGetAll"
+
+	if page < 1 {
+		s.logger.Infof("%s: номер страницы должен быть положительным", prompt)
+		return nil, 0, fmt.Errorf("номер страницы должен быть положительным")
+	}
+
+	if filter.Period != nil && (filter.Period.StartYear > filter.Period.EndYear ||
+		(filter.Period.StartYear == filter.Period.EndYear && filter.Period.StartQuarter > filter.Period.EndQuarter)) {
+		s.logger.Infof("%s: дата конца периода должна быть позже даты начала", prompt)
+		return nil, 0, fmt.Errorf("дата конца периода должна быть позже даты начала")
+	}
+
+	reports, numPages, err = s.finRepo.GetAll(ctx, filter, page)
+	if err != nil {
+		s.logger.Infof("%s: получение финансовых отчетов: %v", prompt, err)
+		return nil, 0, fmt.Errorf("получение финансовых отчетов: %w", err)
+	}
+
+	return reports, numPages, nil
+}
+
 func (s *Service) Update(ctx context.Context, finReport *domain.FinancialReport, ownerId uuid.UUID) (err error) {
 	prompt := "FinReportUpdate"
 
@@ -135,6 +166,55 @@ func (s *Service) Update(ctx context.Context, finReport *domain.FinancialReport,
 	return nil
 }
 
+// Verify отмечает отчет как проверенный. Ни основной владелец компании, ни ее совладельцы, принявшие
+// приглашение, не могут проверить собственный отчет.
+func (s *Service) Verify(ctx context.Context, id uuid.UUID, verifierId uuid.UUID) (err error) {
+	prompt := "FinReportVerify"
+
+	report, err := s.finRepo.GetById(ctx, id)
+	if err != nil {
+		s.logger.Infof("%s: получение финансового отчета: %v", prompt, err)
+		return fmt.Errorf("получение финансового отчета: %w", err)
+	}
+
+	if !report.VerifiedAt.IsZero() {
+		s.logger.Infof("%s: отчет уже проверен", prompt)
+		return fmt.Errorf("отчет уже проверен")
+	}
+
+	company, err := s.compRepo.GetById(ctx, report.CompanyID)
+	if err != nil {
+		s.logger.Infof("%s: получение компании: %v", prompt, err)
+		return fmt.Errorf("получение компании: %w", err)
+	}
+
+	if company.OwnerID == verifierId {
+		s.logger.Infof("%s: владелец компании не может проверять собственные отчеты", prompt)
+		return fmt.Errorf("владелец компании не может проверять собственные отчеты")
+	}
+
+	owners, err := s.ownerRepo.GetByCompanyId(ctx, report.CompanyID)
+	if err != nil {
+		s.logger.Infof("%s: получение совладельцев компании: %v", prompt, err)
+		return fmt.Errorf("получение совладельцев компании: %w", err)
+	}
+
+	for _, owner := range owners {
+		if owner.UserID == verifierId && owner.Accepted() {
+			s.logger.Infof("%s: совладелец компании не может проверять собственные отчеты", prompt)
+			return fmt.Errorf("совладелец компании не может проверять собственные отчеты")
+		}
+	}
+
+	err = s.finRepo.Verify(ctx, id, verifierId)
+	if err != nil {
+		s.logger.Infof("%s: %v", prompt, err)
+		return err
+	}
+
+	return nil
+}
+
 func (s *Service) DeleteById(ctx context.Context, id uuid.UUID, ownerId uuid.UUID) (err error) {
 	prompt := "FinReportDeleteById"
 
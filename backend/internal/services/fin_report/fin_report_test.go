@@ -5,11 +5,15 @@ import (
 	"errors"
 	"fmt"
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
+	"io"
 	"ppo/domain"
 	"ppo/mocks"
+	"ppo/pkg/logger"
 	"testing"
+	"time"
 )
 
 func TestFinReportService_Create(t *testing.T) {
@@ -17,7 +21,10 @@ func TestFinReportService_Create(t *testing.T) {
 	defer ctrl.Finish()
 
 	finRepo := mocks.NewMockIFinancialReportRepository(ctrl)
-	svc := NewService(finRepo)
+	svc := NewService(finRepo, mocks.NewMockICompanyRepository(ctrl), mocks.NewMockICompanyOwnerRepository(ctrl), logger.NewLogger(logger.InfoLevel, io.Discard))
+
+	now := time.Now()
+	curYear, curQuarter := now.Year(), int(now.Month()-1)/3+1
 
 	testCases := []struct {
 		name       string
@@ -30,8 +37,8 @@ func TestFinReportService_Create(t *testing.T) {
 			name: "успешное добавление",
 			data: &domain.FinancialReport{
 				CompanyID: uuid.UUID{1},
-				Revenue:   1,
-				Costs:     1,
+				Revenue:   decimal.NewFromInt(1),
+				Costs:     decimal.NewFromInt(1),
 				Year:      1,
 				Quarter:   1,
 			},
@@ -41,8 +48,8 @@ func TestFinReportService_Create(t *testing.T) {
 						context.Background(),
 						&domain.FinancialReport{
 							CompanyID: uuid.UUID{1},
-							Revenue:   1,
-							Costs:     1,
+							Revenue:   decimal.NewFromInt(1),
+							Costs:     decimal.NewFromInt(1),
 							Year:      1,
 							Quarter:   1,
 						},
@@ -54,8 +61,8 @@ func TestFinReportService_Create(t *testing.T) {
 			name: "отрицательная выручка",
 			data: &domain.FinancialReport{
 				CompanyID: uuid.UUID{1},
-				Revenue:   -1,
-				Costs:     1,
+				Revenue:   decimal.NewFromInt(-1),
+				Costs:     decimal.NewFromInt(1),
 				Year:      1,
 				Quarter:   1,
 			},
@@ -65,8 +72,8 @@ func TestFinReportService_Create(t *testing.T) {
 						context.Background(),
 						&domain.FinancialReport{
 							CompanyID: uuid.UUID{1},
-							Revenue:   -1,
-							Costs:     1,
+							Revenue:   decimal.NewFromInt(-1),
+							Costs:     decimal.NewFromInt(1),
 							Year:      1,
 							Quarter:   1,
 						},
@@ -81,8 +88,8 @@ func TestFinReportService_Create(t *testing.T) {
 			name: "отрицательные расходы",
 			data: &domain.FinancialReport{
 				CompanyID: uuid.UUID{1},
-				Revenue:   1,
-				Costs:     -1,
+				Revenue:   decimal.NewFromInt(1),
+				Costs:     decimal.NewFromInt(-1),
 				Year:      1,
 				Quarter:   1,
 			},
@@ -92,8 +99,8 @@ func TestFinReportService_Create(t *testing.T) {
 						context.Background(),
 						&domain.FinancialReport{
 							CompanyID: uuid.UUID{1},
-							Revenue:   1,
-							Costs:     -1,
+							Revenue:   decimal.NewFromInt(1),
+							Costs:     decimal.NewFromInt(-1),
 							Year:      1,
 							Quarter:   1,
 						},
@@ -108,8 +115,8 @@ func TestFinReportService_Create(t *testing.T) {
 			name: "некорректное значение квартала",
 			data: &domain.FinancialReport{
 				CompanyID: uuid.UUID{1},
-				Revenue:   1,
-				Costs:     1,
+				Revenue:   decimal.NewFromInt(1),
+				Costs:     decimal.NewFromInt(1),
 				Year:      1,
 				Quarter:   5,
 			},
@@ -119,8 +126,8 @@ func TestFinReportService_Create(t *testing.T) {
 						context.Background(),
 						&domain.FinancialReport{
 							CompanyID: uuid.UUID{1},
-							Revenue:   1,
-							Costs:     1,
+							Revenue:   decimal.NewFromInt(1),
+							Costs:     decimal.NewFromInt(1),
 							Year:      1,
 							Quarter:   5,
 						},
@@ -135,9 +142,9 @@ func TestFinReportService_Create(t *testing.T) {
 			name: "указан год, больший текущего",
 			data: &domain.FinancialReport{
 				CompanyID: uuid.UUID{1},
-				Revenue:   1,
-				Costs:     1,
-				Year:      2025,
+				Revenue:   decimal.NewFromInt(1),
+				Costs:     decimal.NewFromInt(1),
+				Year:      curYear + 1,
 				Quarter:   1,
 			},
 			beforeTest: func(finRepo mocks.MockIFinancialReportRepository) {
@@ -146,9 +153,9 @@ func TestFinReportService_Create(t *testing.T) {
 						context.Background(),
 						&domain.FinancialReport{
 							CompanyID: uuid.UUID{1},
-							Revenue:   1,
-							Costs:     1,
-							Year:      2025,
+							Revenue:   decimal.NewFromInt(1),
+							Costs:     decimal.NewFromInt(1),
+							Year:      curYear + 1,
 							Quarter:   1,
 						},
 					).
@@ -162,10 +169,10 @@ func TestFinReportService_Create(t *testing.T) {
 			name: "указан квартал, который еще не закончен",
 			data: &domain.FinancialReport{
 				CompanyID: uuid.UUID{1},
-				Revenue:   1,
-				Costs:     1,
-				Year:      2024,
-				Quarter:   2,
+				Revenue:   decimal.NewFromInt(1),
+				Costs:     decimal.NewFromInt(1),
+				Year:      curYear,
+				Quarter:   curQuarter,
 			},
 			beforeTest: func(finRepo mocks.MockIFinancialReportRepository) {
 				finRepo.EXPECT().
@@ -173,10 +180,10 @@ func TestFinReportService_Create(t *testing.T) {
 						context.Background(),
 						&domain.FinancialReport{
 							CompanyID: uuid.UUID{1},
-							Revenue:   1,
-							Costs:     1,
-							Year:      2024,
-							Quarter:   2,
+							Revenue:   decimal.NewFromInt(1),
+							Costs:     decimal.NewFromInt(1),
+							Year:      curYear,
+							Quarter:   curQuarter,
 						},
 					).
 					Return(nil).
@@ -189,8 +196,8 @@ func TestFinReportService_Create(t *testing.T) {
 			name: "ошибка выполнения запроса в репозитории",
 			data: &domain.FinancialReport{
 				CompanyID: uuid.UUID{1},
-				Revenue:   1,
-				Costs:     1,
+				Revenue:   decimal.NewFromInt(1),
+				Costs:     decimal.NewFromInt(1),
 				Year:      2023,
 				Quarter:   1,
 			},
@@ -200,8 +207,8 @@ func TestFinReportService_Create(t *testing.T) {
 						context.Background(),
 						&domain.FinancialReport{
 							CompanyID: uuid.UUID{1},
-							Revenue:   1,
-							Costs:     1,
+							Revenue:   decimal.NewFromInt(1),
+							Costs:     decimal.NewFromInt(1),
 							Year:      2023,
 							Quarter:   1,
 						},
@@ -236,21 +243,32 @@ func TestFinReportService_DeleteById(t *testing.T) {
 	defer ctrl.Finish()
 
 	finRepo := mocks.NewMockIFinancialReportRepository(ctrl)
-	svc := NewService(finRepo)
+	compRepo := mocks.NewMockICompanyRepository(ctrl)
+	svc := NewService(finRepo, compRepo, mocks.NewMockICompanyOwnerRepository(ctrl), logger.NewLogger(logger.InfoLevel, io.Discard))
 
 	curUuid := uuid.New()
 
 	testCases := []struct {
 		name       string
 		id         uuid.UUID
-		beforeTest func(finRepo mocks.MockIFinancialReportRepository)
+		ownerId    uuid.UUID
+		beforeTest func(finRepo mocks.MockIFinancialReportRepository, compRepo mocks.MockICompanyRepository)
 		wantErr    bool
 		errStr     error
 	}{
 		{
-			name: "успешное удаление",
-			id:   curUuid,
-			beforeTest: func(finRepo mocks.MockIFinancialReportRepository) {
+			name:    "успешное удаление",
+			id:      curUuid,
+			ownerId: uuid.UUID{1},
+			beforeTest: func(finRepo mocks.MockIFinancialReportRepository, compRepo mocks.MockICompanyRepository) {
+				finRepo.EXPECT().
+					GetById(context.Background(), curUuid).
+					Return(&domain.FinancialReport{ID: curUuid, CompanyID: uuid.UUID{1}}, nil)
+
+				compRepo.EXPECT().
+					GetById(context.Background(), uuid.UUID{1}).
+					Return(&domain.Company{ID: uuid.UUID{1}, OwnerID: uuid.UUID{1}}, nil)
+
 				finRepo.EXPECT().
 					DeleteById(context.Background(), curUuid).
 					Return(nil)
@@ -258,9 +276,34 @@ func TestFinReportService_DeleteById(t *testing.T) {
 			wantErr: false,
 		},
 		{
-			name: "ошибка выполнения запроса в репозитории",
-			id:   curUuid,
-			beforeTest: func(finRepo mocks.MockIFinancialReportRepository) {
+			name:    "удаление отчета чужой компании",
+			id:      curUuid,
+			ownerId: uuid.UUID{2},
+			beforeTest: func(finRepo mocks.MockIFinancialReportRepository, compRepo mocks.MockICompanyRepository) {
+				finRepo.EXPECT().
+					GetById(context.Background(), curUuid).
+					Return(&domain.FinancialReport{ID: curUuid, CompanyID: uuid.UUID{1}}, nil)
+
+				compRepo.EXPECT().
+					GetById(context.Background(), uuid.UUID{1}).
+					Return(&domain.Company{ID: uuid.UUID{1}, OwnerID: uuid.UUID{1}}, nil)
+			},
+			wantErr: true,
+			errStr:  errors.New("только владелец компании может удалять финансовые отчеты"),
+		},
+		{
+			name:    "ошибка выполнения запроса в репозитории",
+			id:      curUuid,
+			ownerId: uuid.UUID{1},
+			beforeTest: func(finRepo mocks.MockIFinancialReportRepository, compRepo mocks.MockICompanyRepository) {
+				finRepo.EXPECT().
+					GetById(context.Background(), curUuid).
+					Return(&domain.FinancialReport{ID: curUuid, CompanyID: uuid.UUID{1}}, nil)
+
+				compRepo.EXPECT().
+					GetById(context.Background(), uuid.UUID{1}).
+					Return(&domain.Company{ID: uuid.UUID{1}, OwnerID: uuid.UUID{1}}, nil)
+
 				finRepo.EXPECT().
 					DeleteById(context.Background(), curUuid).
 					Return(fmt.Errorf("sql error"))
@@ -273,10 +316,10 @@ func TestFinReportService_DeleteById(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			ctx := context.Background()
 			if tc.beforeTest != nil {
-				tc.beforeTest(*finRepo)
+				tc.beforeTest(*finRepo, *compRepo)
 			}
 
-			err := svc.DeleteById(ctx, tc.id)
+			err := svc.DeleteById(ctx, tc.id, tc.ownerId)
 
 			if tc.wantErr {
 				require.Equal(t, tc.errStr.Error(), err.Error())
@@ -292,7 +335,7 @@ func TestFinReportService_GetByCompany(t *testing.T) {
 	defer ctrl.Finish()
 
 	finRepo := mocks.NewMockIFinancialReportRepository(ctrl)
-	svc := NewService(finRepo)
+	svc := NewService(finRepo, mocks.NewMockICompanyRepository(ctrl), mocks.NewMockICompanyOwnerRepository(ctrl), logger.NewLogger(logger.InfoLevel, io.Discard))
 
 	testCases := []struct {
 		name       string
@@ -330,78 +373,78 @@ func TestFinReportService_GetByCompany(t *testing.T) {
 								ID:      uuid.UUID{1},
 								Year:    2021,
 								Quarter: 2,
-								Revenue: 1432523,
-								Costs:   75423,
+								Revenue: decimal.NewFromInt(1432523),
+								Costs:   decimal.NewFromInt(75423),
 							},
 							{
 								ID:      uuid.UUID{2},
 								Year:    2021,
 								Quarter: 3,
-								Revenue: 7435235,
-								Costs:   125654,
+								Revenue: decimal.NewFromInt(7435235),
+								Costs:   decimal.NewFromInt(125654),
 							},
 							{
 								ID:      uuid.UUID{3},
 								Year:    2021,
 								Quarter: 4,
-								Revenue: 65742,
-								Costs:   7845634,
+								Revenue: decimal.NewFromInt(65742),
+								Costs:   decimal.NewFromInt(7845634),
 							},
 							{
 								ID:      uuid.UUID{4},
 								Year:    2022,
 								Quarter: 1,
-								Revenue: 43635325,
-								Costs:   12362332,
+								Revenue: decimal.NewFromInt(43635325),
+								Costs:   decimal.NewFromInt(12362332),
 							},
 							{
 								ID:      uuid.UUID{5},
 								Year:    2022,
 								Quarter: 2,
-								Revenue: 50934123,
-								Costs:   13543623,
+								Revenue: decimal.NewFromInt(50934123),
+								Costs:   decimal.NewFromInt(13543623),
 							},
 							{
 								ID:      uuid.UUID{6},
 								Year:    2022,
 								Quarter: 3,
-								Revenue: 78902453,
-								Costs:   15326443,
+								Revenue: decimal.NewFromInt(78902453),
+								Costs:   decimal.NewFromInt(15326443),
 							},
 							{
 								ID:      uuid.UUID{7},
 								Year:    2022,
 								Quarter: 4,
-								Revenue: 64352357,
-								Costs:   23534252,
+								Revenue: decimal.NewFromInt(64352357),
+								Costs:   decimal.NewFromInt(23534252),
 							}, // 173 057 608 => 34 611 521.6; 237 824 258 => 14.5534025
 							{
 								ID:      uuid.UUID{8},
 								Year:    2023,
 								Quarter: 1,
-								Revenue: 32532513,
-								Costs:   5436438,
+								Revenue: decimal.NewFromInt(32532513),
+								Costs:   decimal.NewFromInt(5436438),
 							},
 							{
 								ID:      uuid.UUID{9},
 								Year:    2023,
 								Quarter: 2,
-								Revenue: 6743634,
-								Costs:   9876967,
+								Revenue: decimal.NewFromInt(6743634),
+								Costs:   decimal.NewFromInt(9876967),
 							},
 							{
 								ID:      uuid.UUID{10},
 								Year:    2023,
 								Quarter: 3,
-								Revenue: 46754124,
-								Costs:   24367653,
+								Revenue: decimal.NewFromInt(46754124),
+								Costs:   decimal.NewFromInt(24367653),
 							},
 							{
 								ID:      uuid.UUID{11},
 								Year:    2023,
 								Quarter: 4,
-								Revenue: 14385253,
-								Costs:   7546424,
+								Revenue: decimal.NewFromInt(14385253),
+								Costs:   decimal.NewFromInt(7546424),
 							},
 						},
 						Period: &domain.Period{
@@ -418,78 +461,78 @@ func TestFinReportService_GetByCompany(t *testing.T) {
 						ID:      uuid.UUID{1},
 						Year:    2021,
 						Quarter: 2,
-						Revenue: 1432523,
-						Costs:   75423,
+						Revenue: decimal.NewFromInt(1432523),
+						Costs:   decimal.NewFromInt(75423),
 					},
 					{
 						ID:      uuid.UUID{2},
 						Year:    2021,
 						Quarter: 3,
-						Revenue: 7435235,
-						Costs:   125654,
+						Revenue: decimal.NewFromInt(7435235),
+						Costs:   decimal.NewFromInt(125654),
 					},
 					{
 						ID:      uuid.UUID{3},
 						Year:    2021,
 						Quarter: 4,
-						Revenue: 65742,
-						Costs:   7845634,
+						Revenue: decimal.NewFromInt(65742),
+						Costs:   decimal.NewFromInt(7845634),
 					},
 					{
 						ID:      uuid.UUID{4},
 						Year:    2022,
 						Quarter: 1,
-						Revenue: 43635325,
-						Costs:   12362332,
+						Revenue: decimal.NewFromInt(43635325),
+						Costs:   decimal.NewFromInt(12362332),
 					},
 					{
 						ID:      uuid.UUID{5},
 						Year:    2022,
 						Quarter: 2,
-						Revenue: 50934123,
-						Costs:   13543623,
+						Revenue: decimal.NewFromInt(50934123),
+						Costs:   decimal.NewFromInt(13543623),
 					},
 					{
 						ID:      uuid.UUID{6},
 						Year:    2022,
 						Quarter: 3,
-						Revenue: 78902453,
-						Costs:   15326443,
+						Revenue: decimal.NewFromInt(78902453),
+						Costs:   decimal.NewFromInt(15326443),
 					},
 					{
 						ID:      uuid.UUID{7},
 						Year:    2022,
 						Quarter: 4,
-						Revenue: 64352357,
-						Costs:   23534252,
+						Revenue: decimal.NewFromInt(64352357),
+						Costs:   decimal.NewFromInt(23534252),
 					}, // 173 057 608 => 34 611 521.6; 237 824 258 => 14.5534025
 					{
 						ID:      uuid.UUID{8},
 						Year:    2023,
 						Quarter: 1,
-						Revenue: 32532513,
-						Costs:   5436438,
+						Revenue: decimal.NewFromInt(32532513),
+						Costs:   decimal.NewFromInt(5436438),
 					},
 					{
 						ID:      uuid.UUID{9},
 						Year:    2023,
 						Quarter: 2,
-						Revenue: 6743634,
-						Costs:   9876967,
+						Revenue: decimal.NewFromInt(6743634),
+						Costs:   decimal.NewFromInt(9876967),
 					},
 					{
 						ID:      uuid.UUID{10},
 						Year:    2023,
 						Quarter: 3,
-						Revenue: 46754124,
-						Costs:   24367653,
+						Revenue: decimal.NewFromInt(46754124),
+						Costs:   decimal.NewFromInt(24367653),
 					},
 					{
 						ID:      uuid.UUID{11},
 						Year:    2023,
 						Quarter: 4,
-						Revenue: 14385253,
-						Costs:   7546424,
+						Revenue: decimal.NewFromInt(14385253),
+						Costs:   decimal.NewFromInt(7546424),
 					},
 				},
 				Period: &domain.Period{
@@ -607,7 +650,7 @@ func TestFinReportService_GetById(t *testing.T) {
 	defer ctrl.Finish()
 
 	repo := mocks.NewMockIFinancialReportRepository(ctrl)
-	svc := NewService(repo)
+	svc := NewService(repo, mocks.NewMockICompanyRepository(ctrl), mocks.NewMockICompanyOwnerRepository(ctrl), logger.NewLogger(logger.InfoLevel, io.Discard))
 
 	testCases := []struct {
 		name       string
@@ -629,8 +672,8 @@ func TestFinReportService_GetById(t *testing.T) {
 					Return(&domain.FinancialReport{
 						ID:        uuid.UUID{1},
 						CompanyID: uuid.UUID{1},
-						Revenue:   1,
-						Costs:     1,
+						Revenue:   decimal.NewFromInt(1),
+						Costs:     decimal.NewFromInt(1),
 						Year:      1,
 						Quarter:   1,
 					}, nil)
@@ -638,8 +681,8 @@ func TestFinReportService_GetById(t *testing.T) {
 			expected: &domain.FinancialReport{
 				ID:        uuid.UUID{1},
 				CompanyID: uuid.UUID{1},
-				Revenue:   1,
-				Costs:     1,
+				Revenue:   decimal.NewFromInt(1),
+				Costs:     decimal.NewFromInt(1),
 				Year:      1,
 				Quarter:   1,
 			},
@@ -684,12 +727,14 @@ func TestFinReportService_Update(t *testing.T) {
 	defer ctrl.Finish()
 
 	repo := mocks.NewMockIFinancialReportRepository(ctrl)
-	svc := NewService(repo)
+	compRepo := mocks.NewMockICompanyRepository(ctrl)
+	svc := NewService(repo, compRepo, mocks.NewMockICompanyOwnerRepository(ctrl), logger.NewLogger(logger.InfoLevel, io.Discard))
 
 	testCases := []struct {
 		name       string
 		report     *domain.FinancialReport
-		beforeTest func(finRepo mocks.MockIFinancialReportRepository)
+		ownerId    uuid.UUID
+		beforeTest func(finRepo mocks.MockIFinancialReportRepository, compRepo mocks.MockICompanyRepository)
 		wantErr    bool
 		errStr     error
 	}{
@@ -697,33 +742,70 @@ func TestFinReportService_Update(t *testing.T) {
 			name: "успешное обновление",
 			report: &domain.FinancialReport{
 				ID:      uuid.UUID{1},
-				Revenue: 2,
+				Revenue: decimal.NewFromInt(2),
 			},
-			beforeTest: func(finRepo mocks.MockIFinancialReportRepository) {
+			ownerId: uuid.UUID{1},
+			beforeTest: func(finRepo mocks.MockIFinancialReportRepository, compRepo mocks.MockICompanyRepository) {
+				finRepo.EXPECT().
+					GetById(context.Background(), uuid.UUID{1}).
+					Return(&domain.FinancialReport{ID: uuid.UUID{1}, CompanyID: uuid.UUID{1}}, nil)
+
+				compRepo.EXPECT().
+					GetById(context.Background(), uuid.UUID{1}).
+					Return(&domain.Company{ID: uuid.UUID{1}, OwnerID: uuid.UUID{1}}, nil)
+
 				finRepo.EXPECT().
 					Update(
 						context.Background(),
 						&domain.FinancialReport{
 							ID:      uuid.UUID{1},
-							Revenue: 2,
+							Revenue: decimal.NewFromInt(2),
 						},
 					).Return(nil)
 			},
 			wantErr: false,
 		},
+		{
+			name: "обновление отчета чужой компании",
+			report: &domain.FinancialReport{
+				ID:      uuid.UUID{1},
+				Revenue: decimal.NewFromInt(2),
+			},
+			ownerId: uuid.UUID{2},
+			beforeTest: func(finRepo mocks.MockIFinancialReportRepository, compRepo mocks.MockICompanyRepository) {
+				finRepo.EXPECT().
+					GetById(context.Background(), uuid.UUID{1}).
+					Return(&domain.FinancialReport{ID: uuid.UUID{1}, CompanyID: uuid.UUID{1}}, nil)
+
+				compRepo.EXPECT().
+					GetById(context.Background(), uuid.UUID{1}).
+					Return(&domain.Company{ID: uuid.UUID{1}, OwnerID: uuid.UUID{1}}, nil)
+			},
+			wantErr: true,
+			errStr:  errors.New("только владелец компании может изменять финансовый отчет"),
+		},
 		{
 			name: "ошибка выполнения запроса в репозитории",
 			report: &domain.FinancialReport{
 				ID:      uuid.UUID{1},
-				Revenue: 2,
+				Revenue: decimal.NewFromInt(2),
 			},
-			beforeTest: func(finRepo mocks.MockIFinancialReportRepository) {
+			ownerId: uuid.UUID{1},
+			beforeTest: func(finRepo mocks.MockIFinancialReportRepository, compRepo mocks.MockICompanyRepository) {
+				finRepo.EXPECT().
+					GetById(context.Background(), uuid.UUID{1}).
+					Return(&domain.FinancialReport{ID: uuid.UUID{1}, CompanyID: uuid.UUID{1}}, nil)
+
+				compRepo.EXPECT().
+					GetById(context.Background(), uuid.UUID{1}).
+					Return(&domain.Company{ID: uuid.UUID{1}, OwnerID: uuid.UUID{1}}, nil)
+
 				finRepo.EXPECT().
 					Update(
 						context.Background(),
 						&domain.FinancialReport{
 							ID:      uuid.UUID{1},
-							Revenue: 2,
+							Revenue: decimal.NewFromInt(2),
 						},
 					).Return(fmt.Errorf("sql error"))
 			},
@@ -735,10 +817,115 @@ func TestFinReportService_Update(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			ctx := context.Background()
 			if tc.beforeTest != nil {
-				tc.beforeTest(*repo)
+				tc.beforeTest(*repo, *compRepo)
+			}
+
+			err := svc.Update(ctx, tc.report, tc.ownerId)
+
+			if tc.wantErr {
+				require.Equal(t, tc.errStr.Error(), err.Error())
+			} else {
+				require.Nil(t, err)
+			}
+		})
+	}
+}
+
+func TestFinReportService_Verify(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	finRepo := mocks.NewMockIFinancialReportRepository(ctrl)
+	compRepo := mocks.NewMockICompanyRepository(ctrl)
+	ownerRepo := mocks.NewMockICompanyOwnerRepository(ctrl)
+	svc := NewService(finRepo, compRepo, ownerRepo, logger.NewLogger(logger.InfoLevel, io.Discard))
+
+	acceptedAt := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	// компанией {1} владеет пользователь {1}; пользователь {2} - принявший приглашение совладелец,
+	// пользователь {3} приглашение еще не принял
+	owners := []*domain.CompanyOwner{
+		{CompanyID: uuid.UUID{1}, UserID: uuid.UUID{1}, Share: decimal.NewFromInt(50), AcceptedAt: acceptedAt},
+		{CompanyID: uuid.UUID{1}, UserID: uuid.UUID{2}, Share: decimal.NewFromInt(30), AcceptedAt: acceptedAt},
+		{CompanyID: uuid.UUID{1}, UserID: uuid.UUID{3}, Share: decimal.NewFromInt(20)},
+	}
+
+	testCases := []struct {
+		name       string
+		verifierId uuid.UUID
+		beforeTest func(finRepo mocks.MockIFinancialReportRepository, ownerRepo mocks.MockICompanyOwnerRepository)
+		wantErr    bool
+		errStr     error
+	}{
+		{
+			name:       "успешная проверка",
+			verifierId: uuid.UUID{4},
+			beforeTest: func(finRepo mocks.MockIFinancialReportRepository, ownerRepo mocks.MockICompanyOwnerRepository) {
+				ownerRepo.EXPECT().
+					GetByCompanyId(context.Background(), uuid.UUID{1}).
+					Return(owners, nil)
+
+				finRepo.EXPECT().
+					Verify(context.Background(), uuid.UUID{9}, uuid.UUID{4}).
+					Return(nil)
+			},
+		},
+		{
+			name:       "проверка пользователем, не принявшим приглашение",
+			verifierId: uuid.UUID{3},
+			beforeTest: func(finRepo mocks.MockIFinancialReportRepository, ownerRepo mocks.MockICompanyOwnerRepository) {
+				ownerRepo.EXPECT().
+					GetByCompanyId(context.Background(), uuid.UUID{1}).
+					Return(owners, nil)
+
+				finRepo.EXPECT().
+					Verify(context.Background(), uuid.UUID{9}, uuid.UUID{3}).
+					Return(nil)
+			},
+		},
+		{
+			name:       "проверка основным владельцем",
+			verifierId: uuid.UUID{1},
+			wantErr:    true,
+			errStr:     errors.New("владелец компании не может проверять собственные отчеты"),
+		},
+		{
+			name:       "проверка совладельцем",
+			verifierId: uuid.UUID{2},
+			beforeTest: func(finRepo mocks.MockIFinancialReportRepository, ownerRepo mocks.MockICompanyOwnerRepository) {
+				ownerRepo.EXPECT().
+					GetByCompanyId(context.Background(), uuid.UUID{1}).
+					Return(owners, nil)
+			},
+			wantErr: true,
+			errStr:  errors.New("совладелец компании не может проверять собственные отчеты"),
+		},
+		{
+			name:       "ошибка получения совладельцев",
+			verifierId: uuid.UUID{4},
+			beforeTest: func(finRepo mocks.MockIFinancialReportRepository, ownerRepo mocks.MockICompanyOwnerRepository) {
+				ownerRepo.EXPECT().
+					GetByCompanyId(context.Background(), uuid.UUID{1}).
+					Return(nil, fmt.Errorf("sql error"))
+			},
+			wantErr: true,
+			errStr:  errors.New("получение совладельцев компании: sql error"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			finRepo.EXPECT().
+				GetById(context.Background(), uuid.UUID{9}).
+				Return(&domain.FinancialReport{ID: uuid.UUID{9}, CompanyID: uuid.UUID{1}}, nil)
+			compRepo.EXPECT().
+				GetById(context.Background(), uuid.UUID{1}).
+				Return(&domain.Company{ID: uuid.UUID{1}, OwnerID: uuid.UUID{1}}, nil)
+
+			if tc.beforeTest != nil {
+				tc.beforeTest(*finRepo, *ownerRepo)
 			}
 
-			err := svc.Update(ctx, tc.report)
+			err := svc.Verify(context.Background(), uuid.UUID{9}, tc.verifierId)
 
 			if tc.wantErr {
 				require.Equal(t, tc.errStr.Error(), err.Error())
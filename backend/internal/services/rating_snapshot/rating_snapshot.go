@@ -0,0 +1,111 @@
+package rating_snapshot
+
+import (
+	"context"
+	"fmt"
+	"ppo/domain"
+	"ppo/pkg/logger"
+
+	"github.com/google/uuid"
+)
+
+type Service struct {
+	snapshotRepo domain.IRatingSnapshotRepository
+	logger       logger.ILogger
+}
+
+func NewService(snapshotRepo domain.IRatingSnapshotRepository, logger logger.ILogger) domain.IRatingSnapshotService {
+	return &Service{
+		snapshotRepo: snapshotRepo,
+		logger:       logger,
+	}
+}
+
+func (s *Service) Save(ctx context.Context, snapshot *domain.RatingSnapshot) (err error) {
+	prompt := "RatingSnapshotSave"
+
+	if snapshot.Year <= 0 {
+		s.logger.Infof("%s: должен быть указан год", prompt)
+		return fmt.Errorf("должен быть указан год")
+	}
+
+	if snapshot.Quarter < 1 || snapshot.Quarter > 4 {
+		s.logger.Infof("%s: номер квартала должен быть от 1 до 4", prompt)
+		return fmt.Errorf("номер квартала должен быть от 1 до 4")
+	}
+
+	if snapshot.Strategy == "" {
+		s.logger.Infof("%s: должна быть указана стратегия вычисления рейтинга", prompt)
+		return fmt.Errorf("должна быть указана стратегия вычисления рейтинга")
+	}
+
+	err = s.snapshotRepo.Save(ctx, snapshot)
+	if err != nil {
+		s.logger.Infof("%s: сохранение рейтинга за квартал: %v", prompt, err)
+		return fmt.Errorf("сохранение рейтинга за квартал: %w", err)
+	}
+
+	return nil
+}
+
+// GetByUserId возвращает историю рейтинга предпринимателя, вычисленного стратегией strategy, за период,
+// а если он не задан - за все время.
+func (s *Service) GetByUserId(ctx context.Context, userId uuid.UUID, period *domain.Period, strategy string) (snapshots []*domain.RatingSnapshot, err error) {
+	prompt := "RatingSnapshotGetByUserId"
+
+	if strategy == "" {
+		s.logger.Infof("%s: должна быть указана стратегия вычисления рейтинга", prompt)
+		return nil, fmt.Errorf("должна быть указана стратегия вычисления рейтинга")
+	}
+
+	if period != nil {
+		err = period.Validate()
+		if err != nil {
+			s.logger.Infof("%s: %v", prompt, err)
+			return nil, err
+		}
+	}
+
+	snapshots, err = s.snapshotRepo.GetByUserId(ctx, userId, period, strategy)
+	if err != nil {
+		s.logger.Infof("%s: получение истории рейтинга: %v", prompt, err)
+		return nil, fmt.Errorf("получение истории рейтинга: %w", err)
+	}
+
+	return snapshots, nil
+}
+
+func (s *Service) GetLastCompletedQuarter(ctx context.Context, strategy string) (year, quarter int, err error) {
+	prompt := "RatingSnapshotGetLastCompletedQuarter"
+
+	year, quarter, err = s.snapshotRepo.GetLastCompletedQuarter(ctx, strategy)
+	if err != nil {
+		s.logger.Infof("%s: получение последнего квартала с сохраненными рейтингами: %v", prompt, err)
+		return 0, 0, fmt.Errorf("получение последнего квартала с сохраненными рейтингами: %w", err)
+	}
+
+	return year, quarter, nil
+}
+
+// CompleteQuarter отмечает, что рейтинги всех предпринимателей за квартал, вычисленные стратегией strategy, сохранены.
+func (s *Service) CompleteQuarter(ctx context.Context, year, quarter int, strategy string) (err error) {
+	prompt := "RatingSnapshotCompleteQuarter"
+
+	if quarter < 1 || quarter > 4 {
+		s.logger.Infof("%s: номер квартала должен быть от 1 до 4", prompt)
+		return fmt.Errorf("номер квартала должен быть от 1 до 4")
+	}
+
+	if strategy == "" {
+		s.logger.Infof("%s: должна быть указана стратегия вычисления рейтинга", prompt)
+		return fmt.Errorf("должна быть указана стратегия вычисления рейтинга")
+	}
+
+	err = s.snapshotRepo.CompleteQuarter(ctx, year, quarter, strategy)
+	if err != nil {
+		s.logger.Infof("%s: отметка о сохранении рейтингов за квартал: %v", prompt, err)
+		return fmt.Errorf("отметка о сохранении рейтингов за квартал: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,282 @@
+package rating_snapshot
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"io"
+	"ppo/domain"
+	"ppo/mocks"
+	"ppo/pkg/logger"
+	"testing"
+)
+
+func TestRatingSnapshotService_Save(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	snapshotRepo := mocks.NewMockIRatingSnapshotRepository(ctrl)
+	svc := NewService(snapshotRepo, logger.NewLogger(logger.InfoLevel, io.Discard))
+
+	testCases := []struct {
+		name       string
+		data       *domain.RatingSnapshot
+		beforeTest func(snapshotRepo mocks.MockIRatingSnapshotRepository)
+		wantErr    bool
+		errStr     error
+	}{
+		{
+			name: "успешное сохранение",
+			data: &domain.RatingSnapshot{
+				UserID:   uuid.UUID{1},
+				Year:     2024,
+				Quarter:  2,
+				Strategy: domain.RatingStrategyCurrent,
+				Rating:   0.5,
+			},
+			beforeTest: func(snapshotRepo mocks.MockIRatingSnapshotRepository) {
+				snapshotRepo.EXPECT().
+					Save(
+						context.Background(),
+						&domain.RatingSnapshot{
+							UserID:   uuid.UUID{1},
+							Year:     2024,
+							Quarter:  2,
+							Strategy: domain.RatingStrategyCurrent,
+							Rating:   0.5,
+						},
+					).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "не указан год",
+			data: &domain.RatingSnapshot{
+				UserID:  uuid.UUID{1},
+				Quarter: 2,
+			},
+			wantErr: true,
+			errStr:  errors.New("должен быть указан год"),
+		},
+		{
+			name: "некорректный квартал",
+			data: &domain.RatingSnapshot{
+				UserID:  uuid.UUID{1},
+				Year:    2024,
+				Quarter: 5,
+			},
+			wantErr: true,
+			errStr:  errors.New("номер квартала должен быть от 1 до 4"),
+		},
+		{
+			name: "не указана стратегия",
+			data: &domain.RatingSnapshot{
+				UserID:  uuid.UUID{1},
+				Year:    2024,
+				Quarter: 2,
+			},
+			wantErr: true,
+			errStr:  errors.New("должна быть указана стратегия вычисления рейтинга"),
+		},
+		{
+			name: "ошибка выполнения запроса в репозитории",
+			data: &domain.RatingSnapshot{
+				UserID:   uuid.UUID{1},
+				Year:     2024,
+				Quarter:  2,
+				Strategy: domain.RatingStrategyCurrent,
+			},
+			beforeTest: func(snapshotRepo mocks.MockIRatingSnapshotRepository) {
+				snapshotRepo.EXPECT().
+					Save(
+						context.Background(),
+						&domain.RatingSnapshot{
+							UserID:   uuid.UUID{1},
+							Year:     2024,
+							Quarter:  2,
+							Strategy: domain.RatingStrategyCurrent,
+						},
+					).Return(errors.New("sql error"))
+			},
+			wantErr: true,
+			errStr:  errors.New("сохранение рейтинга за квартал: sql error"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.beforeTest != nil {
+				tc.beforeTest(*snapshotRepo)
+			}
+
+			err := svc.Save(context.Background(), tc.data)
+
+			if tc.wantErr {
+				require.Equal(t, tc.errStr.Error(), err.Error())
+			} else {
+				require.Nil(t, err)
+			}
+		})
+	}
+}
+
+func TestRatingSnapshotService_GetByUserId(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	snapshotRepo := mocks.NewMockIRatingSnapshotRepository(ctrl)
+	svc := NewService(snapshotRepo, logger.NewLogger(logger.InfoLevel, io.Discard))
+
+	history := []*domain.RatingSnapshot{
+		{UserID: uuid.UUID{1}, Year: 2023, Quarter: 4, Rating: 0.4},
+		{UserID: uuid.UUID{1}, Year: 2024, Quarter: 1, Rating: 0.45},
+	}
+
+	testCases := []struct {
+		name       string
+		userId     uuid.UUID
+		period     *domain.Period
+		strategy   string
+		beforeTest func(snapshotRepo mocks.MockIRatingSnapshotRepository)
+		expected   []*domain.RatingSnapshot
+		wantErr    bool
+		errStr     error
+	}{
+		{
+			name:     "вся история",
+			userId:   uuid.UUID{1},
+			strategy: domain.RatingStrategyCurrent,
+			beforeTest: func(snapshotRepo mocks.MockIRatingSnapshotRepository) {
+				snapshotRepo.EXPECT().
+					GetByUserId(context.Background(), uuid.UUID{1}, nil, domain.RatingStrategyCurrent).
+					Return(history, nil)
+			},
+			expected: history,
+		},
+		{
+			name:     "история за период",
+			userId:   uuid.UUID{1},
+			period:   &domain.Period{StartYear: 2024, StartQuarter: 1, EndYear: 2024, EndQuarter: 4},
+			strategy: domain.RatingStrategyCurrent,
+			beforeTest: func(snapshotRepo mocks.MockIRatingSnapshotRepository) {
+				snapshotRepo.EXPECT().
+					GetByUserId(
+						context.Background(),
+						uuid.UUID{1},
+						&domain.Period{StartYear: 2024, StartQuarter: 1, EndYear: 2024, EndQuarter: 4},
+						domain.RatingStrategyCurrent,
+					).
+					Return(history[1:], nil)
+			},
+			expected: history[1:],
+		},
+		{
+			name:     "конец периода раньше начала",
+			userId:   uuid.UUID{1},
+			period:   &domain.Period{StartYear: 2024, StartQuarter: 1, EndYear: 2023, EndQuarter: 4},
+			strategy: domain.RatingStrategyCurrent,
+			wantErr:  true,
+			errStr:   errors.New("дата конца периода должна быть позже даты начала"),
+		},
+		{
+			name:    "не указана стратегия",
+			userId:  uuid.UUID{1},
+			wantErr: true,
+			errStr:  errors.New("должна быть указана стратегия вычисления рейтинга"),
+		},
+		{
+			name:     "ошибка выполнения запроса в репозитории",
+			userId:   uuid.UUID{1},
+			strategy: domain.RatingStrategyCurrent,
+			beforeTest: func(snapshotRepo mocks.MockIRatingSnapshotRepository) {
+				snapshotRepo.EXPECT().
+					GetByUserId(context.Background(), uuid.UUID{1}, nil, domain.RatingStrategyCurrent).
+					Return(nil, errors.New("sql error"))
+			},
+			wantErr: true,
+			errStr:  errors.New("получение истории рейтинга: sql error"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.beforeTest != nil {
+				tc.beforeTest(*snapshotRepo)
+			}
+
+			snapshots, err := svc.GetByUserId(context.Background(), tc.userId, tc.period, tc.strategy)
+
+			if tc.wantErr {
+				require.Equal(t, tc.errStr.Error(), err.Error())
+			} else {
+				require.Nil(t, err)
+				require.Equal(t, tc.expected, snapshots)
+			}
+		})
+	}
+}
+
+func TestRatingSnapshotService_CompleteQuarter(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	snapshotRepo := mocks.NewMockIRatingSnapshotRepository(ctrl)
+	svc := NewService(snapshotRepo, logger.NewLogger(logger.InfoLevel, io.Discard))
+
+	testCases := []struct {
+		name       string
+		year       int
+		quarter    int
+		beforeTest func(snapshotRepo mocks.MockIRatingSnapshotRepository)
+		wantErr    bool
+		errStr     error
+	}{
+		{
+			name:    "успешная отметка",
+			year:    2024,
+			quarter: 1,
+			beforeTest: func(snapshotRepo mocks.MockIRatingSnapshotRepository) {
+				snapshotRepo.EXPECT().
+					CompleteQuarter(context.Background(), 2024, 1, domain.RatingStrategyCurrent).
+					Return(nil)
+			},
+		},
+		{
+			name:    "некорректный квартал",
+			year:    2024,
+			quarter: 0,
+			wantErr: true,
+			errStr:  errors.New("номер квартала должен быть от 1 до 4"),
+		},
+		{
+			name:    "ошибка выполнения запроса в репозитории",
+			year:    2024,
+			quarter: 1,
+			beforeTest: func(snapshotRepo mocks.MockIRatingSnapshotRepository) {
+				snapshotRepo.EXPECT().
+					CompleteQuarter(context.Background(), 2024, 1, domain.RatingStrategyCurrent).
+					Return(errors.New("sql error"))
+			},
+			wantErr: true,
+			errStr:  errors.New("отметка о сохранении рейтингов за квартал: sql error"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.beforeTest != nil {
+				tc.beforeTest(*snapshotRepo)
+			}
+
+			err := svc.CompleteQuarter(context.Background(), tc.year, tc.quarter, domain.RatingStrategyCurrent)
+
+			if tc.wantErr {
+				require.Equal(t, tc.errStr.Error(), err.Error())
+			} else {
+				require.Nil(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,179 @@
+package review
+
+import (
+	"context"
+	"fmt"
+	"ppo/domain"
+	"ppo/pkg/logger"
+
+	"github.com/google/uuid"
+)
+
+const (
+	minRating = 1
+	maxRating = 5
+)
+
+type Service struct {
+	reviewRepo domain.IReviewRepository
+	userRepo   domain.IUserRepository
+	logger     logger.ILogger
+}
+
+func NewService(
+	reviewRepo domain.IReviewRepository,
+	userRepo domain.IUserRepository,
+	logger logger.ILogger,
+) domain.IReviewService {
+	return &Service{
+		reviewRepo: reviewRepo,
+		userRepo:   userRepo,
+		logger:     logger,
+	}
+}
+
+func (s *Service) Create(ctx context.Context, review *domain.Review) (err error) {
+	prompt := "ReviewCreate"
+
+	if review.TargetID == review.ReviewerID {
+		s.logger.Infof("%s: нельзя оставить отзыв самому себе", prompt)
+		return fmt.Errorf("нельзя оставить отзыв самому себе")
+	}
+
+	if review.Pros == "" {
+		s.logger.Infof("%s: должны быть указаны достоинства", prompt)
+		return fmt.Errorf("должны быть указаны достоинства")
+	}
+
+	if review.Cons == "" {
+		s.logger.Infof("%s: должны быть указаны недостатки", prompt)
+		return fmt.Errorf("должны быть указаны недостатки")
+	}
+
+	if review.Rating < minRating || review.Rating > maxRating {
+		s.logger.Infof("%s: оценка должна находиться в отрезке от %d до %d", prompt, minRating, maxRating)
+		return fmt.Errorf("оценка должна находиться в отрезке от %d до %d", minRating, maxRating)
+	}
+
+	_, err = s.userRepo.GetById(ctx, review.TargetID)
+	if err != nil {
+		s.logger.Infof("%s: получение предпринимателя по id: %v", prompt, err)
+		return fmt.Errorf("получение предпринимателя по id: %w", err)
+	}
+
+	exists, err := s.reviewRepo.ExistsByReviewer(ctx, review.TargetID, review.ReviewerID)
+	if err != nil {
+		s.logger.Infof("%s: проверка наличия отзыва: %v", prompt, err)
+		return fmt.Errorf("проверка наличия отзыва: %w", err)
+	}
+
+	if exists {
+		s.logger.Infof("%s: отзыв об этом предпринимателе уже оставлен", prompt)
+		return fmt.Errorf("отзыв об этом предпринимателе уже оставлен")
+	}
+
+	err = s.reviewRepo.Create(ctx, review)
+	if err != nil {
+		s.logger.Infof("%s: создание отзыва: %v", prompt, err)
+		return fmt.Errorf("создание отзыва: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Service) GetById(ctx context.Context, id uuid.UUID) (review *domain.Review, err error) {
+	prompt := "ReviewGetById"
+
+	review, err = s.reviewRepo.GetById(ctx, id)
+	if err != nil {
+		s.logger.Infof("%s: получение отзыва по id: %v", prompt, err)
+		return nil, fmt.Errorf("получение отзыва по id: %w", err)
+	}
+
+	return review, nil
+}
+
+func (s *Service) GetByTarget(ctx context.Context, targetId uuid.UUID, page int) (reviews []*domain.Review, numPages int, err error) {
+	prompt := "ReviewGetByTarget"
+
+	reviews, numPages, err = s.reviewRepo.GetByTarget(ctx, targetId, page)
+	if err != nil {
+		s.logger.Infof("%s: получение отзывов о предпринимателе: %v", prompt, err)
+		return nil, 0, fmt.Errorf("получение отзывов о предпринимателе: %w", err)
+	}
+
+	return reviews, numPages, nil
+}
+
+func (s *Service) GetStatsByTarget(ctx context.Context, targetId uuid.UUID) (stats *domain.ReviewStats, err error) {
+	prompt := "ReviewGetStatsByTarget"
+
+	stats, err = s.reviewRepo.GetStatsByTarget(ctx, targetId)
+	if err != nil {
+		s.logger.Infof("%s: получение статистики отзывов: %v", prompt, err)
+		return nil, fmt.Errorf("получение статистики отзывов: %w", err)
+	}
+
+	return stats, nil
+}
+
+func (s *Service) Update(ctx context.Context, review *domain.Review, reviewerId uuid.UUID) (err error) {
+	prompt := "ReviewUpdate"
+
+	reviewDb, err := s.reviewRepo.GetById(ctx, review.ID)
+	if err != nil {
+		s.logger.Infof("%s: получение отзыва по id: %v", prompt, err)
+		return fmt.Errorf("получение отзыва по id: %w", err)
+	}
+
+	if reviewDb.TargetID != review.TargetID {
+		s.logger.Infof("%s: отзыв оставлен о другом предпринимателе", prompt)
+		return domain.ErrReviewNotFound
+	}
+
+	if reviewDb.ReviewerID != reviewerId {
+		s.logger.Infof("%s: только автор может изменять отзыв", prompt)
+		return fmt.Errorf("только автор может изменять отзыв")
+	}
+
+	if review.Rating != 0 && (review.Rating < minRating || review.Rating > maxRating) {
+		s.logger.Infof("%s: оценка должна находиться в отрезке от %d до %d", prompt, minRating, maxRating)
+		return fmt.Errorf("оценка должна находиться в отрезке от %d до %d", minRating, maxRating)
+	}
+
+	err = s.reviewRepo.Update(ctx, review)
+	if err != nil {
+		s.logger.Infof("%s: обновление отзыва: %v", prompt, err)
+		return fmt.Errorf("обновление отзыва: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Service) DeleteById(ctx context.Context, id uuid.UUID, targetId uuid.UUID, reviewerId uuid.UUID) (err error) {
+	prompt := "ReviewDeleteById"
+
+	review, err := s.reviewRepo.GetById(ctx, id)
+	if err != nil {
+		s.logger.Infof("%s: получение отзыва по id: %v", prompt, err)
+		return fmt.Errorf("получение отзыва по id: %w", err)
+	}
+
+	if review.TargetID != targetId {
+		s.logger.Infof("%s: отзыв оставлен о другом предпринимателе", prompt)
+		return domain.ErrReviewNotFound
+	}
+
+	if review.ReviewerID != reviewerId {
+		s.logger.Infof("%s: только автор может удалить отзыв", prompt)
+		return fmt.Errorf("только автор может удалить отзыв")
+	}
+
+	err = s.reviewRepo.DeleteById(ctx, id)
+	if err != nil {
+		s.logger.Infof("%s: удаление отзыва по id: %v", prompt, err)
+		return fmt.Errorf("удаление отзыва по id: %w", err)
+	}
+
+	return nil
+}
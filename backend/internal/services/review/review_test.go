@@ -0,0 +1,347 @@
+package review
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"io"
+	"ppo/domain"
+	"ppo/mocks"
+	"ppo/pkg/logger"
+	"testing"
+)
+
+func TestReviewService_Create(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reviewRepo := mocks.NewMockIReviewRepository(ctrl)
+	userRepo := mocks.NewMockIUserRepository(ctrl)
+	svc := NewService(reviewRepo, userRepo, logger.NewLogger(logger.InfoLevel, io.Discard))
+
+	testCases := []struct {
+		name       string
+		data       *domain.Review
+		beforeTest func(reviewRepo mocks.MockIReviewRepository, userRepo mocks.MockIUserRepository)
+		wantErr    bool
+		errStr     error
+	}{
+		{
+			name: "успешное добавление",
+			data: &domain.Review{
+				TargetID:   uuid.UUID{1},
+				ReviewerID: uuid.UUID{2},
+				Pros:       "a",
+				Cons:       "b",
+				Rating:     5,
+			},
+			beforeTest: func(reviewRepo mocks.MockIReviewRepository, userRepo mocks.MockIUserRepository) {
+				userRepo.EXPECT().
+					GetById(context.Background(), uuid.UUID{1}).
+					Return(&domain.User{ID: uuid.UUID{1}}, nil)
+
+				reviewRepo.EXPECT().
+					ExistsByReviewer(context.Background(), uuid.UUID{1}, uuid.UUID{2}).
+					Return(false, nil)
+
+				reviewRepo.EXPECT().
+					Create(
+						context.Background(),
+						&domain.Review{
+							TargetID:   uuid.UUID{1},
+							ReviewerID: uuid.UUID{2},
+							Pros:       "a",
+							Cons:       "b",
+							Rating:     5,
+						},
+					).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "отзыв самому себе",
+			data: &domain.Review{
+				TargetID:   uuid.UUID{1},
+				ReviewerID: uuid.UUID{1},
+				Pros:       "a",
+				Cons:       "b",
+				Rating:     5,
+			},
+			wantErr: true,
+			errStr:  errors.New("нельзя оставить отзыв самому себе"),
+		},
+		{
+			name: "оценка вне допустимого диапазона",
+			data: &domain.Review{
+				TargetID:   uuid.UUID{1},
+				ReviewerID: uuid.UUID{2},
+				Pros:       "a",
+				Cons:       "b",
+				Rating:     6,
+			},
+			wantErr: true,
+			errStr:  errors.New("оценка должна находиться в отрезке от 1 до 5"),
+		},
+		{
+			name: "повторный отзыв",
+			data: &domain.Review{
+				TargetID:   uuid.UUID{1},
+				ReviewerID: uuid.UUID{3},
+				Pros:       "a",
+				Cons:       "b",
+				Rating:     3,
+			},
+			beforeTest: func(reviewRepo mocks.MockIReviewRepository, userRepo mocks.MockIUserRepository) {
+				userRepo.EXPECT().
+					GetById(context.Background(), uuid.UUID{1}).
+					Return(&domain.User{ID: uuid.UUID{1}}, nil)
+
+				reviewRepo.EXPECT().
+					ExistsByReviewer(context.Background(), uuid.UUID{1}, uuid.UUID{3}).
+					Return(true, nil)
+			},
+			wantErr: true,
+			errStr:  errors.New("отзыв об этом предпринимателе уже оставлен"),
+		},
+		{
+			name: "ошибка выполнения запроса в репозитории",
+			data: &domain.Review{
+				TargetID:   uuid.UUID{1},
+				ReviewerID: uuid.UUID{4},
+				Pros:       "a",
+				Cons:       "b",
+				Rating:     1,
+			},
+			beforeTest: func(reviewRepo mocks.MockIReviewRepository, userRepo mocks.MockIUserRepository) {
+				userRepo.EXPECT().
+					GetById(context.Background(), uuid.UUID{1}).
+					Return(&domain.User{ID: uuid.UUID{1}}, nil)
+
+				reviewRepo.EXPECT().
+					ExistsByReviewer(context.Background(), uuid.UUID{1}, uuid.UUID{4}).
+					Return(false, nil)
+
+				reviewRepo.EXPECT().
+					Create(context.Background(), gomock.Any()).
+					Return(fmt.Errorf("sql error"))
+			},
+			wantErr: true,
+			errStr:  errors.New("создание отзыва: sql error"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.beforeTest != nil {
+				tc.beforeTest(*reviewRepo, *userRepo)
+			}
+
+			err := svc.Create(context.Background(), tc.data)
+
+			if tc.wantErr {
+				require.Equal(t, tc.errStr.Error(), err.Error())
+			} else {
+				require.Nil(t, err)
+			}
+		})
+	}
+}
+
+func TestReviewService_DeleteById(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reviewRepo := mocks.NewMockIReviewRepository(ctrl)
+	userRepo := mocks.NewMockIUserRepository(ctrl)
+	svc := NewService(reviewRepo, userRepo, logger.NewLogger(logger.InfoLevel, io.Discard))
+
+	testCases := []struct {
+		name       string
+		id         uuid.UUID
+		targetId   uuid.UUID
+		reviewerId uuid.UUID
+		beforeTest func(reviewRepo mocks.MockIReviewRepository)
+		wantErr    bool
+		errStr     error
+	}{
+		{
+			name:       "успешное удаление",
+			id:         uuid.UUID{1},
+			targetId:   uuid.UUID{4},
+			reviewerId: uuid.UUID{2},
+			beforeTest: func(reviewRepo mocks.MockIReviewRepository) {
+				reviewRepo.EXPECT().
+					GetById(context.Background(), uuid.UUID{1}).
+					Return(&domain.Review{ID: uuid.UUID{1}, TargetID: uuid.UUID{4}, ReviewerID: uuid.UUID{2}}, nil)
+
+				reviewRepo.EXPECT().
+					DeleteById(context.Background(), uuid.UUID{1}).
+					Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name:       "удаление чужого отзыва",
+			id:         uuid.UUID{1},
+			targetId:   uuid.UUID{4},
+			reviewerId: uuid.UUID{3},
+			beforeTest: func(reviewRepo mocks.MockIReviewRepository) {
+				reviewRepo.EXPECT().
+					GetById(context.Background(), uuid.UUID{1}).
+					Return(&domain.Review{ID: uuid.UUID{1}, TargetID: uuid.UUID{4}, ReviewerID: uuid.UUID{2}}, nil)
+			},
+			wantErr: true,
+			errStr:  errors.New("только автор может удалить отзыв"),
+		},
+		{
+			name:       "отзыв не найден",
+			id:         uuid.UUID{1},
+			targetId:   uuid.UUID{4},
+			reviewerId: uuid.UUID{2},
+			beforeTest: func(reviewRepo mocks.MockIReviewRepository) {
+				reviewRepo.EXPECT().
+					GetById(context.Background(), uuid.UUID{1}).
+					Return(nil, fmt.Errorf("получение отзыва по id: %w", domain.ErrReviewNotFound))
+			},
+			wantErr: true,
+			errStr:  fmt.Errorf("получение отзыва по id: получение отзыва по id: %w", domain.ErrReviewNotFound),
+		},
+		{
+			name:       "отзыв о другом предпринимателе",
+			id:         uuid.UUID{1},
+			targetId:   uuid.UUID{5},
+			reviewerId: uuid.UUID{2},
+			beforeTest: func(reviewRepo mocks.MockIReviewRepository) {
+				reviewRepo.EXPECT().
+					GetById(context.Background(), uuid.UUID{1}).
+					Return(&domain.Review{ID: uuid.UUID{1}, TargetID: uuid.UUID{4}, ReviewerID: uuid.UUID{2}}, nil)
+			},
+			wantErr: true,
+			errStr:  domain.ErrReviewNotFound,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.beforeTest != nil {
+				tc.beforeTest(*reviewRepo)
+			}
+
+			err := svc.DeleteById(context.Background(), tc.id, tc.targetId, tc.reviewerId)
+
+			if tc.wantErr {
+				require.Equal(t, tc.errStr.Error(), err.Error())
+				// по этой ошибке обработчик отвечает 404
+				if errors.Is(tc.errStr, domain.ErrReviewNotFound) {
+					require.ErrorIs(t, err, domain.ErrReviewNotFound)
+				}
+			} else {
+				require.Nil(t, err)
+			}
+		})
+	}
+}
+
+func TestReviewService_Update(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reviewRepo := mocks.NewMockIReviewRepository(ctrl)
+	userRepo := mocks.NewMockIUserRepository(ctrl)
+	svc := NewService(reviewRepo, userRepo, logger.NewLogger(logger.InfoLevel, io.Discard))
+
+	reviewDb := &domain.Review{ID: uuid.UUID{1}, TargetID: uuid.UUID{4}, ReviewerID: uuid.UUID{2}, Rating: 3}
+
+	testCases := []struct {
+		name       string
+		review     *domain.Review
+		reviewerId uuid.UUID
+		beforeTest func(reviewRepo mocks.MockIReviewRepository)
+		wantErr    bool
+		errStr     error
+	}{
+		{
+			name:       "успешное обновление",
+			review:     &domain.Review{ID: uuid.UUID{1}, TargetID: uuid.UUID{4}, Rating: 5},
+			reviewerId: uuid.UUID{2},
+			beforeTest: func(reviewRepo mocks.MockIReviewRepository) {
+				reviewRepo.EXPECT().
+					GetById(context.Background(), uuid.UUID{1}).
+					Return(reviewDb, nil)
+
+				reviewRepo.EXPECT().
+					Update(context.Background(), &domain.Review{ID: uuid.UUID{1}, TargetID: uuid.UUID{4}, Rating: 5}).
+					Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name:       "отзыв не найден",
+			review:     &domain.Review{ID: uuid.UUID{1}, TargetID: uuid.UUID{4}, Rating: 5},
+			reviewerId: uuid.UUID{2},
+			beforeTest: func(reviewRepo mocks.MockIReviewRepository) {
+				reviewRepo.EXPECT().
+					GetById(context.Background(), uuid.UUID{1}).
+					Return(nil, fmt.Errorf("получение отзыва по id: %w", domain.ErrReviewNotFound))
+			},
+			wantErr: true,
+			errStr:  fmt.Errorf("получение отзыва по id: получение отзыва по id: %w", domain.ErrReviewNotFound),
+		},
+		{
+			name:       "отзыв о другом предпринимателе",
+			review:     &domain.Review{ID: uuid.UUID{1}, TargetID: uuid.UUID{5}, Rating: 5},
+			reviewerId: uuid.UUID{2},
+			beforeTest: func(reviewRepo mocks.MockIReviewRepository) {
+				reviewRepo.EXPECT().
+					GetById(context.Background(), uuid.UUID{1}).
+					Return(reviewDb, nil)
+			},
+			wantErr: true,
+			errStr:  domain.ErrReviewNotFound,
+		},
+		{
+			name:       "изменение чужого отзыва",
+			review:     &domain.Review{ID: uuid.UUID{1}, TargetID: uuid.UUID{4}, Rating: 5},
+			reviewerId: uuid.UUID{3},
+			beforeTest: func(reviewRepo mocks.MockIReviewRepository) {
+				reviewRepo.EXPECT().
+					GetById(context.Background(), uuid.UUID{1}).
+					Return(reviewDb, nil)
+			},
+			wantErr: true,
+			errStr:  errors.New("только автор может изменять отзыв"),
+		},
+		{
+			name:       "оценка вне допустимого отрезка",
+			review:     &domain.Review{ID: uuid.UUID{1}, TargetID: uuid.UUID{4}, Rating: 6},
+			reviewerId: uuid.UUID{2},
+			beforeTest: func(reviewRepo mocks.MockIReviewRepository) {
+				reviewRepo.EXPECT().
+					GetById(context.Background(), uuid.UUID{1}).
+					Return(reviewDb, nil)
+			},
+			wantErr: true,
+			errStr:  errors.New("оценка должна находиться в отрезке от 1 до 5"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.beforeTest != nil {
+				tc.beforeTest(*reviewRepo)
+			}
+
+			err := svc.Update(context.Background(), tc.review, tc.reviewerId)
+
+			if tc.wantErr {
+				require.Equal(t, tc.errStr.Error(), err.Error())
+				// по этой ошибке обработчик отвечает 404
+				if errors.Is(tc.errStr, domain.ErrReviewNotFound) {
+					require.ErrorIs(t, err, domain.ErrReviewNotFound)
+				}
+			} else {
+				require.Nil(t, err)
+			}
+		})
+	}
+}
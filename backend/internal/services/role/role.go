@@ -0,0 +1,85 @@
+package role
+
+import (
+	"context"
+	"fmt"
+	"ppo/domain"
+	"ppo/pkg/logger"
+	"slices"
+
+	"github.com/google/uuid"
+)
+
+type Service struct {
+	roleRepo    domain.IRoleRepository
+	sessionRepo domain.ISessionRepository
+	logger      logger.ILogger
+}
+
+func NewService(
+	roleRepo domain.IRoleRepository,
+	sessionRepo domain.ISessionRepository,
+	logger logger.ILogger,
+) domain.IRoleService {
+	return &Service{
+		roleRepo:    roleRepo,
+		sessionRepo: sessionRepo,
+		logger:      logger,
+	}
+}
+
+func (s *Service) GetAll(ctx context.Context) (roles []*domain.Role, err error) {
+	prompt := "RoleGetAll"
+
+	roles, err = s.roleRepo.GetAll(ctx)
+	if err != nil {
+		s.logger.Infof("%s: получение списка ролей: %v", prompt, err)
+		return nil, fmt.Errorf("получение списка ролей: %w", err)
+	}
+
+	return roles, nil
+}
+
+func (s *Service) HasPermission(ctx context.Context, roleName string, permission string) (ok bool, err error) {
+	prompt := "RoleHasPermission"
+
+	role, err := s.roleRepo.GetByName(ctx, roleName)
+	if err != nil {
+		s.logger.Infof("%s: получение роли: %v", prompt, err)
+		return false, fmt.Errorf("получение роли: %w", err)
+	}
+
+	return slices.Contains(role.Permissions, permission), nil
+}
+
+// AssignRole назначает пользователю роль. Роль зашита в выданные ему токены, поэтому его сессии
+// отзываются: новая роль начнет действовать после повторного входа.
+func (s *Service) AssignRole(ctx context.Context, userId uuid.UUID, roleName string, assignedBy uuid.UUID) (err error) {
+	prompt := "RoleAssign"
+
+	if userId == assignedBy {
+		s.logger.Infof("%s: нельзя изменить собственную роль", prompt)
+		return fmt.Errorf("нельзя изменить собственную роль")
+	}
+
+	_, err = s.roleRepo.GetByName(ctx, roleName)
+	if err != nil {
+		s.logger.Infof("%s: получение роли: %v", prompt, err)
+		return fmt.Errorf("получение роли: %w", err)
+	}
+
+	err = s.roleRepo.SetUserRole(ctx, userId, roleName)
+	if err != nil {
+		s.logger.Infof("%s: %v", prompt, err)
+		return err
+	}
+
+	err = s.sessionRepo.RevokeByUserId(ctx, userId)
+	if err != nil {
+		s.logger.Infof("%s: отзыв сессий пользователя: %v", prompt, err)
+		return fmt.Errorf("отзыв сессий пользователя: %w", err)
+	}
+
+	s.logger.Infof("%s: пользователю %s назначена роль %s (назначил %s)", prompt, userId, roleName, assignedBy)
+	return nil
+}
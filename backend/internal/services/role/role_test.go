@@ -0,0 +1,166 @@
+package role
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"io"
+	"ppo/domain"
+	"ppo/mocks"
+	"ppo/pkg/logger"
+	"testing"
+)
+
+func TestRoleService_HasPermission(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	roleRepo := mocks.NewMockIRoleRepository(ctrl)
+	sessionRepo := mocks.NewMockISessionRepository(ctrl)
+	svc := NewService(roleRepo, sessionRepo, logger.NewLogger(logger.InfoLevel, io.Discard))
+
+	testCases := []struct {
+		name       string
+		role       string
+		permission string
+		beforeTest func(roleRepo mocks.MockIRoleRepository)
+		expected   bool
+		wantErr    bool
+		errStr     error
+	}{
+		{
+			name:       "разрешение выдано",
+			role:       domain.RoleAccountant,
+			permission: domain.PermVerifyReports,
+			beforeTest: func(roleRepo mocks.MockIRoleRepository) {
+				roleRepo.EXPECT().
+					GetByName(context.Background(), domain.RoleAccountant).
+					Return(&domain.Role{
+						Name:        domain.RoleAccountant,
+						Permissions: []string{domain.PermViewAllReports, domain.PermVerifyReports},
+					}, nil)
+			},
+			expected: true,
+		},
+		{
+			name:       "разрешение не выдано",
+			role:       domain.RoleAccountant,
+			permission: domain.PermManageRoles,
+			beforeTest: func(roleRepo mocks.MockIRoleRepository) {
+				roleRepo.EXPECT().
+					GetByName(context.Background(), domain.RoleAccountant).
+					Return(&domain.Role{
+						Name:        domain.RoleAccountant,
+						Permissions: []string{domain.PermViewAllReports, domain.PermVerifyReports},
+					}, nil)
+			},
+			expected: false,
+		},
+		{
+			name:       "неизвестная роль",
+			role:       "guest",
+			permission: domain.PermManageBusiness,
+			beforeTest: func(roleRepo mocks.MockIRoleRepository) {
+				roleRepo.EXPECT().
+					GetByName(context.Background(), "guest").
+					Return(nil, fmt.Errorf("роль 'guest' не найдена"))
+			},
+			wantErr: true,
+			errStr:  errors.New("получение роли: роль 'guest' не найдена"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.beforeTest != nil {
+				tc.beforeTest(*roleRepo)
+			}
+
+			ok, err := svc.HasPermission(context.Background(), tc.role, tc.permission)
+
+			if tc.wantErr {
+				require.Equal(t, tc.errStr.Error(), err.Error())
+			} else {
+				require.Nil(t, err)
+				require.Equal(t, tc.expected, ok)
+			}
+		})
+	}
+}
+
+func TestRoleService_AssignRole(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	roleRepo := mocks.NewMockIRoleRepository(ctrl)
+	sessionRepo := mocks.NewMockISessionRepository(ctrl)
+	svc := NewService(roleRepo, sessionRepo, logger.NewLogger(logger.InfoLevel, io.Discard))
+
+	testCases := []struct {
+		name       string
+		userId     uuid.UUID
+		role       string
+		assignedBy uuid.UUID
+		beforeTest func(roleRepo mocks.MockIRoleRepository, sessionRepo mocks.MockISessionRepository)
+		wantErr    bool
+		errStr     error
+	}{
+		{
+			name:       "успешное назначение",
+			userId:     uuid.UUID{1},
+			role:       domain.RoleAccountant,
+			assignedBy: uuid.UUID{2},
+			beforeTest: func(roleRepo mocks.MockIRoleRepository, sessionRepo mocks.MockISessionRepository) {
+				roleRepo.EXPECT().
+					GetByName(context.Background(), domain.RoleAccountant).
+					Return(&domain.Role{Name: domain.RoleAccountant}, nil)
+
+				roleRepo.EXPECT().
+					SetUserRole(context.Background(), uuid.UUID{1}, domain.RoleAccountant).
+					Return(nil)
+
+				sessionRepo.EXPECT().
+					RevokeByUserId(context.Background(), uuid.UUID{1}).
+					Return(nil)
+			},
+		},
+		{
+			name:       "изменение собственной роли",
+			userId:     uuid.UUID{1},
+			role:       domain.RoleUser,
+			assignedBy: uuid.UUID{1},
+			wantErr:    true,
+			errStr:     errors.New("нельзя изменить собственную роль"),
+		},
+		{
+			name:       "неизвестная роль",
+			userId:     uuid.UUID{1},
+			role:       "guest",
+			assignedBy: uuid.UUID{2},
+			beforeTest: func(roleRepo mocks.MockIRoleRepository, sessionRepo mocks.MockISessionRepository) {
+				roleRepo.EXPECT().
+					GetByName(context.Background(), "guest").
+					Return(nil, fmt.Errorf("роль 'guest' не найдена"))
+			},
+			wantErr: true,
+			errStr:  errors.New("получение роли: роль 'guest' не найдена"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.beforeTest != nil {
+				tc.beforeTest(*roleRepo, *sessionRepo)
+			}
+
+			err := svc.AssignRole(context.Background(), tc.userId, tc.role, tc.assignedBy)
+
+			if tc.wantErr {
+				require.Equal(t, tc.errStr.Error(), err.Error())
+			} else {
+				require.Nil(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,158 @@
+package skill
+
+import (
+	"context"
+	"fmt"
+	"ppo/domain"
+	"ppo/pkg/logger"
+
+	"github.com/google/uuid"
+)
+
+type Service struct {
+	skillRepo domain.ISkillRepository
+	userRepo  domain.IUserRepository
+	logger    logger.ILogger
+}
+
+func NewService(
+	skillRepo domain.ISkillRepository,
+	userRepo domain.IUserRepository,
+	logger logger.ILogger,
+) domain.ISkillService {
+	return &Service{
+		skillRepo: skillRepo,
+		userRepo:  userRepo,
+		logger:    logger,
+	}
+}
+
+func (s *Service) Create(ctx context.Context, skill *domain.Skill) (err error) {
+	prompt := "SkillCreate"
+
+	if skill.Name == "" {
+		s.logger.Infof("%s: должно быть указано название навыка", prompt)
+		return fmt.Errorf("должно быть указано название навыка")
+	}
+
+	if skill.Description == "" {
+		s.logger.Infof("%s: должно быть указано описание навыка", prompt)
+		return fmt.Errorf("должно быть указано описание навыка")
+	}
+
+	err = s.skillRepo.Create(ctx, skill)
+	if err != nil {
+		s.logger.Infof("%s: создание навыка: %v", prompt, err)
+		return fmt.Errorf("создание навыка: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Service) GetById(ctx context.Context, id uuid.UUID) (skill *domain.Skill, err error) {
+	prompt := "SkillGetById"
+
+	skill, err = s.skillRepo.GetById(ctx, id)
+	if err != nil {
+		s.logger.Infof("%s: получение навыка по id: %v", prompt, err)
+		return nil, fmt.Errorf("получение навыка по id: %w", err)
+	}
+
+	return skill, nil
+}
+
+func (s *Service) GetAll(ctx context.Context, page int, isPaginated bool) (skills []*domain.Skill, numPages int, err error) {
+	prompt := "SkillGetAll"
+
+	skills, numPages, err = s.skillRepo.GetAll(ctx, page, isPaginated)
+	if err != nil {
+		s.logger.Infof("%s: получение списка всех навыков: %v", prompt, err)
+		return nil, 0, fmt.Errorf("получение списка всех навыков: %w", err)
+	}
+
+	return skills, numPages, nil
+}
+
+func (s *Service) GetByUserId(ctx context.Context, userId uuid.UUID) (skills []*domain.Skill, err error) {
+	prompt := "SkillGetByUserId"
+
+	skills, err = s.skillRepo.GetByUserId(ctx, userId)
+	if err != nil {
+		s.logger.Infof("%s: получение навыков пользователя: %v", prompt, err)
+		return nil, fmt.Errorf("получение навыков пользователя: %w", err)
+	}
+
+	return skills, nil
+}
+
+func (s *Service) Update(ctx context.Context, skill *domain.Skill) (err error) {
+	prompt := "SkillUpdate"
+
+	_, err = s.skillRepo.GetById(ctx, skill.ID)
+	if err != nil {
+		s.logger.Infof("%s: получение навыка по id: %v", prompt, err)
+		return fmt.Errorf("получение навыка по id: %w", err)
+	}
+
+	err = s.skillRepo.Update(ctx, skill)
+	if err != nil {
+		s.logger.Infof("%s: обновление информации о навыке: %v", prompt, err)
+		return fmt.Errorf("обновление информации о навыке: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Service) DeleteById(ctx context.Context, id uuid.UUID) (err error) {
+	prompt := "SkillDeleteById"
+
+	_, err = s.skillRepo.GetById(ctx, id)
+	if err != nil {
+		s.logger.Infof("%s: получение навыка по id: %v", prompt, err)
+		return fmt.Errorf("получение навыка по id: %w", err)
+	}
+
+	err = s.skillRepo.DeleteById(ctx, id)
+	if err != nil {
+		s.logger.Infof("%s: удаление навыка по id: %v", prompt, err)
+		return fmt.Errorf("удаление навыка по id: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Service) AddToUser(ctx context.Context, userId, skillId uuid.UUID) (err error) {
+	prompt := "SkillAddToUser"
+
+	_, err = s.userRepo.GetById(ctx, userId)
+	if err != nil {
+		s.logger.Infof("%s: получение пользователя по id: %v", prompt, err)
+		return fmt.Errorf("получение пользователя по id: %w", err)
+	}
+
+	_, err = s.skillRepo.GetById(ctx, skillId)
+	if err != nil {
+		s.logger.Infof("%s: получение навыка по id: %v", prompt, err)
+		return fmt.Errorf("получение навыка по id: %w", err)
+	}
+
+	err = s.skillRepo.AddToUser(ctx, userId, skillId)
+	if err != nil {
+		s.logger.Infof("%s: добавление навыка пользователю: %v", prompt, err)
+		return fmt.Errorf("добавление навыка пользователю: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Service) DeleteFromUser(ctx context.Context, userId, skillId uuid.UUID) (err error) {
+	prompt := "SkillDeleteFromUser"
+
+	err = s.skillRepo.DeleteFromUser(ctx, userId, skillId)
+	if err != nil {
+		s.logger.Infof("%s: удаление навыка у пользователя: %v", prompt, err)
+		return fmt.Errorf("удаление навыка у пользователя: %w", err)
+	}
+
+	return nil
+}
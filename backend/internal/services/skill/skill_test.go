@@ -0,0 +1,184 @@
+package skill
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"io"
+	"ppo/domain"
+	"ppo/mocks"
+	"ppo/pkg/logger"
+	"testing"
+)
+
+func TestSkillService_Create(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	skillRepo := mocks.NewMockISkillRepository(ctrl)
+	userRepo := mocks.NewMockIUserRepository(ctrl)
+	svc := NewService(skillRepo, userRepo, logger.NewLogger(logger.InfoLevel, io.Discard))
+
+	testCases := []struct {
+		name       string
+		data       *domain.Skill
+		beforeTest func(skillRepo mocks.MockISkillRepository)
+		wantErr    bool
+		errStr     error
+	}{
+		{
+			name: "успешное добавление",
+			data: &domain.Skill{
+				Name:        "aaa",
+				Description: "bbb",
+			},
+			beforeTest: func(skillRepo mocks.MockISkillRepository) {
+				skillRepo.EXPECT().
+					Create(
+						context.Background(),
+						&domain.Skill{
+							Name:        "aaa",
+							Description: "bbb",
+						},
+					).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "пустое название навыка",
+			data: &domain.Skill{
+				Name:        "",
+				Description: "bbb",
+			},
+			wantErr: true,
+			errStr:  errors.New("должно быть указано название навыка"),
+		},
+		{
+			name: "пустое описание навыка",
+			data: &domain.Skill{
+				Name:        "aaa",
+				Description: "",
+			},
+			wantErr: true,
+			errStr:  errors.New("должно быть указано описание навыка"),
+		},
+		{
+			name: "ошибка выполнения запроса в репозитории",
+			data: &domain.Skill{
+				Name:        "aaa",
+				Description: "bbb",
+			},
+			beforeTest: func(skillRepo mocks.MockISkillRepository) {
+				skillRepo.EXPECT().
+					Create(
+						context.Background(),
+						&domain.Skill{
+							Name:        "aaa",
+							Description: "bbb",
+						},
+					).Return(fmt.Errorf("sql error"))
+			},
+			wantErr: true,
+			errStr:  errors.New("создание навыка: sql error"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.beforeTest != nil {
+				tc.beforeTest(*skillRepo)
+			}
+
+			err := svc.Create(context.Background(), tc.data)
+
+			if tc.wantErr {
+				require.Equal(t, tc.errStr.Error(), err.Error())
+			} else {
+				require.Nil(t, err)
+			}
+		})
+	}
+}
+
+func TestSkillService_AddToUser(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	skillRepo := mocks.NewMockISkillRepository(ctrl)
+	userRepo := mocks.NewMockIUserRepository(ctrl)
+	svc := NewService(skillRepo, userRepo, logger.NewLogger(logger.InfoLevel, io.Discard))
+
+	testCases := []struct {
+		name       string
+		userId     uuid.UUID
+		skillId    uuid.UUID
+		beforeTest func(skillRepo mocks.MockISkillRepository, userRepo mocks.MockIUserRepository)
+		wantErr    bool
+		errStr     error
+	}{
+		{
+			name:    "успешное добавление навыка",
+			userId:  uuid.UUID{1},
+			skillId: uuid.UUID{2},
+			beforeTest: func(skillRepo mocks.MockISkillRepository, userRepo mocks.MockIUserRepository) {
+				userRepo.EXPECT().
+					GetById(context.Background(), uuid.UUID{1}).
+					Return(&domain.User{ID: uuid.UUID{1}}, nil)
+
+				skillRepo.EXPECT().
+					GetById(context.Background(), uuid.UUID{2}).
+					Return(&domain.Skill{ID: uuid.UUID{2}}, nil)
+
+				skillRepo.EXPECT().
+					AddToUser(context.Background(), uuid.UUID{1}, uuid.UUID{2}).
+					Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name:    "навык не найден",
+			userId:  uuid.UUID{1},
+			skillId: uuid.UUID{3},
+			beforeTest: func(skillRepo mocks.MockISkillRepository, userRepo mocks.MockIUserRepository) {
+				userRepo.EXPECT().
+					GetById(context.Background(), uuid.UUID{1}).
+					Return(&domain.User{ID: uuid.UUID{1}}, nil)
+
+				skillRepo.EXPECT().
+					GetById(context.Background(), uuid.UUID{3}).
+					Return(nil, fmt.Errorf("sql error"))
+			},
+			wantErr: true,
+			errStr:  errors.New("получение навыка по id: sql error"),
+		},
+		{
+			name:    "пользователь не найден",
+			userId:  uuid.UUID{4},
+			skillId: uuid.UUID{2},
+			beforeTest: func(skillRepo mocks.MockISkillRepository, userRepo mocks.MockIUserRepository) {
+				userRepo.EXPECT().
+					GetById(context.Background(), uuid.UUID{4}).
+					Return(nil, fmt.Errorf("sql error"))
+			},
+			wantErr: true,
+			errStr:  errors.New("получение пользователя по id: sql error"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.beforeTest != nil {
+				tc.beforeTest(*skillRepo, *userRepo)
+			}
+
+			err := svc.AddToUser(context.Background(), tc.userId, tc.skillId)
+
+			if tc.wantErr {
+				require.Equal(t, tc.errStr.Error(), err.Error())
+			} else {
+				require.Nil(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,168 @@
+package tax_schedule
+
+import (
+	"context"
+	"fmt"
+	"ppo/domain"
+	"ppo/pkg/logger"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+var maxTaxRate = decimal.NewFromInt(100)
+
+type Service struct {
+	taxRepo domain.ITaxScheduleRepository
+	logger  logger.ILogger
+}
+
+func NewService(taxRepo domain.ITaxScheduleRepository, logger logger.ILogger) domain.ITaxScheduleService {
+	return &Service{
+		taxRepo: taxRepo,
+		logger:  logger,
+	}
+}
+
+func validateBrackets(brackets []domain.TaxBracket) (err error) {
+	if len(brackets) == 0 {
+		return fmt.Errorf("налоговая шкала должна содержать хотя бы одну ступень")
+	}
+
+	for i, bracket := range brackets {
+		if bracket.Rate.IsNegative() || bracket.Rate.GreaterThan(maxTaxRate) {
+			return fmt.Errorf("налоговая ставка должна находиться в отрезке от 0 до 100")
+		}
+
+		if bracket.UpperBound.IsNegative() {
+			return fmt.Errorf("верхняя граница ступени не может быть отрицательной")
+		}
+
+		last := i == len(brackets)-1
+		if last && !bracket.UpperBound.IsZero() {
+			return fmt.Errorf("последняя ступень налоговой шкалы не должна быть ограничена сверху")
+		}
+
+		if !last && bracket.UpperBound.IsZero() {
+			return fmt.Errorf("только последняя ступень налоговой шкалы может быть не ограничена сверху")
+		}
+
+		if i > 0 && !last && bracket.UpperBound.LessThanOrEqual(brackets[i-1].UpperBound) {
+			return fmt.Errorf("верхние границы ступеней должны возрастать")
+		}
+	}
+
+	return nil
+}
+
+func (s *Service) Create(ctx context.Context, schedule *domain.TaxSchedule) (err error) {
+	prompt := "TaxScheduleCreate"
+
+	if schedule.Year <= 0 {
+		s.logger.Infof("%s: должен быть указан год начала действия налоговой шкалы", prompt)
+		return fmt.Errorf("должен быть указан год начала действия налоговой шкалы")
+	}
+
+	if schedule.Regime == "" {
+		schedule.Regime = domain.DefaultTaxRegime
+	}
+
+	err = validateBrackets(schedule.Brackets)
+	if err != nil {
+		s.logger.Infof("%s: %v", prompt, err)
+		return err
+	}
+
+	err = s.taxRepo.Create(ctx, schedule)
+	if err != nil {
+		s.logger.Infof("%s: создание налоговой шкалы: %v", prompt, err)
+		return fmt.Errorf("создание налоговой шкалы: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Service) GetById(ctx context.Context, id uuid.UUID) (schedule *domain.TaxSchedule, err error) {
+	prompt := "TaxScheduleGetById"
+
+	schedule, err = s.taxRepo.GetById(ctx, id)
+	if err != nil {
+		s.logger.Infof("%s: получение налоговой шкалы по id: %v", prompt, err)
+		return nil, fmt.Errorf("получение налоговой шкалы по id: %w", err)
+	}
+
+	return schedule, nil
+}
+
+func (s *Service) GetByYear(ctx context.Context, year int, regime string) (schedule *domain.TaxSchedule, err error) {
+	prompt := "TaxScheduleGetByYear"
+
+	schedule, err = s.taxRepo.GetByYear(ctx, year, regime)
+	if err != nil {
+		s.logger.Infof("%s: получение налоговой шкалы по году: %v", prompt, err)
+		return nil, fmt.Errorf("получение налоговой шкалы по году: %w", err)
+	}
+
+	return schedule, nil
+}
+
+func (s *Service) GetAll(ctx context.Context) (schedules []*domain.TaxSchedule, err error) {
+	prompt := "TaxScheduleGetAll"
+
+	schedules, err = s.taxRepo.GetAll(ctx)
+	if err != nil {
+		s.logger.Infof("%s: получение списка налоговых шкал: %v", prompt, err)
+		return nil, fmt.Errorf("получение списка налоговых шкал: %w", err)
+	}
+
+	return schedules, nil
+}
+
+func (s *Service) Update(ctx context.Context, schedule *domain.TaxSchedule) (err error) {
+	prompt := "TaxScheduleUpdate"
+
+	_, err = s.taxRepo.GetById(ctx, schedule.ID)
+	if err != nil {
+		s.logger.Infof("%s: получение налоговой шкалы по id: %v", prompt, err)
+		return fmt.Errorf("получение налоговой шкалы по id: %w", err)
+	}
+
+	if schedule.Year < 0 {
+		s.logger.Infof("%s: год начала действия налоговой шкалы не может быть отрицательным", prompt)
+		return fmt.Errorf("год начала действия налоговой шкалы не может быть отрицательным")
+	}
+
+	if len(schedule.Brackets) != 0 {
+		err = validateBrackets(schedule.Brackets)
+		if err != nil {
+			s.logger.Infof("%s: %v", prompt, err)
+			return err
+		}
+	}
+
+	err = s.taxRepo.Update(ctx, schedule)
+	if err != nil {
+		s.logger.Infof("%s: обновление налоговой шкалы: %v", prompt, err)
+		return fmt.Errorf("обновление налоговой шкалы: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Service) DeleteById(ctx context.Context, id uuid.UUID) (err error) {
+	prompt := "TaxScheduleDeleteById"
+
+	_, err = s.taxRepo.GetById(ctx, id)
+	if err != nil {
+		s.logger.Infof("%s: получение налоговой шкалы по id: %v", prompt, err)
+		return fmt.Errorf("получение налоговой шкалы по id: %w", err)
+	}
+
+	err = s.taxRepo.DeleteById(ctx, id)
+	if err != nil {
+		s.logger.Infof("%s: удаление налоговой шкалы по id: %v", prompt, err)
+		return fmt.Errorf("удаление налоговой шкалы по id: %w", err)
+	}
+
+	return nil
+}
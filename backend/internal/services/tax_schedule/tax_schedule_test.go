@@ -0,0 +1,124 @@
+package tax_schedule
+
+import (
+	"context"
+	"errors"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"io"
+	"ppo/domain"
+	"ppo/mocks"
+	"ppo/pkg/logger"
+	"testing"
+)
+
+func TestTaxScheduleService_Create(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	taxRepo := mocks.NewMockITaxScheduleRepository(ctrl)
+	svc := NewService(taxRepo, logger.NewLogger(logger.InfoLevel, io.Discard))
+
+	testCases := []struct {
+		name       string
+		data       *domain.TaxSchedule
+		beforeTest func(taxRepo mocks.MockITaxScheduleRepository)
+		wantErr    bool
+		errStr     error
+	}{
+		{
+			name: "успешное добавление",
+			data: &domain.TaxSchedule{
+				Year: 2024,
+				Brackets: []domain.TaxBracket{
+					{UpperBound: decimal.NewFromInt(10000000), Rate: decimal.NewFromInt(4)},
+					{Rate: decimal.NewFromInt(7)},
+				},
+			},
+			beforeTest: func(taxRepo mocks.MockITaxScheduleRepository) {
+				taxRepo.EXPECT().
+					Create(
+						context.Background(),
+						&domain.TaxSchedule{
+							Year:   2024,
+							Regime: domain.DefaultTaxRegime,
+							Brackets: []domain.TaxBracket{
+								{UpperBound: decimal.NewFromInt(10000000), Rate: decimal.NewFromInt(4)},
+								{Rate: decimal.NewFromInt(7)},
+							},
+						},
+					).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "не указан год",
+			data: &domain.TaxSchedule{
+				Brackets: []domain.TaxBracket{
+					{Rate: decimal.NewFromInt(7)},
+				},
+			},
+			wantErr: true,
+			errStr:  errors.New("должен быть указан год начала действия налоговой шкалы"),
+		},
+		{
+			name: "пустая шкала",
+			data: &domain.TaxSchedule{
+				Year: 2024,
+			},
+			wantErr: true,
+			errStr:  errors.New("налоговая шкала должна содержать хотя бы одну ступень"),
+		},
+		{
+			name: "последняя ступень ограничена сверху",
+			data: &domain.TaxSchedule{
+				Year: 2024,
+				Brackets: []domain.TaxBracket{
+					{UpperBound: decimal.NewFromInt(10000000), Rate: decimal.NewFromInt(4)},
+				},
+			},
+			wantErr: true,
+			errStr:  errors.New("последняя ступень налоговой шкалы не должна быть ограничена сверху"),
+		},
+		{
+			name: "границы ступеней не возрастают",
+			data: &domain.TaxSchedule{
+				Year: 2024,
+				Brackets: []domain.TaxBracket{
+					{UpperBound: decimal.NewFromInt(50000000), Rate: decimal.NewFromInt(4)},
+					{UpperBound: decimal.NewFromInt(10000000), Rate: decimal.NewFromInt(7)},
+					{Rate: decimal.NewFromInt(13)},
+				},
+			},
+			wantErr: true,
+			errStr:  errors.New("верхние границы ступеней должны возрастать"),
+		},
+		{
+			name: "ставка больше 100%",
+			data: &domain.TaxSchedule{
+				Year: 2024,
+				Brackets: []domain.TaxBracket{
+					{Rate: decimal.NewFromInt(130)},
+				},
+			},
+			wantErr: true,
+			errStr:  errors.New("налоговая ставка должна находиться в отрезке от 0 до 100"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.beforeTest != nil {
+				tc.beforeTest(*taxRepo)
+			}
+
+			err := svc.Create(context.Background(), tc.data)
+
+			if tc.wantErr {
+				require.Equal(t, tc.errStr.Error(), err.Error())
+			} else {
+				require.Nil(t, err)
+			}
+		})
+	}
+}
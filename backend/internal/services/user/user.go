@@ -8,6 +8,7 @@ import (
 	"strings"
 
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 )
 
 type Service struct {
@@ -34,29 +35,10 @@ func NewService(
 func (s *Service) Create(ctx context.Context, user *domain.User) (err error) {
 	prompt := "UserCreate"
 
-	if user.Gender != "m" && user.Gender != "w" {
-		s.logger.Infof("%s: неизвестный пол", prompt)
-		return fmt.Errorf("неизвестный пол")
-	}
-
-	if user.City == "" {
-		s.logger.Infof("%s: должно быть указано название города", prompt)
-		return fmt.Errorf("должно быть указано название города")
-	}
-
-	if user.Birthday.IsZero() {
-		s.logger.Infof("%s: должна быть указана дата рождения", prompt)
-		return fmt.Errorf("должна быть указана дата рождения")
-	}
-
-	if user.FullName == "" {
-		s.logger.Infof("%s: должны быть указаны ФИО", prompt)
-		return fmt.Errorf("должны быть указаны ФИО")
-	}
-
-	if len(strings.Split(user.FullName, " ")) != 3 {
-		s.logger.Infof("%s: некорректное количество слов (должны быть фамилия, имя и отчество)", prompt)
-		return fmt.Errorf("некорректное количество слов (должны быть фамилия, имя и отчество)")
+	err = user.ValidateProfile()
+	if err != nil {
+		s.logger.Infof("%s: %v", prompt, err)
+		return err
 	}
 
 	err = s.userRepo.Create(ctx, user)
@@ -92,10 +74,47 @@ func (s *Service) GetById(ctx context.Context, userId uuid.UUID) (user *domain.U
 	return user, nil
 }
 
-func (s *Service) GetAll(ctx context.Context, page int) (users []*domain.User, numPages int, err error) {
+func validateUserFilter(filter *domain.UserFilter) (err error) {
+	if filter.Gender != "" && filter.Gender != "m" && filter.Gender != "w" {
+		return fmt.Errorf("неизвестный пол")
+	}
+
+	if filter.MinAge < 0 || filter.MaxAge < 0 {
+		return fmt.Errorf("возраст не может быть отрицательным")
+	}
+
+	if filter.MaxAge != 0 && filter.MinAge > filter.MaxAge {
+		return fmt.Errorf("минимальный возраст не может превышать максимальный")
+	}
+
+	switch filter.SortBy {
+	case "", domain.SortByName, domain.SortByRating:
+	case domain.SortByRevenue:
+		if filter.Period == nil {
+			return fmt.Errorf("для сортировки по выручке должен быть указан период")
+		}
+	default:
+		return fmt.Errorf("неизвестный порядок сортировки: %s", filter.SortBy)
+	}
+
+	return nil
+}
+
+func (s *Service) GetAll(ctx context.Context, filter *domain.UserFilter, page int) (users []*domain.User, numPages int, err error) {
 	prompt := "UserGetAll"
 
-	users, numPages, err = s.userRepo.GetAll(ctx, page)
+	if page < 1 {
+		s.logger.Infof("%s: номер страницы должен быть положительным", prompt)
+		return nil, 0, fmt.Errorf("номер страницы должен быть положительным")
+	}
+
+	err = validateUserFilter(filter)
+	if err != nil {
+		s.logger.Infof("%s: %v", prompt, err)
+		return nil, 0, err
+	}
+
+	users, numPages, err = s.userRepo.GetAll(ctx, filter, page)
 	if err != nil {
 		s.logger.Infof("%s: получение списка всех пользователей: %v", prompt, err)
 		return nil, 0, fmt.Errorf("получение списка всех пользователей: %w", err)
@@ -104,6 +123,79 @@ func (s *Service) GetAll(ctx context.Context, page int) (users []*domain.User, n
 	return users, numPages, nil
 }
 
+func (s *Service) GetRatingData(ctx context.Context, period *domain.Period, filter *domain.UserFilter) (data []*domain.UserRatingData, err error) {
+	prompt := "UserGetRatingData"
+
+	err = validateUserFilter(filter)
+	if err != nil {
+		s.logger.Infof("%s: %v", prompt, err)
+		return nil, err
+	}
+
+	data, err = s.userRepo.GetRatingData(ctx, period, filter)
+	if err != nil {
+		s.logger.Infof("%s: получение показателей предпринимателей для рейтинга: %v", prompt, err)
+		return nil, fmt.Errorf("получение показателей предпринимателей для рейтинга: %w", err)
+	}
+
+	return data, nil
+}
+
+func (s *Service) GetEntrepreneurIds(ctx context.Context) (ids []uuid.UUID, err error) {
+	prompt := "UserGetEntrepreneurIds"
+
+	ids, err = s.userRepo.GetEntrepreneurIds(ctx)
+	if err != nil {
+		s.logger.Infof("%s: получение списка предпринимателей: %v", prompt, err)
+		return nil, fmt.Errorf("получение списка предпринимателей: %w", err)
+	}
+
+	return ids, nil
+}
+
+func (s *Service) GetMaxRevenue(ctx context.Context, period *domain.Period) (revenue decimal.Decimal, err error) {
+	prompt := "UserGetMaxRevenue"
+
+	err = period.Validate()
+	if err != nil {
+		s.logger.Infof("%s: %v", prompt, err)
+		return decimal.Zero, err
+	}
+
+	revenue, err = s.userRepo.GetMaxRevenue(ctx, period)
+	if err != nil {
+		s.logger.Infof("%s: получение наибольшей выручки предпринимателя: %v", prompt, err)
+		return decimal.Zero, fmt.Errorf("получение наибольшей выручки предпринимателя: %w", err)
+	}
+
+	return revenue, nil
+}
+
+func (s *Service) GetInfluence(ctx context.Context, id uuid.UUID, period *domain.Period) (influence []*domain.ActivityFieldInfluence, err error) {
+	prompt := "UserGetInfluence"
+
+	if period.StartYear > period.EndYear ||
+		(period.StartYear == period.EndYear && period.StartQuarter > period.EndQuarter) {
+		s.logger.Infof("%s: дата конца периода должна быть позже даты начала", prompt)
+		return nil, fmt.Errorf("дата конца периода должна быть позже даты начала")
+	}
+
+	// у неизвестного пользователя нет компаний, но пустой список не должен выдавать его за существующего
+	_, err = s.userRepo.GetById(ctx, id)
+	if err != nil {
+		s.logger.Infof("%s: %v", prompt, err)
+		return nil, err
+	}
+
+	influence, err = s.userRepo.GetInfluence(ctx, id, period)
+	if err != nil {
+		s.logger.Infof("%s: %v", prompt, err)
+		return nil, err
+	}
+
+	return influence, nil
+}
+
 func (s *Service) Update(ctx context.Context, user *domain.User) (err error) {
 	prompt := "UserUpdate"
 
@@ -5,10 +5,13 @@ import (
 	"errors"
 	"fmt"
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
+	"io"
 	"ppo/domain"
 	"ppo/mocks"
+	"ppo/pkg/logger"
 	"testing"
 	"time"
 )
@@ -20,7 +23,7 @@ func TestUserService_DeleteById(t *testing.T) {
 	userRepo := mocks.NewMockIUserRepository(ctrl)
 	compRepo := mocks.NewMockICompanyRepository(ctrl)
 	actFieldRepo := mocks.NewMockIActivityFieldRepository(ctrl)
-	svc := NewService(userRepo, compRepo, actFieldRepo)
+	svc := NewService(userRepo, compRepo, actFieldRepo, logger.NewLogger(logger.InfoLevel, io.Discard))
 
 	curUuid := uuid.New()
 
@@ -35,6 +38,10 @@ func TestUserService_DeleteById(t *testing.T) {
 			name: "успешное удаление",
 			id:   curUuid,
 			beforeTest: func(userRepo mocks.MockIUserRepository) {
+				userRepo.EXPECT().
+					GetById(context.Background(), curUuid).
+					Return(&domain.User{ID: curUuid}, nil)
+
 				userRepo.EXPECT().
 					DeleteById(context.Background(), curUuid).
 					Return(nil)
@@ -45,6 +52,10 @@ func TestUserService_DeleteById(t *testing.T) {
 			name: "ошибка выполнения запроса в репозитории",
 			id:   curUuid,
 			beforeTest: func(userRepo mocks.MockIUserRepository) {
+				userRepo.EXPECT().
+					GetById(context.Background(), curUuid).
+					Return(&domain.User{ID: curUuid}, nil)
+
 				userRepo.EXPECT().
 					DeleteById(context.Background(), curUuid).
 					Return(fmt.Errorf("sql error"))
@@ -78,7 +89,7 @@ func TestUserService_GetAll(t *testing.T) {
 	userRepo := mocks.NewMockIUserRepository(ctrl)
 	compRepo := mocks.NewMockICompanyRepository(ctrl)
 	actFieldRepo := mocks.NewMockIActivityFieldRepository(ctrl)
-	svc := NewService(userRepo, compRepo, actFieldRepo)
+	svc := NewService(userRepo, compRepo, actFieldRepo, logger.NewLogger(logger.InfoLevel, io.Discard))
 
 	testCases := []struct {
 		name       string
@@ -91,7 +102,7 @@ func TestUserService_GetAll(t *testing.T) {
 			name: "успешное получение списка всех компаний",
 			beforeTest: func(userRepo mocks.MockIUserRepository) {
 				userRepo.EXPECT().
-					GetAll(context.Background(), 1).
+					GetAll(context.Background(), &domain.UserFilter{}, 1).
 					Return([]*domain.User{
 						{
 							ID:       uuid.UUID{1},
@@ -117,7 +128,7 @@ func TestUserService_GetAll(t *testing.T) {
 							Birthday: time.Date(3, 3, 3, 3, 3, 3, 3, time.Local),
 							City:     "c",
 						},
-					}, nil)
+					}, 1, nil)
 			},
 			expected: []*domain.User{
 				{
@@ -151,8 +162,8 @@ func TestUserService_GetAll(t *testing.T) {
 			name: "ошибка получения данных в репозитории",
 			beforeTest: func(userRepo mocks.MockIUserRepository) {
 				userRepo.EXPECT().
-					GetAll(context.Background(), 1).
-					Return(nil, fmt.Errorf("sql error"))
+					GetAll(context.Background(), &domain.UserFilter{}, 1).
+					Return(nil, 0, fmt.Errorf("sql error"))
 			},
 			wantErr: true,
 			errStr:  errors.New("получение списка всех пользователей: sql error"),
@@ -165,13 +176,14 @@ func TestUserService_GetAll(t *testing.T) {
 				tc.beforeTest(*userRepo)
 			}
 
-			users, err := svc.GetAll(ctx, 1)
+			users, numPages, err := svc.GetAll(ctx, &domain.UserFilter{}, 1)
 
 			if tc.wantErr {
 				require.Equal(t, tc.errStr.Error(), err.Error())
 			} else {
 				require.Nil(t, err)
 				require.Equal(t, users, tc.expected)
+				require.Equal(t, 1, numPages)
 			}
 		})
 	}
@@ -184,7 +196,7 @@ func TestUserService_Create(t *testing.T) {
 	userRepo := mocks.NewMockIUserRepository(ctrl)
 	compRepo := mocks.NewMockICompanyRepository(ctrl)
 	actFieldRepo := mocks.NewMockIActivityFieldRepository(ctrl)
-	svc := NewService(userRepo, compRepo, actFieldRepo)
+	svc := NewService(userRepo, compRepo, actFieldRepo, logger.NewLogger(logger.InfoLevel, io.Discard))
 
 	testCases := []struct {
 		name       string
@@ -385,7 +397,7 @@ func TestUserService_GetById(t *testing.T) {
 	userRepo := mocks.NewMockIUserRepository(ctrl)
 	compRepo := mocks.NewMockICompanyRepository(ctrl)
 	actFieldRepo := mocks.NewMockIActivityFieldRepository(ctrl)
-	svc := NewService(userRepo, compRepo, actFieldRepo)
+	svc := NewService(userRepo, compRepo, actFieldRepo, logger.NewLogger(logger.InfoLevel, io.Discard))
 
 	testCases := []struct {
 		name       string
@@ -464,7 +476,7 @@ func TestUserService_Update(t *testing.T) {
 	userRepo := mocks.NewMockIUserRepository(ctrl)
 	compRepo := mocks.NewMockICompanyRepository(ctrl)
 	actFieldRepo := mocks.NewMockIActivityFieldRepository(ctrl)
-	svc := NewService(userRepo, compRepo, actFieldRepo)
+	svc := NewService(userRepo, compRepo, actFieldRepo, logger.NewLogger(logger.InfoLevel, io.Discard))
 
 	testCases := []struct {
 		name       string
@@ -481,6 +493,10 @@ func TestUserService_Update(t *testing.T) {
 				Role: "admin",
 			},
 			beforeTest: func(userRepo mocks.MockIUserRepository) {
+				userRepo.EXPECT().
+					GetById(context.Background(), uuid.UUID{1}).
+					Return(&domain.User{ID: uuid.UUID{1}}, nil)
+
 				userRepo.EXPECT().
 					Update(
 						context.Background(),
@@ -501,6 +517,10 @@ func TestUserService_Update(t *testing.T) {
 				Role: "admin",
 			},
 			beforeTest: func(userRepo mocks.MockIUserRepository) {
+				userRepo.EXPECT().
+					GetById(context.Background(), uuid.UUID{1}).
+					Return(&domain.User{ID: uuid.UUID{1}}, nil)
+
 				userRepo.EXPECT().
 					Update(
 						context.Background(),
@@ -532,3 +552,127 @@ func TestUserService_Update(t *testing.T) {
 		})
 	}
 }
+
+func TestUserService_GetInfluence(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userRepo := mocks.NewMockIUserRepository(ctrl)
+	compRepo := mocks.NewMockICompanyRepository(ctrl)
+	actFieldRepo := mocks.NewMockIActivityFieldRepository(ctrl)
+	svc := NewService(userRepo, compRepo, actFieldRepo, logger.NewLogger(logger.InfoLevel, io.Discard))
+
+	period := &domain.Period{StartYear: 2023, StartQuarter: 1, EndYear: 2023, EndQuarter: 4}
+	influence := []*domain.ActivityFieldInfluence{
+		{
+			ActivityField: &domain.ActivityField{ID: uuid.UUID{2}, Name: "a"},
+			Revenue:       decimal.NewFromInt(250),
+			TotalRevenue:  decimal.NewFromInt(1000),
+			Rank:          2,
+			Participants:  3,
+		},
+	}
+
+	testCases := []struct {
+		name       string
+		id         uuid.UUID
+		period     *domain.Period
+		beforeTest func(userRepo mocks.MockIUserRepository)
+		expected   []*domain.ActivityFieldInfluence
+		wantErr    bool
+		errStr     error
+	}{
+		{
+			name:   "успешное получение влияния",
+			id:     uuid.UUID{1},
+			period: period,
+			beforeTest: func(userRepo mocks.MockIUserRepository) {
+				userRepo.EXPECT().
+					GetById(context.Background(), uuid.UUID{1}).
+					Return(&domain.User{ID: uuid.UUID{1}}, nil)
+
+				userRepo.EXPECT().
+					GetInfluence(context.Background(), uuid.UUID{1}, period).
+					Return(influence, nil)
+			},
+			expected: influence,
+		},
+		{
+			name:   "период из одного квартала",
+			id:     uuid.UUID{1},
+			period: &domain.Period{StartYear: 2023, StartQuarter: 2, EndYear: 2023, EndQuarter: 2},
+			beforeTest: func(userRepo mocks.MockIUserRepository) {
+				userRepo.EXPECT().
+					GetById(context.Background(), uuid.UUID{1}).
+					Return(&domain.User{ID: uuid.UUID{1}}, nil)
+
+				userRepo.EXPECT().
+					GetInfluence(
+						context.Background(),
+						uuid.UUID{1},
+						&domain.Period{StartYear: 2023, StartQuarter: 2, EndYear: 2023, EndQuarter: 2},
+					).
+					Return([]*domain.ActivityFieldInfluence{}, nil)
+			},
+			expected: []*domain.ActivityFieldInfluence{},
+		},
+		{
+			name:    "год конца периода раньше года начала",
+			id:      uuid.UUID{1},
+			period:  &domain.Period{StartYear: 2024, StartQuarter: 1, EndYear: 2023, EndQuarter: 4},
+			wantErr: true,
+			errStr:  errors.New("дата конца периода должна быть позже даты начала"),
+		},
+		{
+			name:    "квартал конца периода раньше квартала начала",
+			id:      uuid.UUID{1},
+			period:  &domain.Period{StartYear: 2023, StartQuarter: 3, EndYear: 2023, EndQuarter: 2},
+			wantErr: true,
+			errStr:  errors.New("дата конца периода должна быть позже даты начала"),
+		},
+		{
+			name:   "пользователь не найден",
+			id:     uuid.UUID{1},
+			period: period,
+			beforeTest: func(userRepo mocks.MockIUserRepository) {
+				userRepo.EXPECT().
+					GetById(context.Background(), uuid.UUID{1}).
+					Return(nil, fmt.Errorf("получение пользователя по id: %w", domain.ErrUserNotFound))
+			},
+			wantErr: true,
+			errStr:  errors.New("получение пользователя по id: пользователь не найден"),
+		},
+		{
+			name:   "ошибка выполнения запроса в репозитории",
+			id:     uuid.UUID{1},
+			period: period,
+			beforeTest: func(userRepo mocks.MockIUserRepository) {
+				userRepo.EXPECT().
+					GetById(context.Background(), uuid.UUID{1}).
+					Return(&domain.User{ID: uuid.UUID{1}}, nil)
+
+				userRepo.EXPECT().
+					GetInfluence(context.Background(), uuid.UUID{1}, period).
+					Return(nil, fmt.Errorf("получение влияния предпринимателя в сферах деятельности: sql error"))
+			},
+			wantErr: true,
+			errStr:  errors.New("получение влияния предпринимателя в сферах деятельности: sql error"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.beforeTest != nil {
+				tc.beforeTest(*userRepo)
+			}
+
+			influence, err := svc.GetInfluence(context.Background(), tc.id, tc.period)
+
+			if tc.wantErr {
+				require.Equal(t, tc.errStr.Error(), err.Error())
+			} else {
+				require.Nil(t, err)
+				require.Equal(t, tc.expected, influence)
+			}
+		})
+	}
+}
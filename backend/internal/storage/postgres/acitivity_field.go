@@ -3,7 +3,6 @@ package postgres
 import (
 	"context"
 	"fmt"
-	"math"
 	"ppo/domain"
 	"ppo/internal/config"
 	"strings"
@@ -11,6 +10,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/shopspring/decimal"
 )
 
 type ActivityFieldRepository struct {
@@ -24,12 +24,14 @@ func NewActivityFieldRepository(db *pgxpool.Pool) domain.IActivityFieldRepositor
 }
 
 func (r *ActivityFieldRepository) Create(ctx context.Context, data *domain.ActivityField) (err error) {
-	query := `insert into ppo.activity_fields(name, description, cost) 
-	values ($1, $2, $3)`
+	query := `insert into ppo.activity_fields(parent_id, code, name, description, cost) 
+	values ($1, nullif($2, ''), $3, $4, $5)`
 
 	_, err = r.db.Exec(
 		ctx,
 		query,
+		parentIdArg(data.ParentID),
+		data.Code,
 		data.Name,
 		data.Description,
 		data.Cost,
@@ -41,6 +43,40 @@ func (r *ActivityFieldRepository) Create(ctx context.Context, data *domain.Activ
 	return nil
 }
 
+func parentIdArg(parentId uuid.UUID) uuid.NullUUID {
+	return uuid.NullUUID{UUID: parentId, Valid: parentId != uuid.Nil}
+}
+
+// UpsertByCode добавляет сферу деятельности с кодом классификатора или обновляет уже добавленную.
+// Вес существующей сферы не меняется; в data записываются ее id и вес.
+func (r *ActivityFieldRepository) UpsertByCode(ctx context.Context, data *domain.ActivityField) (err error) {
+	query := `insert into ppo.activity_fields(parent_id, code, name, description, cost) 
+	values ($1, $2, $3, $4, $5) 
+	on conflict (code) do update set 
+		parent_id = excluded.parent_id, 
+		name = excluded.name, 
+		description = excluded.description
+	returning id, cost`
+
+	err = r.db.QueryRow(
+		ctx,
+		query,
+		parentIdArg(data.ParentID),
+		data.Code,
+		data.Name,
+		data.Description,
+		data.Cost,
+	).Scan(
+		&data.ID,
+		&data.Cost,
+	)
+	if err != nil {
+		return fmt.Errorf("сохранение сферы деятельности с кодом %s: %w", data.Code, err)
+	}
+
+	return nil
+}
+
 func (r *ActivityFieldRepository) DeleteById(ctx context.Context, id uuid.UUID) (err error) {
 	query := `delete from ppo.activity_fields where id = $1`
 
@@ -62,6 +98,16 @@ func (r *ActivityFieldRepository) Update(ctx context.Context, data *domain.Activ
 	query := "update ppo.activity_fields set "
 
 	i := 1
+	if data.ParentID != uuid.Nil {
+		queryElems = append(queryElems, fmt.Sprintf("parent_id = $%d", i))
+		queryArgs = append(queryArgs, data.ParentID)
+		i++
+	}
+	if data.Code != "" {
+		queryElems = append(queryElems, fmt.Sprintf("code = $%d", i))
+		queryArgs = append(queryArgs, data.Code)
+		i++
+	}
 	if data.Name != "" {
 		queryElems = append(queryElems, fmt.Sprintf("name = $%d", i))
 		queryArgs = append(queryArgs, data.Name)
@@ -72,7 +118,7 @@ func (r *ActivityFieldRepository) Update(ctx context.Context, data *domain.Activ
 		queryArgs = append(queryArgs, data.Description)
 		i++
 	}
-	if math.Abs(float64(data.Cost)) > 0 {
+	if !data.Cost.IsZero() {
 		queryElems = append(queryElems, fmt.Sprintf("cost = $%d", i))
 		queryArgs = append(queryArgs, data.Cost)
 		i++
@@ -94,14 +140,17 @@ func (r *ActivityFieldRepository) Update(ctx context.Context, data *domain.Activ
 }
 
 func (r *ActivityFieldRepository) GetById(ctx context.Context, id uuid.UUID) (field *domain.ActivityField, err error) {
-	query := `select name, description, cost from ppo.activity_fields where id = $1`
+	query := `select parent_id, coalesce(code, ''), name, description, cost from ppo.activity_fields where id = $1`
 
 	field = new(domain.ActivityField)
+	var parentId uuid.NullUUID
 	err = r.db.QueryRow(
 		ctx,
 		query,
 		id,
 	).Scan(
+		&parentId,
+		&field.Code,
 		&field.Name,
 		&field.Description,
 		&field.Cost,
@@ -111,11 +160,46 @@ func (r *ActivityFieldRepository) GetById(ctx context.Context, id uuid.UUID) (fi
 	}
 
 	field.ID = id
+	field.ParentID = parentId.UUID
 
 	return field, nil
 }
 
-func (r *ActivityFieldRepository) GetMaxCost(ctx context.Context) (cost float32, err error) {
+// GetSubtreeIds возвращает id сферы деятельности и всех ее потомков.
+func (r *ActivityFieldRepository) GetSubtreeIds(ctx context.Context, id uuid.UUID) (ids []uuid.UUID, err error) {
+	query := `with recursive subtree as (
+			select id from ppo.activity_fields where id = $1
+			union all
+			select af.id from ppo.activity_fields af join subtree s on af.parent_id = s.id
+		)
+		select id from subtree`
+
+	rows, err := r.db.Query(
+		ctx,
+		query,
+		id,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("получение поддерева сфер деятельности: %w", err)
+	}
+	defer rows.Close()
+
+	ids = make([]uuid.UUID, 0)
+	for rows.Next() {
+		var tmp uuid.UUID
+
+		err = rows.Scan(&tmp)
+		if err != nil {
+			return nil, fmt.Errorf("сканирование полученных строк: %w", err)
+		}
+
+		ids = append(ids, tmp)
+	}
+
+	return ids, nil
+}
+
+func (r *ActivityFieldRepository) GetMaxCost(ctx context.Context) (cost decimal.Decimal, err error) {
 	query := `select max(cost)
 		from ppo.activity_fields`
 
@@ -125,7 +209,7 @@ func (r *ActivityFieldRepository) GetMaxCost(ctx context.Context) (cost float32,
 	).Scan(&cost)
 
 	if err != nil {
-		return 0, fmt.Errorf("получение максимального веса сферы деятельности: %w", err)
+		return decimal.Zero, fmt.Errorf("получение максимального веса сферы деятельности: %w", err)
 	}
 
 	return cost, nil
@@ -135,6 +219,8 @@ func (r *ActivityFieldRepository) GetAll(ctx context.Context, page int, isPagina
 	query :=
 		`select 
     		id, 
+    		parent_id,
+    		coalesce(code, ''),
     		name,
     		description,
     		cost 
@@ -161,9 +247,12 @@ func (r *ActivityFieldRepository) GetAll(ctx context.Context, page int, isPagina
 	fields = make([]*domain.ActivityField, 0)
 	for rows.Next() {
 		tmp := new(domain.ActivityField)
+		var parentId uuid.NullUUID
 
 		err = rows.Scan(
 			&tmp.ID,
+			&parentId,
+			&tmp.Code,
 			&tmp.Name,
 			&tmp.Description,
 			&tmp.Cost,
@@ -172,6 +261,7 @@ func (r *ActivityFieldRepository) GetAll(ctx context.Context, page int, isPagina
 		if err != nil {
 			return nil, 0, fmt.Errorf("сканирование полученных строк: %w", err)
 		}
+		tmp.ParentID = parentId.UUID
 
 		fields = append(fields, tmp)
 	}
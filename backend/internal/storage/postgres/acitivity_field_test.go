@@ -2,6 +2,7 @@ package postgres
 
 import (
 	"context"
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/require"
 	"ppo/domain"
 	"testing"
@@ -21,7 +22,7 @@ func TestActivityFieldRepository_Create(t *testing.T) {
 			field: &domain.ActivityField{
 				Name:        "a",
 				Description: "a",
-				Cost:        1.0,
+				Cost:        decimal.RequireFromString("1.0"),
 			},
 		},
 	}
@@ -43,13 +44,13 @@ func TestActivityFieldRepository_GetMaxCost(t *testing.T) {
 
 	testCases := []struct {
 		name     string
-		expected float32
+		expected decimal.Decimal
 		wantErr  bool
 		errStr   error
 	}{
 		{
 			name:     "успех",
-			expected: 1.3,
+			expected: decimal.RequireFromString("1.3"),
 			wantErr:  false,
 		},
 	}
@@ -61,7 +62,7 @@ func TestActivityFieldRepository_GetMaxCost(t *testing.T) {
 				require.Equal(t, tc.errStr.Error(), err.Error())
 			} else {
 				require.Nil(t, err)
-				require.InEpsilon(t, tc.expected, res, 1e-4)
+				require.True(t, tc.expected.Equal(res))
 			}
 		})
 	}
@@ -0,0 +1,160 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"ppo/domain"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type ApiKeyRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewApiKeyRepository(db *pgxpool.Pool) domain.IApiKeyRepository {
+	return &ApiKeyRepository{
+		db: db,
+	}
+}
+
+func (r *ApiKeyRepository) Create(ctx context.Context, key *domain.ApiKey) (err error) {
+	query := `insert into ppo.api_keys(user_id, name, prefix, key_hash, scopes, expires_at) 
+	values ($1, $2, $3, $4, $5, $6) 
+	returning id, created_at`
+
+	var expiresAt sql.NullTime
+	if !key.ExpiresAt.IsZero() {
+		expiresAt = sql.NullTime{Time: key.ExpiresAt, Valid: true}
+	}
+
+	err = r.db.QueryRow(
+		ctx,
+		query,
+		key.UserID,
+		key.Name,
+		key.Prefix,
+		key.Hash,
+		key.Scopes,
+		expiresAt,
+	).Scan(
+		&key.ID,
+		&key.CreatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("создание API-ключа: %w", err)
+	}
+
+	return nil
+}
+
+// GetByHash возвращает только действующий ключ: неотозванный и неистёкший.
+func (r *ApiKeyRepository) GetByHash(ctx context.Context, hash string) (key *domain.ApiKey, err error) {
+	query := `
+		select id, user_id, name, prefix, scopes, expires_at, created_at, last_used_at 
+		from ppo.api_keys 
+		where key_hash = $1 and revoked_at is null and (expires_at is null or expires_at > now())`
+
+	key = &domain.ApiKey{Hash: hash}
+	var expiresAt, lastUsedAt sql.NullTime
+	err = r.db.QueryRow(
+		ctx,
+		query,
+		hash,
+	).Scan(
+		&key.ID,
+		&key.UserID,
+		&key.Name,
+		&key.Prefix,
+		&key.Scopes,
+		&expiresAt,
+		&key.CreatedAt,
+		&lastUsedAt,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("получение API-ключа: %w", err)
+	}
+	key.ExpiresAt = expiresAt.Time
+	key.LastUsedAt = lastUsedAt.Time
+
+	return key, nil
+}
+
+func (r *ApiKeyRepository) GetByUserId(ctx context.Context, userId uuid.UUID) (keys []*domain.ApiKey, err error) {
+	query := `
+		select id, name, prefix, scopes, expires_at, created_at, last_used_at 
+		from ppo.api_keys 
+		where user_id = $1 and revoked_at is null and (expires_at is null or expires_at > now())
+		order by created_at`
+
+	rows, err := r.db.Query(
+		ctx,
+		query,
+		userId,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("получение списка API-ключей: %w", err)
+	}
+	defer rows.Close()
+
+	keys = make([]*domain.ApiKey, 0)
+	for rows.Next() {
+		tmp := &domain.ApiKey{UserID: userId}
+		var expiresAt, lastUsedAt sql.NullTime
+
+		err = rows.Scan(
+			&tmp.ID,
+			&tmp.Name,
+			&tmp.Prefix,
+			&tmp.Scopes,
+			&expiresAt,
+			&tmp.CreatedAt,
+			&lastUsedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("сканирование полученных строк: %w", err)
+		}
+		tmp.ExpiresAt = expiresAt.Time
+		tmp.LastUsedAt = lastUsedAt.Time
+
+		keys = append(keys, tmp)
+	}
+
+	return keys, nil
+}
+
+// Revoke отзывает ключ, только если он принадлежит указанному пользователю.
+func (r *ApiKeyRepository) Revoke(ctx context.Context, id uuid.UUID, userId uuid.UUID) (err error) {
+	query := `update ppo.api_keys set revoked_at = now() where id = $1 and user_id = $2 and revoked_at is null`
+
+	tag, err := r.db.Exec(
+		ctx,
+		query,
+		id,
+		userId,
+	)
+	if err != nil {
+		return fmt.Errorf("отзыв API-ключа: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("API-ключ не найден")
+	}
+
+	return nil
+}
+
+func (r *ApiKeyRepository) Touch(ctx context.Context, id uuid.UUID) (err error) {
+	_, err = r.db.Exec(
+		ctx,
+		`update ppo.api_keys set last_used_at = now() where id = $1`,
+		id,
+	)
+	if err != nil {
+		return fmt.Errorf("обновление времени использования API-ключа: %w", err)
+	}
+
+	return nil
+}
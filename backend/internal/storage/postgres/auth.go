@@ -3,6 +3,7 @@ package postgres
 import (
 	"context"
 	"fmt"
+	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"ppo/domain"
 )
@@ -17,14 +18,19 @@ func NewAuthRepository(db *pgxpool.Pool) domain.IAuthRepository {
 	}
 }
 
-func (r *AuthRepository) Register(ctx context.Context, authInfo *domain.UserAuth) (err error) {
-	query := `insert into ppo.users (username, password, role) values ($1, $2, 'user')`
+func (r *AuthRepository) Register(ctx context.Context, authInfo *domain.UserAuth, profile *domain.User) (err error) {
+	query := `insert into ppo.users (username, password, role, full_name, birthday, gender, city)
+		values ($1, $2, 'user', $3, $4, $5, $6)`
 
 	_, err = r.db.Exec(
 		ctx,
 		query,
 		authInfo.Username,
 		authInfo.HashedPass,
+		profile.FullName,
+		profile.Birthday,
+		profile.Gender,
+		profile.City,
 	)
 	if err != nil {
 		return fmt.Errorf("регистрация пользователя: %w", err)
@@ -52,3 +58,40 @@ func (r *AuthRepository) GetByUsername(ctx context.Context, username string) (da
 
 	return UserAuthDbToUserAuth(tmp), nil
 }
+
+func (r *AuthRepository) GetById(ctx context.Context, id uuid.UUID) (data *domain.UserAuth, err error) {
+	query := `select username, password, role from ppo.users where id = $1`
+
+	tmp := new(UserAuth)
+	err = r.db.QueryRow(
+		ctx,
+		query,
+		id,
+	).Scan(
+		&tmp.Username,
+		&tmp.HashedPass,
+		&tmp.Role,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("получение пользователя по id: %w", err)
+	}
+	tmp.ID = id
+
+	return UserAuthDbToUserAuth(tmp), nil
+}
+
+func (r *AuthRepository) UpdatePassword(ctx context.Context, id uuid.UUID, hashedPass string) (err error) {
+	query := `update ppo.users set password = $1 where id = $2`
+
+	_, err = r.db.Exec(
+		ctx,
+		query,
+		hashedPass,
+		id,
+	)
+	if err != nil {
+		return fmt.Errorf("обновление пароля: %w", err)
+	}
+
+	return nil
+}
@@ -6,11 +6,19 @@ import (
 	"github.com/stretchr/testify/require"
 	"ppo/domain"
 	"testing"
+	"time"
 )
 
 func TestAuthRepository_Register(t *testing.T) {
 	repo := NewAuthRepository(testDbInstance)
 
+	profile := &domain.User{
+		FullName: "Иванов Иван Иванович",
+		Gender:   "m",
+		Birthday: time.Date(1990, 1, 1, 0, 0, 0, 0, time.UTC),
+		City:     "Москва",
+	}
+
 	testCases := []struct {
 		name     string
 		authInfo *domain.UserAuth
@@ -38,7 +46,7 @@ func TestAuthRepository_Register(t *testing.T) {
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			err := repo.Register(context.Background(), tc.authInfo)
+			err := repo.Register(context.Background(), tc.authInfo, profile)
 
 			if tc.wantErr {
 				require.Equal(t, tc.errStr.Error(), err.Error())
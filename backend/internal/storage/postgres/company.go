@@ -2,6 +2,7 @@ package postgres
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"ppo/domain"
 	"ppo/internal/config"
@@ -12,6 +13,17 @@ import (
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
+const (
+	// реквизиты компаний, добавленных до их появления, пусты
+	registryIdColumns = `coalesce(inn, ''), coalesce(ogrn, ''), coalesce(kpp, '')`
+
+	secondaryActivityFieldsColumn = `array(
+		select caf.activity_field_id 
+		from ppo.company_activity_fields caf 
+		where caf.company_id = companies.id 
+		order by caf.activity_field_id)`
+)
+
 type CompanyRepository struct {
 	db *pgxpool.Pool
 }
@@ -22,27 +34,87 @@ func NewCompanyRepository(db *pgxpool.Pool) domain.ICompanyRepository {
 	}
 }
 
+// Create добавляет компанию вместе с долей основного владельца: изначально он владеет ею целиком.
 func (r *CompanyRepository) Create(ctx context.Context, company *domain.Company) (err error) {
-	query := `insert into ppo.companies(owner_id, activity_field_id, name, city) 
-	values ($1, $2, $3, $4)`
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("открытие транзакции: %w", err)
+	}
 
-	_, err = r.db.Exec(
+	defer func() {
+		if err != nil {
+			rollbackErr := tx.Rollback(ctx)
+			if rollbackErr != nil {
+				err = fmt.Errorf("обработанная ошибка: %w\nоткат транзакции: %v", err, rollbackErr)
+			}
+		}
+	}()
+
+	query := `insert into ppo.companies(owner_id, activity_field_id, name, city, inn, ogrn, kpp) 
+	values ($1, $2, $3, $4, $5, $6, nullif($7, '')) 
+	on conflict do nothing
+	returning id`
+
+	err = tx.QueryRow(
 		ctx,
 		query,
 		company.OwnerID,
 		company.ActivityFieldId,
 		company.Name,
 		company.City,
-	)
+		company.INN,
+		company.OGRN,
+		company.KPP,
+	).Scan(&company.ID)
 	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return fmt.Errorf("компания с таким ИНН или ОГРН уже зарегистрирована")
+		}
 		return fmt.Errorf("создание компании: %w", err)
 	}
 
+	_, err = tx.Exec(
+		ctx,
+		`insert into ppo.company_owners(company_id, user_id, share, accepted_at) values ($1, $2, $3, now())`,
+		company.ID,
+		company.OwnerID,
+		domain.MaxOwnerShare,
+	)
+	if err != nil {
+		return fmt.Errorf("сохранение доли владельца компании: %w", err)
+	}
+
+	err = insertSecondaryActivityFields(ctx, tx, company)
+	if err != nil {
+		return err
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return fmt.Errorf("закрытие транзакции: %w", err)
+	}
+
+	return nil
+}
+
+func insertSecondaryActivityFields(ctx context.Context, tx pgx.Tx, company *domain.Company) (err error) {
+	for _, fieldId := range company.SecondaryActivityFieldIds {
+		_, err = tx.Exec(
+			ctx,
+			`insert into ppo.company_activity_fields(company_id, activity_field_id) values ($1, $2)`,
+			company.ID,
+			fieldId,
+		)
+		if err != nil {
+			return fmt.Errorf("сохранение дополнительной сферы деятельности компании: %w", err)
+		}
+	}
+
 	return nil
 }
 
 func (r *CompanyRepository) GetById(ctx context.Context, id uuid.UUID) (company *domain.Company, err error) {
-	query := `select owner_id, activity_field_id, name, city from ppo.companies where id = $1`
+	query := `select owner_id, activity_field_id, name, city, ` + registryIdColumns + `, ` + secondaryActivityFieldsColumn + ` from ppo.companies where id = $1`
 
 	company = new(domain.Company)
 	err = r.db.QueryRow(
@@ -54,6 +126,10 @@ func (r *CompanyRepository) GetById(ctx context.Context, id uuid.UUID) (company
 		&company.ActivityFieldId,
 		&company.Name,
 		&company.City,
+		&company.INN,
+		&company.OGRN,
+		&company.KPP,
+		&company.SecondaryActivityFieldIds,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("получение компании по id: %w", err)
@@ -63,13 +139,42 @@ func (r *CompanyRepository) GetById(ctx context.Context, id uuid.UUID) (company
 	return company, nil
 }
 
+func (r *CompanyRepository) GetByINN(ctx context.Context, inn string) (company *domain.Company, err error) {
+	query := `select id, owner_id, activity_field_id, name, city, ` + registryIdColumns + `, ` + secondaryActivityFieldsColumn + ` from ppo.companies where inn = $1`
+
+	company = new(domain.Company)
+	err = r.db.QueryRow(
+		ctx,
+		query,
+		inn,
+	).Scan(
+		&company.ID,
+		&company.OwnerID,
+		&company.ActivityFieldId,
+		&company.Name,
+		&company.City,
+		&company.INN,
+		&company.OGRN,
+		&company.KPP,
+		&company.SecondaryActivityFieldIds,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrCompanyNotFound
+		}
+		return nil, fmt.Errorf("получение компании по ИНН: %w", err)
+	}
+
+	return company, nil
+}
+
 func (r *CompanyRepository) GetByOwnerId(ctx context.Context, id uuid.UUID, page int, isPaginated bool) (companies []*domain.Company, numPages int, err error) {
 	query :=
 		`select 
     		id, 
     		activity_field_id,
     		name,
-    		city 
+    		city, ` + registryIdColumns + `, ` + secondaryActivityFieldsColumn + ` 
 		from ppo.companies 
 		where owner_id = $1`
 
@@ -102,6 +207,10 @@ func (r *CompanyRepository) GetByOwnerId(ctx context.Context, id uuid.UUID, page
 			&tmp.ActivityFieldId,
 			&tmp.Name,
 			&tmp.City,
+			&tmp.INN,
+			&tmp.OGRN,
+			&tmp.KPP,
+			&tmp.SecondaryActivityFieldIds,
 		)
 		tmp.OwnerID = id
 
@@ -130,7 +239,84 @@ func (r *CompanyRepository) GetByOwnerId(ctx context.Context, id uuid.UUID, page
 	return companies, numPages, nil
 }
 
+// GetByActivityFieldIds возвращает компании, у которых основная или одна из дополнительных сфер деятельности входит в ids.
+func (r *CompanyRepository) GetByActivityFieldIds(ctx context.Context, ids []uuid.UUID, page int) (companies []*domain.Company, numPages int, err error) {
+	condition := `
+		from ppo.companies 
+		where activity_field_id = any($1) or exists (
+			select 1 
+			from ppo.company_activity_fields caf 
+			where caf.company_id = companies.id and caf.activity_field_id = any($1))`
+
+	rows, err := r.db.Query(
+		ctx,
+		`select id, owner_id, activity_field_id, name, city, `+registryIdColumns+`, `+secondaryActivityFieldsColumn+
+			condition+` order by name, id offset $2 limit $3`,
+		ids,
+		(page-1)*config.PageSize,
+		config.PageSize,
+	)
+	if err != nil {
+		return nil, 0, fmt.Errorf("получение компаний по сферам деятельности: %w", err)
+	}
+	defer rows.Close()
+
+	companies = make([]*domain.Company, 0)
+	for rows.Next() {
+		tmp := new(domain.Company)
+
+		err = rows.Scan(
+			&tmp.ID,
+			&tmp.OwnerID,
+			&tmp.ActivityFieldId,
+			&tmp.Name,
+			&tmp.City,
+			&tmp.INN,
+			&tmp.OGRN,
+			&tmp.KPP,
+			&tmp.SecondaryActivityFieldIds,
+		)
+		if err != nil {
+			return nil, 0, fmt.Errorf("сканирование полученных строк: %w", err)
+		}
+
+		companies = append(companies, tmp)
+	}
+
+	var numRecords int
+	err = r.db.QueryRow(
+		ctx,
+		`select count(*)`+condition,
+		ids,
+	).Scan(&numRecords)
+	if err != nil {
+		return nil, 0, fmt.Errorf("получение числа компаний по сферам деятельности: %w", err)
+	}
+
+	numPages = numRecords / config.PageSize
+	if numRecords%config.PageSize != 0 {
+		numPages++
+	}
+
+	return companies, numPages, nil
+}
+
+// Update обновляет непустые поля компании; дополнительные сферы деятельности, если переданы, заменяются целиком.
 func (r *CompanyRepository) Update(ctx context.Context, company *domain.Company) (err error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("открытие транзакции: %w", err)
+	}
+
+	defer func() {
+		if err != nil {
+			rollbackErr := tx.Rollback(ctx)
+			if rollbackErr != nil {
+				err = fmt.Errorf("обработанная ошибка: %w\nоткат транзакции: %v", err, rollbackErr)
+			}
+		}
+	}()
+
 	queryArgs := make([]any, 0)
 	queryElems := make([]string, 0)
 	query := "update ppo.companies set "
@@ -156,17 +342,56 @@ func (r *CompanyRepository) Update(ctx context.Context, company *domain.Company)
 		queryArgs = append(queryArgs, company.City)
 		i++
 	}
-	query += strings.Join(queryElems, ", ")
-	query += fmt.Sprintf(" where id = $%d", i)
-	queryArgs = append(queryArgs, company.ID)
+	if company.INN != "" {
+		queryElems = append(queryElems, fmt.Sprintf("inn = $%d", i))
+		queryArgs = append(queryArgs, company.INN)
+		i++
+	}
+	if company.OGRN != "" {
+		queryElems = append(queryElems, fmt.Sprintf("ogrn = $%d", i))
+		queryArgs = append(queryArgs, company.OGRN)
+		i++
+	}
+	// КПП обновляется вместе с ИНН: при смене юридического лица на ИП он должен стать пустым
+	if company.INN != "" || company.KPP != "" {
+		queryElems = append(queryElems, fmt.Sprintf("kpp = nullif($%d, '')", i))
+		queryArgs = append(queryArgs, company.KPP)
+		i++
+	}
+	if len(queryElems) != 0 {
+		query += strings.Join(queryElems, ", ")
+		query += fmt.Sprintf(" where id = $%d", i)
+		queryArgs = append(queryArgs, company.ID)
 
-	_, err = r.db.Exec(
-		ctx,
-		query,
-		queryArgs...,
-	)
+		_, err = tx.Exec(
+			ctx,
+			query,
+			queryArgs...,
+		)
+		if err != nil {
+			return fmt.Errorf("обновление информации о компании: %w", err)
+		}
+	}
+
+	if company.SecondaryActivityFieldIds != nil {
+		_, err = tx.Exec(
+			ctx,
+			`delete from ppo.company_activity_fields where company_id = $1`,
+			company.ID,
+		)
+		if err != nil {
+			return fmt.Errorf("удаление дополнительных сфер деятельности компании: %w", err)
+		}
+
+		err = insertSecondaryActivityFields(ctx, tx, company)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = tx.Commit(ctx)
 	if err != nil {
-		return fmt.Errorf("обновление информации о компании: %w", err)
+		return fmt.Errorf("закрытие транзакции: %w", err)
 	}
 
 	return nil
@@ -214,7 +439,7 @@ func (r *CompanyRepository) DeleteById(ctx context.Context, id uuid.UUID) (err e
 }
 
 func (r *CompanyRepository) GetAll(ctx context.Context, page int) (companies []*domain.Company, err error) {
-	query := `select id, owner_id, activity_field_id, name, city from ppo.companies offset $1 limit $2`
+	query := `select id, owner_id, activity_field_id, name, city, ` + registryIdColumns + `, ` + secondaryActivityFieldsColumn + ` from ppo.companies offset $1 limit $2`
 
 	rows, err := r.db.Query(
 		ctx,
@@ -236,6 +461,10 @@ func (r *CompanyRepository) GetAll(ctx context.Context, page int) (companies []*
 			&tmp.ActivityFieldId,
 			&tmp.Name,
 			&tmp.City,
+			&tmp.INN,
+			&tmp.OGRN,
+			&tmp.KPP,
+			&tmp.SecondaryActivityFieldIds,
 		)
 
 		if err != nil {
@@ -0,0 +1,219 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"ppo/domain"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/shopspring/decimal"
+)
+
+type CompanyOwnerRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewCompanyOwnerRepository(db *pgxpool.Pool) domain.ICompanyOwnerRepository {
+	return &CompanyOwnerRepository{
+		db: db,
+	}
+}
+
+func scanCompanyOwners(rows pgx.Rows) (owners []*domain.CompanyOwner, err error) {
+	defer rows.Close()
+
+	owners = make([]*domain.CompanyOwner, 0)
+	for rows.Next() {
+		tmp := new(domain.CompanyOwner)
+		var invitedBy uuid.NullUUID
+		var acceptedAt sql.NullTime
+
+		err = rows.Scan(
+			&tmp.CompanyID,
+			&tmp.UserID,
+			&tmp.Share,
+			&invitedBy,
+			&acceptedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("сканирование полученных строк: %w", err)
+		}
+		tmp.InvitedBy = invitedBy.UUID
+		tmp.AcceptedAt = acceptedAt.Time
+
+		owners = append(owners, tmp)
+	}
+
+	return owners, nil
+}
+
+func (r *CompanyOwnerRepository) GetByCompanyId(ctx context.Context, companyId uuid.UUID) (owners []*domain.CompanyOwner, err error) {
+	query := `
+		select company_id, user_id, share, invited_by, accepted_at 
+		from ppo.company_owners 
+		where company_id = $1
+		order by share desc, user_id`
+
+	rows, err := r.db.Query(
+		ctx,
+		query,
+		companyId,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("получение владельцев компании: %w", err)
+	}
+
+	return scanCompanyOwners(rows)
+}
+
+func (r *CompanyOwnerRepository) GetAcceptedByUserId(ctx context.Context, userId uuid.UUID) (owners []*domain.CompanyOwner, err error) {
+	query := `
+		select company_id, user_id, share, invited_by, accepted_at 
+		from ppo.company_owners 
+		where user_id = $1 and accepted_at is not null
+		order by company_id`
+
+	rows, err := r.db.Query(
+		ctx,
+		query,
+		userId,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("получение долей пользователя в компаниях: %w", err)
+	}
+
+	return scanCompanyOwners(rows)
+}
+
+// otherOwnersShare блокирует компанию до конца транзакции, чтобы параллельные изменения ее долей
+// выполнялись по очереди, и возвращает сумму долей всех владельцев, кроме userId.
+func otherOwnersShare(ctx context.Context, tx pgx.Tx, companyId, userId uuid.UUID) (sum decimal.Decimal, err error) {
+	var id uuid.UUID
+	err = tx.QueryRow(
+		ctx,
+		`select id from ppo.companies where id = $1 for update`,
+		companyId,
+	).Scan(&id)
+	if err != nil {
+		return decimal.Decimal{}, fmt.Errorf("получение компании по id: %w", err)
+	}
+
+	err = tx.QueryRow(
+		ctx,
+		`select coalesce(sum(share), 0) from ppo.company_owners where company_id = $1 and user_id <> $2`,
+		companyId,
+		userId,
+	).Scan(&sum)
+	if err != nil {
+		return decimal.Decimal{}, fmt.Errorf("подсчет долей владельцев компании: %w", err)
+	}
+
+	return sum, nil
+}
+
+// changeShares выполняет изменение долей компании, если после него их сумма не превысит 100%.
+func (r *CompanyOwnerRepository) changeShares(ctx context.Context, owner *domain.CompanyOwner, change func(tx pgx.Tx) error) (err error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("открытие транзакции: %w", err)
+	}
+
+	defer func() {
+		if err != nil {
+			rollbackErr := tx.Rollback(ctx)
+			if rollbackErr != nil {
+				err = fmt.Errorf("обработанная ошибка: %w\nоткат транзакции: %v", err, rollbackErr)
+			}
+		}
+	}()
+
+	others, err := otherOwnersShare(ctx, tx, owner.CompanyID, owner.UserID)
+	if err != nil {
+		return err
+	}
+
+	if others.Add(owner.Share).GreaterThan(domain.MaxOwnerShare) {
+		return domain.ErrSharesExceed
+	}
+
+	err = change(tx)
+	if err != nil {
+		return err
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return fmt.Errorf("закрытие транзакции: %w", err)
+	}
+
+	return nil
+}
+
+func (r *CompanyOwnerRepository) Invite(ctx context.Context, owner *domain.CompanyOwner) (err error) {
+	return r.changeShares(ctx, owner, func(tx pgx.Tx) error {
+		tag, err := tx.Exec(
+			ctx,
+			`insert into ppo.company_owners(company_id, user_id, share, invited_by) 
+			values ($1, $2, $3, $4) 
+			on conflict do nothing`,
+			owner.CompanyID,
+			owner.UserID,
+			owner.Share,
+			owner.InvitedBy,
+		)
+		if err != nil {
+			return fmt.Errorf("приглашение совладельца: %w", err)
+		}
+
+		if tag.RowsAffected() == 0 {
+			return fmt.Errorf("пользователь уже является совладельцем компании или приглашен")
+		}
+
+		return nil
+	})
+}
+
+func (r *CompanyOwnerRepository) Accept(ctx context.Context, companyId uuid.UUID, userId uuid.UUID) (err error) {
+	tag, err := r.db.Exec(
+		ctx,
+		`update ppo.company_owners set accepted_at = now() 
+		where company_id = $1 and user_id = $2 and accepted_at is null`,
+		companyId,
+		userId,
+	)
+	if err != nil {
+		return fmt.Errorf("принятие приглашения в совладельцы: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("приглашение в совладельцы не найдено")
+	}
+
+	return nil
+}
+
+func (r *CompanyOwnerRepository) SetShare(ctx context.Context, companyId uuid.UUID, userId uuid.UUID, share decimal.Decimal) (err error) {
+	owner := &domain.CompanyOwner{CompanyID: companyId, UserID: userId, Share: share}
+
+	return r.changeShares(ctx, owner, func(tx pgx.Tx) error {
+		tag, err := tx.Exec(
+			ctx,
+			`update ppo.company_owners set share = $3 where company_id = $1 and user_id = $2`,
+			companyId,
+			userId,
+			share,
+		)
+		if err != nil {
+			return fmt.Errorf("изменение доли совладельца: %w", err)
+		}
+
+		if tag.RowsAffected() == 0 {
+			return fmt.Errorf("пользователь не является совладельцем компании")
+		}
+
+		return nil
+	})
+}
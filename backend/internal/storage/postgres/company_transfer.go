@@ -0,0 +1,285 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"ppo/domain"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type CompanyTransferRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewCompanyTransferRepository(db *pgxpool.Pool) domain.ICompanyTransferRepository {
+	return &CompanyTransferRepository{
+		db: db,
+	}
+}
+
+// непринятая в срок передача хранится со статусом pending, истечение вычисляется при чтении
+const transferColumns = `
+	id, 
+	company_id, 
+	from_user_id, 
+	to_user_id, 
+	case when status = 'pending' and expires_at <= now() then 'expired' else status end, 
+	created_at, 
+	expires_at, 
+	resolved_at`
+
+func scanCompanyTransfer(row pgx.Row) (transfer *domain.CompanyTransfer, err error) {
+	transfer = new(domain.CompanyTransfer)
+	var resolvedAt sql.NullTime
+
+	err = row.Scan(
+		&transfer.ID,
+		&transfer.CompanyID,
+		&transfer.FromUserID,
+		&transfer.ToUserID,
+		&transfer.Status,
+		&transfer.CreatedAt,
+		&transfer.ExpiresAt,
+		&resolvedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	transfer.ResolvedAt = resolvedAt.Time
+
+	return transfer, nil
+}
+
+func (r *CompanyTransferRepository) queryTransfers(ctx context.Context, query string, args ...any) (transfers []*domain.CompanyTransfer, err error) {
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("получение передач компаний: %w", err)
+	}
+	defer rows.Close()
+
+	transfers = make([]*domain.CompanyTransfer, 0)
+	for rows.Next() {
+		tmp, err := scanCompanyTransfer(rows)
+		if err != nil {
+			return nil, fmt.Errorf("сканирование полученных строк: %w", err)
+		}
+
+		transfers = append(transfers, tmp)
+	}
+
+	return transfers, nil
+}
+
+func (r *CompanyTransferRepository) Create(ctx context.Context, transfer *domain.CompanyTransfer) (err error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("открытие транзакции: %w", err)
+	}
+
+	defer func() {
+		if err != nil {
+			rollbackErr := tx.Rollback(ctx)
+			if rollbackErr != nil {
+				err = fmt.Errorf("обработанная ошибка: %w\nоткат транзакции: %v", err, rollbackErr)
+			}
+		}
+	}()
+
+	// истекшая передача не должна мешать начать новую
+	_, err = tx.Exec(
+		ctx,
+		`update ppo.company_transfers set status = 'expired', resolved_at = expires_at 
+		where company_id = $1 and status = 'pending' and expires_at <= now()`,
+		transfer.CompanyID,
+	)
+	if err != nil {
+		return fmt.Errorf("завершение истекших передач компании: %w", err)
+	}
+
+	err = tx.QueryRow(
+		ctx,
+		`insert into ppo.company_transfers(company_id, from_user_id, to_user_id, expires_at) 
+		values ($1, $2, $3, $4) 
+		on conflict do nothing 
+		returning id, status, created_at`,
+		transfer.CompanyID,
+		transfer.FromUserID,
+		transfer.ToUserID,
+		transfer.ExpiresAt,
+	).Scan(
+		&transfer.ID,
+		&transfer.Status,
+		&transfer.CreatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return fmt.Errorf("у компании уже есть незавершенная передача")
+		}
+		return fmt.Errorf("создание передачи компании: %w", err)
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return fmt.Errorf("закрытие транзакции: %w", err)
+	}
+
+	return nil
+}
+
+func (r *CompanyTransferRepository) GetById(ctx context.Context, id uuid.UUID) (transfer *domain.CompanyTransfer, err error) {
+	transfer, err = scanCompanyTransfer(r.db.QueryRow(
+		ctx,
+		`select `+transferColumns+` from ppo.company_transfers where id = $1`,
+		id,
+	))
+	if err != nil {
+		return nil, fmt.Errorf("получение передачи компании по id: %w", err)
+	}
+
+	return transfer, nil
+}
+
+func (r *CompanyTransferRepository) GetByCompanyId(ctx context.Context, companyId uuid.UUID) (transfers []*domain.CompanyTransfer, err error) {
+	return r.queryTransfers(
+		ctx,
+		`select `+transferColumns+` from ppo.company_transfers where company_id = $1 order by created_at`,
+		companyId,
+	)
+}
+
+func (r *CompanyTransferRepository) GetPendingByUserId(ctx context.Context, userId uuid.UUID) (transfers []*domain.CompanyTransfer, err error) {
+	return r.queryTransfers(
+		ctx,
+		`select `+transferColumns+` from ppo.company_transfers 
+		where to_user_id = $1 and status = 'pending' and expires_at > now() 
+		order by created_at`,
+		userId,
+	)
+}
+
+// Accept в одной транзакции переписывает компанию на получателя и передает ему долю прежнего
+// владельца. Отчеты привязаны к компании, поэтому остаются при ней.
+func (r *CompanyTransferRepository) Accept(ctx context.Context, id uuid.UUID, userId uuid.UUID) (err error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("открытие транзакции: %w", err)
+	}
+
+	defer func() {
+		if err != nil {
+			rollbackErr := tx.Rollback(ctx)
+			if rollbackErr != nil {
+				err = fmt.Errorf("обработанная ошибка: %w\nоткат транзакции: %v", err, rollbackErr)
+			}
+		}
+	}()
+
+	var transfer domain.CompanyTransfer
+	err = tx.QueryRow(
+		ctx,
+		`select company_id, from_user_id, to_user_id, status, expires_at 
+		from ppo.company_transfers where id = $1 for update`,
+		id,
+	).Scan(
+		&transfer.CompanyID,
+		&transfer.FromUserID,
+		&transfer.ToUserID,
+		&transfer.Status,
+		&transfer.ExpiresAt,
+	)
+	if err != nil {
+		return fmt.Errorf("получение передачи компании по id: %w", err)
+	}
+
+	if transfer.ToUserID != userId {
+		return fmt.Errorf("передача адресована другому пользователю")
+	}
+
+	if transfer.Status != domain.TransferPending || !transfer.ExpiresAt.After(time.Now()) {
+		return fmt.Errorf("передача уже завершена или истекла")
+	}
+
+	tag, err := tx.Exec(
+		ctx,
+		`update ppo.companies set owner_id = $3 where id = $1 and owner_id = $2`,
+		transfer.CompanyID,
+		transfer.FromUserID,
+		transfer.ToUserID,
+	)
+	if err != nil {
+		return fmt.Errorf("смена владельца компании: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("отправитель больше не является владельцем компании")
+	}
+
+	// если получатель уже совладелец, доли складываются; сумма долей компании не меняется
+	_, err = tx.Exec(
+		ctx,
+		`insert into ppo.company_owners(company_id, user_id, share, invited_by, accepted_at)
+		select company_id, $3, share, user_id, now() 
+		from ppo.company_owners 
+		where company_id = $1 and user_id = $2
+		on conflict (company_id, user_id) do update 
+		set share = ppo.company_owners.share + excluded.share, 
+		    accepted_at = coalesce(ppo.company_owners.accepted_at, excluded.accepted_at)`,
+		transfer.CompanyID,
+		transfer.FromUserID,
+		transfer.ToUserID,
+	)
+	if err != nil {
+		return fmt.Errorf("передача доли владельца: %w", err)
+	}
+
+	_, err = tx.Exec(
+		ctx,
+		`delete from ppo.company_owners where company_id = $1 and user_id = $2`,
+		transfer.CompanyID,
+		transfer.FromUserID,
+	)
+	if err != nil {
+		return fmt.Errorf("удаление доли прежнего владельца: %w", err)
+	}
+
+	_, err = tx.Exec(
+		ctx,
+		`update ppo.company_transfers set status = 'accepted', resolved_at = now() where id = $1`,
+		id,
+	)
+	if err != nil {
+		return fmt.Errorf("завершение передачи компании: %w", err)
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return fmt.Errorf("закрытие транзакции: %w", err)
+	}
+
+	return nil
+}
+
+func (r *CompanyTransferRepository) Decline(ctx context.Context, id uuid.UUID, userId uuid.UUID) (err error) {
+	tag, err := r.db.Exec(
+		ctx,
+		`update ppo.company_transfers set status = 'declined', resolved_at = now() 
+		where id = $1 and to_user_id = $2 and status = 'pending' and expires_at > now()`,
+		id,
+		userId,
+	)
+	if err != nil {
+		return fmt.Errorf("отклонение передачи компании: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("активная передача компании не найдена")
+	}
+
+	return nil
+}
@@ -2,13 +2,14 @@ package postgres
 
 import (
 	"context"
+	"database/sql"
 	"errors"
 	"fmt"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
-	"math"
 	"ppo/domain"
+	"ppo/internal/config"
 	"strings"
 )
 
@@ -43,9 +44,11 @@ func (r *FinReportRepository) Create(ctx context.Context, finReport *domain.Fina
 }
 
 func (r *FinReportRepository) GetById(ctx context.Context, id uuid.UUID) (report *domain.FinancialReport, err error) {
-	query := `select company_id, revenue, costs, year, quarter from ppo.fin_reports where id = $1`
+	query := `select company_id, revenue, costs, year, quarter, verified_by, verified_at from ppo.fin_reports where id = $1`
 
 	report = new(domain.FinancialReport)
+	var verifiedBy uuid.NullUUID
+	var verifiedAt sql.NullTime
 	err = r.db.QueryRow(
 		ctx,
 		query,
@@ -56,17 +59,21 @@ func (r *FinReportRepository) GetById(ctx context.Context, id uuid.UUID) (report
 		&report.Costs,
 		&report.Year,
 		&report.Quarter,
+		&verifiedBy,
+		&verifiedAt,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("получение отчета по id: %w", err)
 	}
 
 	report.ID = id
+	report.VerifiedBy = verifiedBy.UUID
+	report.VerifiedAt = verifiedAt.Time
 	return report, nil
 }
 
 func (r *FinReportRepository) GetByCompany(ctx context.Context, companyId uuid.UUID, period *domain.Period) (report *domain.FinancialReportByPeriod, err error) {
-	query := `select id, company_id, revenue, costs, year, quarter
+	query := `select id, company_id, revenue, costs, year, quarter, verified_by, verified_at
 	from ppo.fin_reports 
 	where company_id = $1 and year = $2 and quarter = $3`
 
@@ -86,6 +93,8 @@ func (r *FinReportRepository) GetByCompany(ctx context.Context, companyId uuid.U
 
 		for quarter := startQtr; quarter <= endQtr; quarter++ {
 			tmp := new(domain.FinancialReport)
+			var verifiedBy uuid.NullUUID
+			var verifiedAt sql.NullTime
 
 			err = r.db.QueryRow(
 				ctx,
@@ -100,6 +109,8 @@ func (r *FinReportRepository) GetByCompany(ctx context.Context, companyId uuid.U
 				&tmp.Costs,
 				&tmp.Year,
 				&tmp.Quarter,
+				&verifiedBy,
+				&verifiedAt,
 			)
 
 			if err != nil {
@@ -109,6 +120,8 @@ func (r *FinReportRepository) GetByCompany(ctx context.Context, companyId uuid.U
 					return nil, fmt.Errorf("сканирование записи: %w", err)
 				}
 			}
+			tmp.VerifiedBy = verifiedBy.UUID
+			tmp.VerifiedAt = verifiedAt.Time
 
 			report.Reports = append(report.Reports, *tmp)
 		}
@@ -130,12 +143,12 @@ func (r *FinReportRepository) Update(ctx context.Context, finRep *domain.Financi
 		queryArgs = append(queryArgs, finRep.CompanyID)
 		i++
 	}
-	if math.Abs(float64(finRep.Revenue)) > 0 {
+	if !finRep.Revenue.IsZero() {
 		queryElems = append(queryElems, fmt.Sprintf("revenue = $%d", i))
 		queryArgs = append(queryArgs, finRep.Revenue)
 		i++
 	}
-	if math.Abs(float64(finRep.Costs)) > 0 {
+	if !finRep.Costs.IsZero() {
 		queryElems = append(queryElems, fmt.Sprintf("costs = $%d", i))
 		queryArgs = append(queryArgs, finRep.Costs)
 		i++
@@ -150,6 +163,8 @@ func (r *FinReportRepository) Update(ctx context.Context, finRep *domain.Financi
 		queryArgs = append(queryArgs, finRep.Quarter)
 		i++
 	}
+	// измененный отчет требует повторной проверки
+	queryElems = append(queryElems, "verified_by = null", "verified_at = null")
 	query += strings.Join(queryElems, ", ")
 	query += fmt.Sprintf(" where id = $%d", i)
 	queryArgs = append(queryArgs, finRep.ID)
@@ -166,6 +181,107 @@ func (r *FinReportRepository) Update(ctx context.Context, finRep *domain.Financi
 	return nil
 }
 
+func (r *FinReportRepository) GetAll(ctx context.Context, filter *domain.FinancialReportFilter, page int) (
+	reports []*domain.FinancialReport, numPages int, err error) {
+	queryArgs := make([]any, 0)
+	queryElems := make([]string, 0)
+
+	i := 1
+	if filter.CompanyID.ID() != 0 {
+		queryElems = append(queryElems, fmt.Sprintf("company_id = $%d", i))
+		queryArgs = append(queryArgs, filter.CompanyID)
+		i++
+	}
+	if filter.Period != nil {
+		queryElems = append(queryElems, fmt.Sprintf("(year, quarter) >= ($%d, $%d)", i, i+1))
+		queryElems = append(queryElems, fmt.Sprintf("(year, quarter) <= ($%d, $%d)", i+2, i+3))
+		queryArgs = append(queryArgs,
+			filter.Period.StartYear, filter.Period.StartQuarter, filter.Period.EndYear, filter.Period.EndQuarter)
+		i += 4
+	}
+	if filter.OnlyUnverified {
+		queryElems = append(queryElems, "verified_at is null")
+	}
+
+	where := ""
+	if len(queryElems) > 0 {
+		where = " where " + strings.Join(queryElems, " and ")
+	}
+
+	rows, err := r.db.Query(
+		ctx,
+		`select id, company_id, revenue, costs, year, quarter, verified_by, verified_at
+		from ppo.fin_reports`+where+
+			fmt.Sprintf(" order by year desc, quarter desc, company_id offset $%d limit $%d", i, i+1),
+		append(queryArgs, (page-1)*config.PageSize, config.PageSize)...,
+	)
+	if err != nil {
+		return nil, 0, fmt.Errorf("получение финансовых отчетов: %w", err)
+	}
+
+	reports = make([]*domain.FinancialReport, 0)
+	for rows.Next() {
+		tmp := new(domain.FinancialReport)
+		var verifiedBy uuid.NullUUID
+		var verifiedAt sql.NullTime
+
+		err = rows.Scan(
+			&tmp.ID,
+			&tmp.CompanyID,
+			&tmp.Revenue,
+			&tmp.Costs,
+			&tmp.Year,
+			&tmp.Quarter,
+			&verifiedBy,
+			&verifiedAt,
+		)
+		if err != nil {
+			return nil, 0, fmt.Errorf("сканирование полученных строк: %w", err)
+		}
+
+		tmp.VerifiedBy = verifiedBy.UUID
+		tmp.VerifiedAt = verifiedAt.Time
+		reports = append(reports, tmp)
+	}
+
+	var numRecords int
+	err = r.db.QueryRow(
+		ctx,
+		`select count(*) from ppo.fin_reports`+where,
+		queryArgs...,
+	).Scan(&numRecords)
+	if err != nil {
+		return nil, 0, fmt.Errorf("получение количества финансовых отчетов: %w", err)
+	}
+
+	numPages = numRecords / config.PageSize
+	if numRecords%config.PageSize != 0 {
+		numPages++
+	}
+
+	return reports, numPages, nil
+}
+
+func (r *FinReportRepository) Verify(ctx context.Context, id uuid.UUID, verifierId uuid.UUID) (err error) {
+	query := `update ppo.fin_reports set verified_by = $1, verified_at = now() where id = $2`
+
+	tag, err := r.db.Exec(
+		ctx,
+		query,
+		verifierId,
+		id,
+	)
+	if err != nil {
+		return fmt.Errorf("проверка отчета: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("проверка отчета: отчет не найден")
+	}
+
+	return nil
+}
+
 func (r *FinReportRepository) DeleteById(ctx context.Context, id uuid.UUID) (err error) {
 	query := `delete from ppo.fin_reports where id = $1`
 
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/require"
 	"ppo/domain"
 	"testing"
@@ -22,8 +23,8 @@ func TestFinReportRepository_Create(t *testing.T) {
 			name: "успех",
 			report: &domain.FinancialReport{
 				CompanyID: uuid.UUID{1},
-				Revenue:   1.32,
-				Costs:     1.23,
+				Revenue:   decimal.RequireFromString("1.32"),
+				Costs:     decimal.RequireFromString("1.23"),
 				Year:      2024,
 				Quarter:   1,
 			},
@@ -90,7 +91,7 @@ func TestFinReportRepository_Update(t *testing.T) {
 			name: "успех",
 			report: &domain.FinancialReport{
 				ID:      uuid.UUID{1},
-				Revenue: 2.0,
+				Revenue: decimal.RequireFromString("2.0"),
 			},
 		},
 	}
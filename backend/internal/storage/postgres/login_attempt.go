@@ -0,0 +1,106 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"ppo/domain"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type LoginAttemptRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewLoginAttemptRepository(db *pgxpool.Pool) domain.ILoginAttemptRepository {
+	return &LoginAttemptRepository{
+		db: db,
+	}
+}
+
+func (r *LoginAttemptRepository) Get(ctx context.Context, key string) (attempts *domain.LoginAttempts, err error) {
+	query := `select failures, locked_until from ppo.login_attempts where key = $1`
+
+	attempts = &domain.LoginAttempts{Key: key}
+
+	var lockedUntil sql.NullTime
+	err = r.db.QueryRow(
+		ctx,
+		query,
+		key,
+	).Scan(
+		&attempts.Failures,
+		&lockedUntil,
+	)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return attempts, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("получение неудачных попыток входа: %w", err)
+	}
+	attempts.LockedUntil = lockedUntil.Time
+
+	return attempts, nil
+}
+
+// RegisterFailure увеличивает счётчик неудачных попыток и возвращает его новое значение.
+// Если предыдущая неудача была раньше since, отсчёт начинается заново.
+func (r *LoginAttemptRepository) RegisterFailure(ctx context.Context, key string, since time.Time) (failures int, err error) {
+	query := `
+		insert into ppo.login_attempts(key, failures, last_failure_at)
+		values ($1, 1, now())
+		on conflict (key) do update
+		set failures = case
+		        when ppo.login_attempts.last_failure_at < $2 then 1
+		        else ppo.login_attempts.failures + 1
+		    end,
+		    last_failure_at = now()
+		returning failures`
+
+	err = r.db.QueryRow(
+		ctx,
+		query,
+		key,
+		since,
+	).Scan(&failures)
+	if err != nil {
+		return 0, fmt.Errorf("учёт неудачной попытки входа: %w", err)
+	}
+
+	return failures, nil
+}
+
+func (r *LoginAttemptRepository) Lock(ctx context.Context, key string, until time.Time) (err error) {
+	query := `update ppo.login_attempts set locked_until = $1 where key = $2`
+
+	_, err = r.db.Exec(
+		ctx,
+		query,
+		until,
+		key,
+	)
+	if err != nil {
+		return fmt.Errorf("блокировка входа: %w", err)
+	}
+
+	return nil
+}
+
+func (r *LoginAttemptRepository) Reset(ctx context.Context, key string) (err error) {
+	query := `delete from ppo.login_attempts where key = $1`
+
+	_, err = r.db.Exec(
+		ctx,
+		query,
+		key,
+	)
+	if err != nil {
+		return fmt.Errorf("сброс неудачных попыток входа: %w", err)
+	}
+
+	return nil
+}
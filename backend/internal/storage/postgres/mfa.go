@@ -0,0 +1,200 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"ppo/domain"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type MfaRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewMfaRepository(db *pgxpool.Pool) domain.IMfaRepository {
+	return &MfaRepository{
+		db: db,
+	}
+}
+
+// GetByUserId возвращает nil без ошибки, если пользователь не подключал двухфакторную аутентификацию.
+func (r *MfaRepository) GetByUserId(ctx context.Context, userId uuid.UUID) (mfa *domain.UserMfa, err error) {
+	query := `select secret, confirmed_at from ppo.user_mfa where user_id = $1`
+
+	mfa = &domain.UserMfa{UserID: userId}
+	var confirmedAt sql.NullTime
+	err = r.db.QueryRow(
+		ctx,
+		query,
+		userId,
+	).Scan(
+		&mfa.Secret,
+		&confirmedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("получение настроек двухфакторной аутентификации: %w", err)
+	}
+
+	mfa.ConfirmedAt = confirmedAt.Time
+	return mfa, nil
+}
+
+// Save сохраняет новый неподтвержденный секрет, заменяя начатое ранее подключение.
+func (r *MfaRepository) Save(ctx context.Context, mfa *domain.UserMfa) (err error) {
+	query := `insert into ppo.user_mfa(user_id, secret) values ($1, $2)
+		on conflict (user_id) do update
+		set secret = excluded.secret, confirmed_at = null, last_used_step = null
+		where ppo.user_mfa.confirmed_at is null`
+
+	tag, err := r.db.Exec(
+		ctx,
+		query,
+		mfa.UserID,
+		mfa.Secret,
+	)
+	if err != nil {
+		return fmt.Errorf("сохранение секрета двухфакторной аутентификации: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("двухфакторная аутентификация уже подключена")
+	}
+
+	return nil
+}
+
+// Confirm завершает подключение и заменяет коды восстановления переданными хэшами.
+func (r *MfaRepository) Confirm(ctx context.Context, userId uuid.UUID, recoveryHashes []string) (err error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("открытие транзакции: %w", err)
+	}
+
+	defer func() {
+		if err != nil {
+			rollbackErr := tx.Rollback(ctx)
+			if rollbackErr != nil {
+				err = fmt.Errorf("обработанная ошибка: %w\nоткат транзакции: %v", err, rollbackErr)
+			}
+		}
+	}()
+
+	tag, err := tx.Exec(
+		ctx,
+		`update ppo.user_mfa set confirmed_at = now() where user_id = $1 and confirmed_at is null`,
+		userId,
+	)
+	if err != nil {
+		return fmt.Errorf("подтверждение двухфакторной аутентификации: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		err = fmt.Errorf("нет неподтвержденного подключения двухфакторной аутентификации")
+		return err
+	}
+
+	_, err = tx.Exec(
+		ctx,
+		`delete from ppo.mfa_recovery_codes where user_id = $1`,
+		userId,
+	)
+	if err != nil {
+		return fmt.Errorf("удаление прежних кодов восстановления: %w", err)
+	}
+
+	for _, hash := range recoveryHashes {
+		_, err = tx.Exec(
+			ctx,
+			`insert into ppo.mfa_recovery_codes(user_id, hash) values ($1, $2)`,
+			userId,
+			hash,
+		)
+		if err != nil {
+			return fmt.Errorf("сохранение кода восстановления: %w", err)
+		}
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return fmt.Errorf("закрытие транзакции: %w", err)
+	}
+
+	return nil
+}
+
+// UseStep запоминает шаг принятого TOTP-кода. Возвращает false, если код этого или более
+// позднего шага уже принимался.
+func (r *MfaRepository) UseStep(ctx context.Context, userId uuid.UUID, step int64) (ok bool, err error) {
+	query := `update ppo.user_mfa set last_used_step = $2
+		where user_id = $1 and (last_used_step is null or last_used_step < $2)`
+
+	tag, err := r.db.Exec(
+		ctx,
+		query,
+		userId,
+		step,
+	)
+	if err != nil {
+		return false, fmt.Errorf("учет использованного кода: %w", err)
+	}
+
+	return tag.RowsAffected() == 1, nil
+}
+
+func (r *MfaRepository) UseRecoveryCode(ctx context.Context, userId uuid.UUID, hash string) (ok bool, err error) {
+	query := `update ppo.mfa_recovery_codes set used_at = now()
+		where user_id = $1 and hash = $2 and used_at is null`
+
+	tag, err := r.db.Exec(
+		ctx,
+		query,
+		userId,
+		hash,
+	)
+	if err != nil {
+		return false, fmt.Errorf("использование кода восстановления: %w", err)
+	}
+
+	return tag.RowsAffected() == 1, nil
+}
+
+func (r *MfaRepository) Delete(ctx context.Context, userId uuid.UUID) (err error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("открытие транзакции: %w", err)
+	}
+
+	defer func() {
+		if err != nil {
+			rollbackErr := tx.Rollback(ctx)
+			if rollbackErr != nil {
+				err = fmt.Errorf("обработанная ошибка: %w\nоткат транзакции: %v", err, rollbackErr)
+			}
+		}
+	}()
+
+	_, err = tx.Exec(ctx, `delete from ppo.mfa_recovery_codes where user_id = $1`, userId)
+	if err != nil {
+		return fmt.Errorf("удаление кодов восстановления: %w", err)
+	}
+
+	_, err = tx.Exec(ctx, `delete from ppo.user_mfa where user_id = $1`, userId)
+	if err != nil {
+		return fmt.Errorf("отключение двухфакторной аутентификации: %w", err)
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return fmt.Errorf("закрытие транзакции: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,63 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"ppo/domain"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type PasswordResetRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewPasswordResetRepository(db *pgxpool.Pool) domain.IPasswordResetRepository {
+	return &PasswordResetRepository{
+		db: db,
+	}
+}
+
+func (r *PasswordResetRepository) Create(ctx context.Context, token *domain.PasswordResetToken) (err error) {
+	query := `insert into ppo.password_reset_tokens(user_id, token_hash, expires_at) values ($1, $2, $3) returning id`
+
+	err = r.db.QueryRow(
+		ctx,
+		query,
+		token.UserID,
+		token.Hash,
+		token.ExpiresAt,
+	).Scan(&token.ID)
+	if err != nil {
+		return fmt.Errorf("сохранение токена сброса пароля: %w", err)
+	}
+
+	return nil
+}
+
+// Use погашает неиспользованный и неистёкший токен одним запросом, поэтому
+// один и тот же токен не может быть применён дважды даже при параллельных запросах.
+func (r *PasswordResetRepository) Use(ctx context.Context, hash string) (token *domain.PasswordResetToken, err error) {
+	query := `
+		update ppo.password_reset_tokens
+		set used_at = now()
+		where token_hash = $1 and used_at is null and expires_at > now()
+		returning id, user_id, expires_at`
+
+	token = new(domain.PasswordResetToken)
+	err = r.db.QueryRow(
+		ctx,
+		query,
+		hash,
+	).Scan(
+		&token.ID,
+		&token.UserID,
+		&token.ExpiresAt,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("использование токена сброса пароля: %w", err)
+	}
+	token.Hash = hash
+
+	return token, nil
+}
@@ -0,0 +1,188 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"ppo/domain"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type RatingSnapshotRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewRatingSnapshotRepository(db *pgxpool.Pool) domain.IRatingSnapshotRepository {
+	return &RatingSnapshotRepository{
+		db: db,
+	}
+}
+
+// Save сохраняет рейтинг за квартал; повторное вычисление той же стратегией за тот же квартал заменяет прежнее.
+func (r *RatingSnapshotRepository) Save(ctx context.Context, snapshot *domain.RatingSnapshot) (err error) {
+	query := `
+		insert into ppo.rating_snapshots(
+			user_id, year, quarter, strategy, company_id, share, cost, max_cost, revenue, profit,
+			cost_contribution, profitability_contribution, rating
+		)
+		values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+		on conflict (user_id, year, quarter, strategy) do update set
+			company_id = excluded.company_id,
+			share = excluded.share,
+			cost = excluded.cost,
+			max_cost = excluded.max_cost,
+			revenue = excluded.revenue,
+			profit = excluded.profit,
+			cost_contribution = excluded.cost_contribution,
+			profitability_contribution = excluded.profitability_contribution,
+			rating = excluded.rating,
+			created_at = now()
+		returning created_at`
+
+	companyId := uuid.NullUUID{UUID: snapshot.CompanyID, Valid: snapshot.CompanyID != uuid.Nil}
+
+	err = r.db.QueryRow(
+		ctx,
+		query,
+		snapshot.UserID,
+		snapshot.Year,
+		snapshot.Quarter,
+		snapshot.Strategy,
+		companyId,
+		snapshot.Share,
+		snapshot.Cost,
+		snapshot.MaxCost,
+		snapshot.Revenue,
+		snapshot.Profit,
+		snapshot.CostContribution,
+		snapshot.ProfitabilityContribution,
+		snapshot.Rating,
+	).Scan(&snapshot.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("сохранение рейтинга за квартал: %w", err)
+	}
+
+	return nil
+}
+
+// GetByUserId возвращает рейтинги предпринимателя, вычисленные стратегией strategy, по кварталам
+// в хронологическом порядке; если период не задан - за все время.
+func (r *RatingSnapshotRepository) GetByUserId(ctx context.Context, userId uuid.UUID, period *domain.Period, strategy string) (snapshots []*domain.RatingSnapshot, err error) {
+	query := `
+		select
+		    user_id,
+		    year,
+		    quarter,
+		    strategy,
+		    company_id,
+		    share,
+		    cost,
+		    max_cost,
+		    revenue,
+		    profit,
+		    cost_contribution,
+		    profitability_contribution,
+		    rating,
+		    created_at
+		from ppo.rating_snapshots
+		where user_id = $1 and strategy = $2`
+	args := []any{userId, strategy}
+
+	if period != nil {
+		query += `
+			and (year, quarter) >= ($3, $4)
+			and (year, quarter) <= ($5, $6)`
+		args = append(args, period.StartYear, period.StartQuarter, period.EndYear, period.EndQuarter)
+	}
+	query += " order by year, quarter"
+
+	rows, err := r.db.Query(
+		ctx,
+		query,
+		args...,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("получение истории рейтинга: %w", err)
+	}
+	defer rows.Close()
+
+	snapshots = make([]*domain.RatingSnapshot, 0)
+	for rows.Next() {
+		tmp := new(domain.RatingSnapshot)
+		var companyId uuid.NullUUID
+
+		err = rows.Scan(
+			&tmp.UserID,
+			&tmp.Year,
+			&tmp.Quarter,
+			&tmp.Strategy,
+			&companyId,
+			&tmp.Share,
+			&tmp.Cost,
+			&tmp.MaxCost,
+			&tmp.Revenue,
+			&tmp.Profit,
+			&tmp.CostContribution,
+			&tmp.ProfitabilityContribution,
+			&tmp.Rating,
+			&tmp.CreatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("сканирование полученных строк: %w", err)
+		}
+		tmp.CompanyID = companyId.UUID
+
+		snapshots = append(snapshots, tmp)
+	}
+
+	return snapshots, nil
+}
+
+// GetLastCompletedQuarter возвращает год и номер последнего квартала, рейтинги всех предпринимателей
+// за который, вычисленные стратегией strategy, сохранены; если таких кварталов нет - нули.
+func (r *RatingSnapshotRepository) GetLastCompletedQuarter(ctx context.Context, strategy string) (year, quarter int, err error) {
+	query := `
+		select year, quarter
+		from ppo.rating_snapshot_runs
+		where strategy = $1
+		order by year desc, quarter desc
+		limit 1`
+
+	err = r.db.QueryRow(
+		ctx,
+		query,
+		strategy,
+	).Scan(&year, &quarter)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return 0, 0, nil
+	}
+	if err != nil {
+		return 0, 0, fmt.Errorf("получение последнего квартала с сохраненными рейтингами: %w", err)
+	}
+
+	return year, quarter, nil
+}
+
+// CompleteQuarter отмечает, что рейтинги всех предпринимателей за квартал, вычисленные стратегией strategy, сохранены.
+func (r *RatingSnapshotRepository) CompleteQuarter(ctx context.Context, year, quarter int, strategy string) (err error) {
+	query := `
+		insert into ppo.rating_snapshot_runs(year, quarter, strategy)
+		values ($1, $2, $3)
+		on conflict (year, quarter, strategy) do nothing`
+
+	_, err = r.db.Exec(
+		ctx,
+		query,
+		year,
+		quarter,
+		strategy,
+	)
+	if err != nil {
+		return fmt.Errorf("отметка о сохранении рейтингов за квартал: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,233 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"ppo/domain"
+	"ppo/internal/config"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type ReviewRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewReviewRepository(db *pgxpool.Pool) domain.IReviewRepository {
+	return &ReviewRepository{
+		db: db,
+	}
+}
+
+func (r *ReviewRepository) Create(ctx context.Context, review *domain.Review) (err error) {
+	query := `insert into ppo.reviews(target_id, reviewer_id, pros, cons, description, rating)
+	values ($1, $2, $3, $4, $5, $6)`
+
+	_, err = r.db.Exec(
+		ctx,
+		query,
+		review.TargetID,
+		review.ReviewerID,
+		review.Pros,
+		review.Cons,
+		review.Description,
+		review.Rating,
+	)
+	if err != nil {
+		return fmt.Errorf("создание отзыва: %w", err)
+	}
+
+	return nil
+}
+
+func (r *ReviewRepository) GetById(ctx context.Context, id uuid.UUID) (review *domain.Review, err error) {
+	query := `select target_id, reviewer_id, pros, cons, description, rating from ppo.reviews where id = $1`
+
+	var description sql.NullString
+	review = new(domain.Review)
+	err = r.db.QueryRow(
+		ctx,
+		query,
+		id,
+	).Scan(
+		&review.TargetID,
+		&review.ReviewerID,
+		&review.Pros,
+		&review.Cons,
+		&description,
+		&review.Rating,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("получение отзыва по id: %w", domain.ErrReviewNotFound)
+		}
+		return nil, fmt.Errorf("получение отзыва по id: %w", err)
+	}
+
+	review.ID = id
+	review.Description = description.String
+	return review, nil
+}
+
+func (r *ReviewRepository) GetByTarget(ctx context.Context, targetId uuid.UUID, page int) (reviews []*domain.Review, numPages int, err error) {
+	query := `
+		select
+		    id,
+		    reviewer_id,
+		    pros,
+		    cons,
+		    description,
+		    rating
+		from ppo.reviews
+		where target_id = $1
+		order by created_at desc, id
+		offset $2
+		limit $3`
+
+	rows, err := r.db.Query(
+		ctx,
+		query,
+		targetId,
+		(page-1)*config.PageSize,
+		config.PageSize,
+	)
+	if err != nil {
+		return nil, 0, fmt.Errorf("получение отзывов: %w", err)
+	}
+
+	reviews = make([]*domain.Review, 0)
+	for rows.Next() {
+		tmp := new(domain.Review)
+		var description sql.NullString
+
+		err = rows.Scan(
+			&tmp.ID,
+			&tmp.ReviewerID,
+			&tmp.Pros,
+			&tmp.Cons,
+			&description,
+			&tmp.Rating,
+		)
+		tmp.TargetID = targetId
+		tmp.Description = description.String
+
+		if err != nil {
+			return nil, 0, fmt.Errorf("сканирование полученных строк: %w", err)
+		}
+		reviews = append(reviews, tmp)
+	}
+
+	var numRecords int
+	err = r.db.QueryRow(
+		ctx,
+		`select count(*) from ppo.reviews where target_id = $1`,
+		targetId,
+	).Scan(&numRecords)
+	if err != nil {
+		return nil, 0, fmt.Errorf("получение количества отзывов: %w", err)
+	}
+
+	numPages = numRecords / config.PageSize
+	if numRecords%config.PageSize != 0 {
+		numPages++
+	}
+
+	return reviews, numPages, nil
+}
+
+func (r *ReviewRepository) GetStatsByTarget(ctx context.Context, targetId uuid.UUID) (stats *domain.ReviewStats, err error) {
+	query := `select count(*), coalesce(avg(rating), 0)::float4 from ppo.reviews where target_id = $1`
+
+	stats = new(domain.ReviewStats)
+	err = r.db.QueryRow(
+		ctx,
+		query,
+		targetId,
+	).Scan(
+		&stats.Count,
+		&stats.AverageRating,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("получение статистики отзывов: %w", err)
+	}
+
+	return stats, nil
+}
+
+func (r *ReviewRepository) ExistsByReviewer(ctx context.Context, targetId, reviewerId uuid.UUID) (exists bool, err error) {
+	query := `select exists(select 1 from ppo.reviews where target_id = $1 and reviewer_id = $2)`
+
+	err = r.db.QueryRow(
+		ctx,
+		query,
+		targetId,
+		reviewerId,
+	).Scan(&exists)
+	if err != nil {
+		return false, fmt.Errorf("проверка наличия отзыва: %w", err)
+	}
+
+	return exists, nil
+}
+
+func (r *ReviewRepository) Update(ctx context.Context, review *domain.Review) (err error) {
+	queryArgs := make([]any, 0)
+	queryElems := make([]string, 0)
+	query := "update ppo.reviews set "
+
+	i := 1
+	if review.Pros != "" {
+		queryElems = append(queryElems, fmt.Sprintf("pros = $%d", i))
+		queryArgs = append(queryArgs, review.Pros)
+		i++
+	}
+	if review.Cons != "" {
+		queryElems = append(queryElems, fmt.Sprintf("cons = $%d", i))
+		queryArgs = append(queryArgs, review.Cons)
+		i++
+	}
+	if review.Description != "" {
+		queryElems = append(queryElems, fmt.Sprintf("description = $%d", i))
+		queryArgs = append(queryArgs, review.Description)
+		i++
+	}
+	if review.Rating != 0 {
+		queryElems = append(queryElems, fmt.Sprintf("rating = $%d", i))
+		queryArgs = append(queryArgs, review.Rating)
+		i++
+	}
+	query += strings.Join(queryElems, ", ")
+	query += fmt.Sprintf(" where id = $%d", i)
+	queryArgs = append(queryArgs, review.ID)
+
+	_, err = r.db.Exec(
+		ctx,
+		query,
+		queryArgs...,
+	)
+	if err != nil {
+		return fmt.Errorf("обновление отзыва: %w", err)
+	}
+
+	return nil
+}
+
+func (r *ReviewRepository) DeleteById(ctx context.Context, id uuid.UUID) (err error) {
+	query := `delete from ppo.reviews where id = $1`
+
+	_, err = r.db.Exec(
+		ctx,
+		query,
+		id,
+	)
+	if err != nil {
+		return fmt.Errorf("удаление отзыва по id: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,98 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"ppo/domain"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type RoleRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewRoleRepository(db *pgxpool.Pool) domain.IRoleRepository {
+	return &RoleRepository{
+		db: db,
+	}
+}
+
+const roleQuery = `select
+		r.name,
+		r.description,
+		array_remove(array_agg(rp.permission order by rp.permission), null)
+	from ppo.roles r
+	left join ppo.role_permissions rp on rp.role = r.name`
+
+func (r *RoleRepository) GetAll(ctx context.Context) (roles []*domain.Role, err error) {
+	rows, err := r.db.Query(
+		ctx,
+		roleQuery+` group by r.name, r.description order by r.name`,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("получение ролей: %w", err)
+	}
+
+	roles = make([]*domain.Role, 0)
+	for rows.Next() {
+		tmp := new(domain.Role)
+
+		err = rows.Scan(
+			&tmp.Name,
+			&tmp.Description,
+			&tmp.Permissions,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("сканирование полученных строк: %w", err)
+		}
+
+		roles = append(roles, tmp)
+	}
+
+	return roles, nil
+}
+
+func (r *RoleRepository) GetByName(ctx context.Context, name string) (role *domain.Role, err error) {
+	role = new(domain.Role)
+	err = r.db.QueryRow(
+		ctx,
+		roleQuery+` where r.name = $1 group by r.name, r.description`,
+		name,
+	).Scan(
+		&role.Name,
+		&role.Description,
+		&role.Permissions,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("роль '%s' не найдена", name)
+		}
+		return nil, fmt.Errorf("получение роли: %w", err)
+	}
+
+	return role, nil
+}
+
+func (r *RoleRepository) SetUserRole(ctx context.Context, userId uuid.UUID, role string) (err error) {
+	query := `update ppo.users set role = $1 where id = $2`
+
+	tag, err := r.db.Exec(
+		ctx,
+		query,
+		role,
+		userId,
+	)
+	if err != nil {
+		return fmt.Errorf("назначение роли: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("назначение роли: пользователь не найден")
+	}
+
+	return nil
+}
@@ -0,0 +1,146 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"ppo/domain"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type SessionRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewSessionRepository(db *pgxpool.Pool) domain.ISessionRepository {
+	return &SessionRepository{
+		db: db,
+	}
+}
+
+func (r *SessionRepository) Create(ctx context.Context, userId uuid.UUID) (id uuid.UUID, err error) {
+	query := `insert into ppo.sessions(user_id) values ($1) returning id`
+
+	err = r.db.QueryRow(
+		ctx,
+		query,
+		userId,
+	).Scan(&id)
+	if err != nil {
+		return uuid.UUID{}, fmt.Errorf("создание сессии: %w", err)
+	}
+
+	return id, nil
+}
+
+func (r *SessionRepository) IsActive(ctx context.Context, id uuid.UUID) (active bool, err error) {
+	query := `select exists(select 1 from ppo.sessions where id = $1 and revoked_at is null)`
+
+	err = r.db.QueryRow(
+		ctx,
+		query,
+		id,
+	).Scan(&active)
+	if err != nil {
+		return false, fmt.Errorf("проверка активности сессии: %w", err)
+	}
+
+	return active, nil
+}
+
+func (r *SessionRepository) Revoke(ctx context.Context, id uuid.UUID) (err error) {
+	query := `update ppo.sessions set revoked_at = now() where id = $1 and revoked_at is null`
+
+	_, err = r.db.Exec(
+		ctx,
+		query,
+		id,
+	)
+	if err != nil {
+		return fmt.Errorf("отзыв сессии: %w", err)
+	}
+
+	return nil
+}
+
+func (r *SessionRepository) RevokeByUserId(ctx context.Context, userId uuid.UUID) (err error) {
+	query := `update ppo.sessions set revoked_at = now() where user_id = $1 and revoked_at is null`
+
+	_, err = r.db.Exec(
+		ctx,
+		query,
+		userId,
+	)
+	if err != nil {
+		return fmt.Errorf("отзыв сессий пользователя: %w", err)
+	}
+
+	return nil
+}
+
+func (r *SessionRepository) CreateRefreshToken(ctx context.Context, token *domain.RefreshToken) (err error) {
+	query := `insert into ppo.refresh_tokens(session_id, token_hash, expires_at) values ($1, $2, $3) returning id`
+
+	err = r.db.QueryRow(
+		ctx,
+		query,
+		token.SessionID,
+		token.Hash,
+		token.ExpiresAt,
+	).Scan(&token.ID)
+	if err != nil {
+		return fmt.Errorf("сохранение refresh-токена: %w", err)
+	}
+
+	return nil
+}
+
+func (r *SessionRepository) GetRefreshTokenByHash(ctx context.Context, hash string) (token *domain.RefreshToken, err error) {
+	query := `
+		select
+		    rt.id,
+		    rt.session_id,
+		    s.user_id,
+		    rt.expires_at,
+		    rt.used_at is not null
+		from ppo.refresh_tokens rt
+		join ppo.sessions s on s.id = rt.session_id
+		where rt.token_hash = $1`
+
+	token = new(domain.RefreshToken)
+	err = r.db.QueryRow(
+		ctx,
+		query,
+		hash,
+	).Scan(
+		&token.ID,
+		&token.SessionID,
+		&token.UserID,
+		&token.ExpiresAt,
+		&token.Used,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("получение refresh-токена: %w", err)
+	}
+	token.Hash = hash
+
+	return token, nil
+}
+
+// UseRefreshToken помечает токен использованным. Возвращает false, если токен уже был использован,
+// в том числе параллельным запросом.
+func (r *SessionRepository) UseRefreshToken(ctx context.Context, id uuid.UUID) (ok bool, err error) {
+	query := `update ppo.refresh_tokens set used_at = now() where id = $1 and used_at is null`
+
+	tag, err := r.db.Exec(
+		ctx,
+		query,
+		id,
+	)
+	if err != nil {
+		return false, fmt.Errorf("использование refresh-токена: %w", err)
+	}
+
+	return tag.RowsAffected() == 1, nil
+}
@@ -0,0 +1,136 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"ppo/domain"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type SigningKeyRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewSigningKeyRepository(db *pgxpool.Pool) domain.ISigningKeyRepository {
+	return &SigningKeyRepository{
+		db: db,
+	}
+}
+
+// GetValid возвращает ключи, пригодные для проверки токенов; действующий ключ подписи идет первым.
+func (r *SigningKeyRepository) GetValid(ctx context.Context) (keys []*domain.SigningKey, err error) {
+	query := `select id, algorithm, private_key, created_at, retired_at, expires_at
+		from ppo.signing_keys
+		where expires_at is null or expires_at > now()
+		order by retired_at is not null, created_at desc`
+
+	rows, err := r.db.Query(
+		ctx,
+		query,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("получение ключей подписи: %w", err)
+	}
+
+	keys = make([]*domain.SigningKey, 0)
+	for rows.Next() {
+		tmp := new(domain.SigningKey)
+		var retiredAt, expiresAt sql.NullTime
+
+		err = rows.Scan(
+			&tmp.ID,
+			&tmp.Algorithm,
+			&tmp.PrivateKey,
+			&tmp.CreatedAt,
+			&retiredAt,
+			&expiresAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("сканирование полученных строк: %w", err)
+		}
+
+		tmp.RetiredAt = retiredAt.Time
+		tmp.ExpiresAt = expiresAt.Time
+		keys = append(keys, tmp)
+	}
+
+	return keys, nil
+}
+
+// Rotate делает key ключом подписи, если действующий ключ создан раньше createdBefore или
+// использует другой алгоритм. Прежний ключ остается пригодным для проверки до retiredKeyExpiresAt.
+// Экземпляры приложения ротируют ключи под общей блокировкой, поэтому ключ подписи всегда один.
+func (r *SigningKeyRepository) Rotate(ctx context.Context, key *domain.SigningKey, createdBefore time.Time,
+	retiredKeyExpiresAt time.Time) (rotated bool, err error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return false, fmt.Errorf("открытие транзакции: %w", err)
+	}
+
+	defer func() {
+		if err != nil || !rotated {
+			rollbackErr := tx.Rollback(ctx)
+			if err != nil && rollbackErr != nil {
+				err = fmt.Errorf("обработанная ошибка: %w\nоткат транзакции: %v", err, rollbackErr)
+			}
+		}
+	}()
+
+	_, err = tx.Exec(ctx, `select pg_advisory_xact_lock(hashtext('ppo.signing_keys'))`)
+	if err != nil {
+		return false, fmt.Errorf("блокировка ключей подписи: %w", err)
+	}
+
+	var fresh bool
+	err = tx.QueryRow(
+		ctx,
+		`select exists(
+			select 1 from ppo.signing_keys
+			where retired_at is null and created_at >= $1 and algorithm = $2
+		)`,
+		createdBefore,
+		key.Algorithm,
+	).Scan(&fresh)
+	if err != nil {
+		return false, fmt.Errorf("проверка действующего ключа подписи: %w", err)
+	}
+
+	if fresh {
+		return false, nil
+	}
+
+	_, err = tx.Exec(
+		ctx,
+		`update ppo.signing_keys set retired_at = now(), expires_at = $1 where retired_at is null`,
+		retiredKeyExpiresAt,
+	)
+	if err != nil {
+		return false, fmt.Errorf("вывод ключа подписи из оборота: %w", err)
+	}
+
+	_, err = tx.Exec(
+		ctx,
+		`insert into ppo.signing_keys(id, algorithm, private_key) values ($1, $2, $3)`,
+		key.ID,
+		key.Algorithm,
+		key.PrivateKey,
+	)
+	if err != nil {
+		return false, fmt.Errorf("создание ключа подписи: %w", err)
+	}
+
+	_, err = tx.Exec(ctx, `delete from ppo.signing_keys where expires_at <= now()`)
+	if err != nil {
+		return false, fmt.Errorf("удаление истекших ключей подписи: %w", err)
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return false, fmt.Errorf("закрытие транзакции: %w", err)
+	}
+
+	return true, nil
+}
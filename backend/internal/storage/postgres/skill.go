@@ -0,0 +1,267 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"ppo/domain"
+	"ppo/internal/config"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type SkillRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewSkillRepository(db *pgxpool.Pool) domain.ISkillRepository {
+	return &SkillRepository{
+		db: db,
+	}
+}
+
+func (r *SkillRepository) Create(ctx context.Context, skill *domain.Skill) (err error) {
+	query := `insert into ppo.skills(name, description)
+	values ($1, $2)`
+
+	_, err = r.db.Exec(
+		ctx,
+		query,
+		skill.Name,
+		skill.Description,
+	)
+	if err != nil {
+		return fmt.Errorf("создание навыка: %w", err)
+	}
+
+	return nil
+}
+
+func (r *SkillRepository) GetById(ctx context.Context, id uuid.UUID) (skill *domain.Skill, err error) {
+	query := `select name, description from ppo.skills where id = $1`
+
+	skill = new(domain.Skill)
+	err = r.db.QueryRow(
+		ctx,
+		query,
+		id,
+	).Scan(
+		&skill.Name,
+		&skill.Description,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("получение навыка по id: %w", err)
+	}
+
+	skill.ID = id
+	return skill, nil
+}
+
+func (r *SkillRepository) GetAll(ctx context.Context, page int, isPaginated bool) (skills []*domain.Skill, numPages int, err error) {
+	query :=
+		`select
+    		id,
+    		name,
+    		description
+		from ppo.skills
+		order by name`
+
+	var rows pgx.Rows
+	if !isPaginated {
+		rows, err = r.db.Query(
+			ctx,
+			query,
+		)
+	} else {
+		rows, err = r.db.Query(
+			ctx,
+			query+` offset $1 limit $2`,
+			(page-1)*config.PageSize,
+			config.PageSize,
+		)
+	}
+	if err != nil {
+		return nil, 0, fmt.Errorf("получение навыков: %w", err)
+	}
+
+	skills = make([]*domain.Skill, 0)
+	for rows.Next() {
+		tmp := new(domain.Skill)
+
+		err = rows.Scan(
+			&tmp.ID,
+			&tmp.Name,
+			&tmp.Description,
+		)
+
+		if err != nil {
+			return nil, 0, fmt.Errorf("сканирование полученных строк: %w", err)
+		}
+
+		skills = append(skills, tmp)
+	}
+
+	var numRecords int
+	err = r.db.QueryRow(
+		ctx,
+		`select count(*) from ppo.skills`,
+	).Scan(&numRecords)
+	if err != nil {
+		return nil, 0, fmt.Errorf("получение числа навыков: %w", err)
+	}
+
+	numPages = numRecords / config.PageSize
+	if numRecords%config.PageSize != 0 {
+		numPages++
+	}
+
+	return skills, numPages, nil
+}
+
+func (r *SkillRepository) GetByUserId(ctx context.Context, userId uuid.UUID) (skills []*domain.Skill, err error) {
+	query := `
+		select
+		    s.id,
+		    s.name,
+		    s.description
+		from ppo.skills s
+		join ppo.user_skills us on us.skill_id = s.id
+		where us.user_id = $1
+		order by s.name`
+
+	rows, err := r.db.Query(
+		ctx,
+		query,
+		userId,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("получение навыков пользователя: %w", err)
+	}
+
+	skills = make([]*domain.Skill, 0)
+	for rows.Next() {
+		tmp := new(domain.Skill)
+
+		err = rows.Scan(
+			&tmp.ID,
+			&tmp.Name,
+			&tmp.Description,
+		)
+
+		if err != nil {
+			return nil, fmt.Errorf("сканирование полученных строк: %w", err)
+		}
+		skills = append(skills, tmp)
+	}
+
+	return skills, nil
+}
+
+func (r *SkillRepository) Update(ctx context.Context, skill *domain.Skill) (err error) {
+	queryArgs := make([]any, 0)
+	queryElems := make([]string, 0)
+	query := "update ppo.skills set "
+
+	i := 1
+	if skill.Name != "" {
+		queryElems = append(queryElems, fmt.Sprintf("name = $%d", i))
+		queryArgs = append(queryArgs, skill.Name)
+		i++
+	}
+	if skill.Description != "" {
+		queryElems = append(queryElems, fmt.Sprintf("description = $%d", i))
+		queryArgs = append(queryArgs, skill.Description)
+		i++
+	}
+	query += strings.Join(queryElems, ", ")
+	query += fmt.Sprintf(" where id = $%d", i)
+	queryArgs = append(queryArgs, skill.ID)
+
+	_, err = r.db.Exec(
+		ctx,
+		query,
+		queryArgs...,
+	)
+	if err != nil {
+		return fmt.Errorf("обновление информации о навыке: %w", err)
+	}
+
+	return nil
+}
+
+func (r *SkillRepository) DeleteById(ctx context.Context, id uuid.UUID) (err error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("открытие транзакции: %w", err)
+	}
+
+	defer func() {
+		if err != nil {
+			rollbackErr := tx.Rollback(ctx)
+			if rollbackErr != nil {
+				err = fmt.Errorf("обработанная ошибка: %w\nоткат транзакции: %v", err, rollbackErr)
+			}
+		}
+	}()
+
+	_, err = tx.Exec(
+		ctx,
+		`delete from ppo.user_skills where skill_id = $1`,
+		id,
+	)
+	if err != nil {
+		return fmt.Errorf("удаление навыка у пользователей: %w", err)
+	}
+
+	_, err = tx.Exec(
+		ctx,
+		`delete from ppo.skills where id = $1`,
+		id,
+	)
+	if err != nil {
+		return fmt.Errorf("удаление навыка по id: %w", err)
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return fmt.Errorf("закрытие транзакции: %w", err)
+	}
+
+	return nil
+}
+
+func (r *SkillRepository) AddToUser(ctx context.Context, userId, skillId uuid.UUID) (err error) {
+	query := `insert into ppo.user_skills(user_id, skill_id)
+	values ($1, $2)
+	on conflict do nothing`
+
+	_, err = r.db.Exec(
+		ctx,
+		query,
+		userId,
+		skillId,
+	)
+	if err != nil {
+		return fmt.Errorf("добавление навыка пользователю: %w", err)
+	}
+
+	return nil
+}
+
+func (r *SkillRepository) DeleteFromUser(ctx context.Context, userId, skillId uuid.UUID) (err error) {
+	query := `delete from ppo.user_skills where user_id = $1 and skill_id = $2`
+
+	_, err = r.db.Exec(
+		ctx,
+		query,
+		userId,
+		skillId,
+	)
+	if err != nil {
+		return fmt.Errorf("удаление навыка у пользователя: %w", err)
+	}
+
+	return nil
+}
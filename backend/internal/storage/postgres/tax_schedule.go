@@ -0,0 +1,300 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"ppo/domain"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/shopspring/decimal"
+)
+
+type TaxScheduleRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewTaxScheduleRepository(db *pgxpool.Pool) domain.ITaxScheduleRepository {
+	return &TaxScheduleRepository{
+		db: db,
+	}
+}
+
+func (r *TaxScheduleRepository) Create(ctx context.Context, schedule *domain.TaxSchedule) (err error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("открытие транзакции: %w", err)
+	}
+
+	defer func() {
+		if err != nil {
+			rollbackErr := tx.Rollback(ctx)
+			if rollbackErr != nil {
+				err = fmt.Errorf("обработанная ошибка: %w\nоткат транзакции: %v", err, rollbackErr)
+			}
+		}
+	}()
+
+	err = tx.QueryRow(
+		ctx,
+		`insert into ppo.tax_schedules(year, regime) values ($1, $2) returning id`,
+		schedule.Year,
+		schedule.Regime,
+	).Scan(&schedule.ID)
+	if err != nil {
+		return fmt.Errorf("создание налоговой шкалы: %w", err)
+	}
+
+	err = insertTaxBrackets(ctx, tx, schedule)
+	if err != nil {
+		return err
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return fmt.Errorf("закрытие транзакции: %w", err)
+	}
+
+	return nil
+}
+
+func insertTaxBrackets(ctx context.Context, tx pgx.Tx, schedule *domain.TaxSchedule) (err error) {
+	query := `insert into ppo.tax_brackets(schedule_id, upper_bound, rate) values ($1, $2, $3)`
+
+	for _, bracket := range schedule.Brackets {
+		var upperBound decimal.NullDecimal
+		if !bracket.UpperBound.IsZero() {
+			upperBound = decimal.NewNullDecimal(bracket.UpperBound)
+		}
+
+		_, err = tx.Exec(
+			ctx,
+			query,
+			schedule.ID,
+			upperBound,
+			bracket.Rate,
+		)
+		if err != nil {
+			return fmt.Errorf("добавление ступени налоговой шкалы: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (r *TaxScheduleRepository) getBrackets(ctx context.Context, schedule *domain.TaxSchedule) (err error) {
+	query := `
+		select
+		    upper_bound,
+		    rate
+		from ppo.tax_brackets
+		where schedule_id = $1
+		order by upper_bound nulls last`
+
+	rows, err := r.db.Query(
+		ctx,
+		query,
+		schedule.ID,
+	)
+	if err != nil {
+		return fmt.Errorf("получение ступеней налоговой шкалы: %w", err)
+	}
+
+	schedule.Brackets = make([]domain.TaxBracket, 0)
+	for rows.Next() {
+		var upperBound decimal.NullDecimal
+		var bracket domain.TaxBracket
+
+		err = rows.Scan(
+			&upperBound,
+			&bracket.Rate,
+		)
+		if err != nil {
+			return fmt.Errorf("сканирование полученных строк: %w", err)
+		}
+
+		if upperBound.Valid {
+			bracket.UpperBound = upperBound.Decimal
+		}
+		schedule.Brackets = append(schedule.Brackets, bracket)
+	}
+
+	return nil
+}
+
+func (r *TaxScheduleRepository) GetById(ctx context.Context, id uuid.UUID) (schedule *domain.TaxSchedule, err error) {
+	query := `select year, regime from ppo.tax_schedules where id = $1`
+
+	schedule = new(domain.TaxSchedule)
+	err = r.db.QueryRow(
+		ctx,
+		query,
+		id,
+	).Scan(
+		&schedule.Year,
+		&schedule.Regime,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("получение налоговой шкалы по id: %w", err)
+	}
+	schedule.ID = id
+
+	err = r.getBrackets(ctx, schedule)
+	if err != nil {
+		return nil, err
+	}
+
+	return schedule, nil
+}
+
+func (r *TaxScheduleRepository) GetByYear(ctx context.Context, year int, regime string) (schedule *domain.TaxSchedule, err error) {
+	query := `
+		select
+		    id,
+		    year,
+		    regime
+		from ppo.tax_schedules
+		where regime = $1 and year <= $2
+		order by year desc
+		limit 1`
+
+	schedule = new(domain.TaxSchedule)
+	err = r.db.QueryRow(
+		ctx,
+		query,
+		regime,
+		year,
+	).Scan(
+		&schedule.ID,
+		&schedule.Year,
+		&schedule.Regime,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("получение налоговой шкалы за %d год: %w", year, err)
+	}
+
+	err = r.getBrackets(ctx, schedule)
+	if err != nil {
+		return nil, err
+	}
+
+	return schedule, nil
+}
+
+func (r *TaxScheduleRepository) GetAll(ctx context.Context) (schedules []*domain.TaxSchedule, err error) {
+	query := `select id, year, regime from ppo.tax_schedules order by regime, year`
+
+	rows, err := r.db.Query(
+		ctx,
+		query,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("получение налоговых шкал: %w", err)
+	}
+	defer rows.Close()
+
+	schedules = make([]*domain.TaxSchedule, 0)
+	for rows.Next() {
+		tmp := new(domain.TaxSchedule)
+
+		err = rows.Scan(
+			&tmp.ID,
+			&tmp.Year,
+			&tmp.Regime,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("сканирование полученных строк: %w", err)
+		}
+
+		schedules = append(schedules, tmp)
+	}
+	rows.Close()
+
+	for _, schedule := range schedules {
+		err = r.getBrackets(ctx, schedule)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return schedules, nil
+}
+
+func (r *TaxScheduleRepository) Update(ctx context.Context, schedule *domain.TaxSchedule) (err error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("открытие транзакции: %w", err)
+	}
+
+	defer func() {
+		if err != nil {
+			rollbackErr := tx.Rollback(ctx)
+			if rollbackErr != nil {
+				err = fmt.Errorf("обработанная ошибка: %w\nоткат транзакции: %v", err, rollbackErr)
+			}
+		}
+	}()
+
+	if schedule.Year != 0 {
+		_, err = tx.Exec(
+			ctx,
+			`update ppo.tax_schedules set year = $1 where id = $2`,
+			schedule.Year,
+			schedule.ID,
+		)
+		if err != nil {
+			return fmt.Errorf("обновление года налоговой шкалы: %w", err)
+		}
+	}
+
+	if schedule.Regime != "" {
+		_, err = tx.Exec(
+			ctx,
+			`update ppo.tax_schedules set regime = $1 where id = $2`,
+			schedule.Regime,
+			schedule.ID,
+		)
+		if err != nil {
+			return fmt.Errorf("обновление режима налоговой шкалы: %w", err)
+		}
+	}
+
+	if len(schedule.Brackets) != 0 {
+		_, err = tx.Exec(
+			ctx,
+			`delete from ppo.tax_brackets where schedule_id = $1`,
+			schedule.ID,
+		)
+		if err != nil {
+			return fmt.Errorf("удаление ступеней налоговой шкалы: %w", err)
+		}
+
+		err = insertTaxBrackets(ctx, tx, schedule)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return fmt.Errorf("закрытие транзакции: %w", err)
+	}
+
+	return nil
+}
+
+func (r *TaxScheduleRepository) DeleteById(ctx context.Context, id uuid.UUID) (err error) {
+	query := `delete from ppo.tax_schedules where id = $1`
+
+	_, err = r.db.Exec(
+		ctx,
+		query,
+		id,
+	)
+	if err != nil {
+		return fmt.Errorf("удаление налоговой шкалы по id: %w", err)
+	}
+
+	return nil
+}
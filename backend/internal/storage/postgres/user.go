@@ -2,13 +2,16 @@ package postgres
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"ppo/domain"
 	"ppo/internal/config"
 	"strings"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/shopspring/decimal"
 )
 
 type UserRepository struct {
@@ -21,6 +24,8 @@ func NewUserRepository(db *pgxpool.Pool) domain.IUserRepository {
 	}
 }
 
+// Create заполняет профиль учётной записи, зарегистрированной без него. Заполненный профиль
+// этим методом не перезаписывается, для изменения есть Update.
 func (r *UserRepository) Create(ctx context.Context, user *domain.User) (err error) {
 	query := `update ppo.users
 		set 
@@ -28,9 +33,10 @@ func (r *UserRepository) Create(ctx context.Context, user *domain.User) (err err
 		    birthday = $2,
 		    gender = $3,
 		    city = $4
-		where id = $5`
+		where id = $5
+			and (full_name is null or birthday is null or gender is null or city is null)`
 
-	_, err = r.db.Exec(
+	tag, err := r.db.Exec(
 		ctx,
 		query,
 		user.FullName,
@@ -43,6 +49,10 @@ func (r *UserRepository) Create(ctx context.Context, user *domain.User) (err err
 		return fmt.Errorf("создание пользователя: %w", err)
 	}
 
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("создание пользователя: профиль уже заполнен или пользователь не найден")
+	}
+
 	return nil
 }
 
@@ -87,6 +97,9 @@ func (r *UserRepository) GetById(ctx context.Context, userId uuid.UUID) (user *d
 		&tmp.Role,
 	)
 	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("получение пользователя по id: %w", domain.ErrUserNotFound)
+		}
 		return nil, fmt.Errorf("получение пользователя по id: %w", err)
 	}
 
@@ -94,24 +107,110 @@ func (r *UserRepository) GetById(ctx context.Context, userId uuid.UUID) (user *d
 	return UserDbToUser(tmp), nil
 }
 
-func (r *UserRepository) GetAll(ctx context.Context, page int) (users []*domain.User, numPages int, err error) {
+// userFilterConditions строит условия отбора предпринимателей по фильтру. Значения передаются
+// только параметрами запроса, нумерация которых продолжает уже добавленные в queryArgs.
+func userFilterConditions(filter *domain.UserFilter, queryArgs []any) (queryElems []string, args []any) {
+	// предприниматели, не заполнившие профиль, в поиск не попадают
+	queryElems = []string{
+		"u.role = 'user'",
+		"u.full_name is not null and u.birthday is not null and u.gender is not null and u.city is not null",
+	}
+	args = queryArgs
+
+	i := len(args) + 1
+	if filter.City != "" {
+		queryElems = append(queryElems, fmt.Sprintf("u.city = $%d", i))
+		args = append(args, filter.City)
+		i++
+	}
+	if filter.Gender != "" {
+		queryElems = append(queryElems, fmt.Sprintf("u.gender = $%d", i))
+		args = append(args, filter.Gender)
+		i++
+	}
+	if filter.MinAge > 0 {
+		queryElems = append(queryElems, fmt.Sprintf("u.birthday <= current_date - make_interval(years => $%d)", i))
+		args = append(args, filter.MinAge)
+		i++
+	}
+	if filter.MaxAge > 0 {
+		queryElems = append(queryElems, fmt.Sprintf("u.birthday > current_date - make_interval(years => $%d)", i))
+		args = append(args, filter.MaxAge+1)
+		i++
+	}
+	// компании предпринимателя - все, где он совладелец, в том числе основной владелец;
+	// сфера деятельности может быть как основной, так и дополнительной
+	if filter.ActivityFieldId.ID() != 0 {
+		queryElems = append(queryElems, fmt.Sprintf(`exists (
+			select 1
+			from ppo.company_owners fco
+			join ppo.companies fc on fc.id = fco.company_id
+			where fco.user_id = u.id
+				and fco.accepted_at is not null
+				and (
+					fc.activity_field_id = $%[1]d
+					or exists (
+						select 1 from ppo.company_activity_fields caf
+						where caf.company_id = fc.id and caf.activity_field_id = $%[1]d
+					)
+				)
+		)`, i))
+		args = append(args, filter.ActivityFieldId)
+		i++
+	}
+	if filter.SkillId.ID() != 0 {
+		queryElems = append(queryElems, fmt.Sprintf(
+			"exists (select 1 from ppo.user_skills us where us.user_id = u.id and us.skill_id = $%d)", i))
+		args = append(args, filter.SkillId)
+		i++
+	}
+	if filter.Name != "" {
+		queryElems = append(queryElems, fmt.Sprintf(
+			"to_tsvector('simple', coalesce(u.full_name, '')) @@ plainto_tsquery('simple', $%d)", i))
+		args = append(args, filter.Name)
+	}
+
+	return queryElems, args
+}
+
+func (r *UserRepository) GetAll(ctx context.Context, filter *domain.UserFilter, page int) (users []*domain.User, numPages int, err error) {
 	query := `select 
-    	id,
-    	username,
-    	full_name,
-    	birthday,
-    	gender,
-    	city 
-	from ppo.users
-	where role = 'user'
-	offset $1
-	limit $2`
+    	u.id,
+    	u.username,
+    	u.full_name,
+    	u.birthday,
+    	u.gender,
+    	u.city 
+	from ppo.users u`
+
+	queryArgs := make([]any, 0)
+	orderBy := " order by u.full_name, u.id"
+	if filter.SortBy == domain.SortByRevenue {
+		query += `
+	left join lateral (
+		select coalesce(sum(fr.revenue * co.share / 100), 0) as revenue
+		from ppo.company_owners co
+		join ppo.fin_reports fr on fr.company_id = co.company_id
+		where co.user_id = u.id
+			and co.accepted_at is not null
+			and (fr.year, fr.quarter) >= ($1, $2)
+			and (fr.year, fr.quarter) <= ($3, $4)
+	) rev on true`
+		queryArgs = append(queryArgs,
+			filter.Period.StartYear, filter.Period.StartQuarter, filter.Period.EndYear, filter.Period.EndQuarter)
+		orderBy = " order by rev.revenue desc, u.full_name, u.id"
+	}
+
+	queryElems, queryArgs := userFilterConditions(filter, queryArgs)
+	where := " where " + strings.Join(queryElems, " and ")
+
+	i := len(queryArgs) + 1
+	query += where + orderBy + fmt.Sprintf(" offset $%d limit $%d", i, i+1)
 
 	rows, err := r.db.Query(
 		ctx,
 		query,
-		(page-1)*config.PageSize,
-		config.PageSize,
+		append(queryArgs, (page-1)*config.PageSize, config.PageSize)...,
 	)
 	if err != nil {
 		return nil, 0, fmt.Errorf("получение предпринимателей: %w", err)
@@ -136,10 +235,14 @@ func (r *UserRepository) GetAll(ctx context.Context, page int) (users []*domain.
 		users = append(users, UserDbToUser(tmp))
 	}
 
+	// условия фильтра те же, но без параметров периода, нужных только для сортировки
+	countElems, countArgs := userFilterConditions(filter, make([]any, 0))
+
 	var numRecords int
 	err = r.db.QueryRow(
 		ctx,
-		`select count(*) from ppo.users`,
+		`select count(*) from ppo.users u where `+strings.Join(countElems, " and "),
+		countArgs...,
 	).Scan(&numRecords)
 	if err != nil {
 		return nil, 0, fmt.Errorf("получение количества предпринимателей: %w", err)
@@ -153,6 +256,278 @@ func (r *UserRepository) GetAll(ctx context.Context, page int) (users []*domain.
 	return users, numPages, nil
 }
 
+func (r *UserRepository) GetRatingData(ctx context.Context, period *domain.Period, filter *domain.UserFilter) (data []*domain.UserRatingData, err error) {
+	// по каждой компании считаются выручка и прибыль за период в части, приходящейся на долю совладельца,
+	// затем они суммируются по совладельцу; для рейтинга также нужен вес сферы деятельности
+	// наиболее прибыльной для него компании
+	query := `
+		with company_results as (
+			select
+			    co.user_id as owner_id,
+			    af.cost,
+			    coalesce(sum(fr.revenue), 0) * co.share / 100 as revenue,
+			    coalesce(sum(fr.revenue - fr.costs), 0) * co.share / 100 as profit
+			from ppo.company_owners co
+			join ppo.companies c on c.id = co.company_id
+			join ppo.activity_fields af on af.id = c.activity_field_id
+			left join ppo.fin_reports fr on fr.company_id = c.id
+				and (fr.year, fr.quarter) >= ($1, $2)
+				and (fr.year, fr.quarter) <= ($3, $4)
+			where co.accepted_at is not null
+			group by c.id, co.user_id, co.share, af.cost
+		),
+		totals as (
+			select
+			    owner_id,
+			    sum(revenue) as revenue,
+			    sum(profit) as profit
+			from company_results
+			group by owner_id
+		),
+		review_stats as (
+			select
+			    target_id,
+			    count(*) as count,
+			    avg(rating)::float4 as average_rating
+			from ppo.reviews
+			group by target_id
+		),
+		best as (
+			select distinct on (owner_id)
+			    owner_id,
+			    cost
+			from company_results
+			where profit > 0
+			order by owner_id, profit desc
+		)
+		select
+		    u.id,
+		    u.username,
+		    u.full_name,
+		    u.birthday,
+		    u.gender,
+		    u.city,
+		    coalesce(t.revenue, 0),
+		    coalesce(t.profit, 0),
+		    b.cost,
+		    coalesce(rs.count, 0),
+		    coalesce(rs.average_rating, 0)
+		from ppo.users u
+		left join totals t on t.owner_id = u.id
+		left join best b on b.owner_id = u.id
+		left join review_stats rs on rs.target_id = u.id`
+
+	queryElems, queryArgs := userFilterConditions(
+		filter,
+		[]any{period.StartYear, period.StartQuarter, period.EndYear, period.EndQuarter},
+	)
+	query += " where " + strings.Join(queryElems, " and ")
+	query += " order by u.full_name, u.id"
+
+	rows, err := r.db.Query(
+		ctx,
+		query,
+		queryArgs...,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("получение показателей предпринимателей для рейтинга: %w", err)
+	}
+
+	data = make([]*domain.UserRatingData, 0)
+	for rows.Next() {
+		tmp := new(User)
+		entry := new(domain.UserRatingData)
+
+		err = rows.Scan(
+			&tmp.ID,
+			&tmp.Username,
+			&tmp.FullName,
+			&tmp.Birthday,
+			&tmp.Gender,
+			&tmp.City,
+			&entry.Revenue,
+			&entry.Profit,
+			&entry.Cost,
+			&entry.Reviews.Count,
+			&entry.Reviews.AverageRating,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("сканирование полученных строк: %w", err)
+		}
+
+		entry.User = UserDbToUser(tmp)
+		data = append(data, entry)
+	}
+
+	return data, nil
+}
+
+// GetEntrepreneurIds возвращает идентификаторы всех предпринимателей, попадающих в рейтинг.
+func (r *UserRepository) GetEntrepreneurIds(ctx context.Context) (ids []uuid.UUID, err error) {
+	queryElems, queryArgs := userFilterConditions(&domain.UserFilter{}, nil)
+	query := "select u.id from ppo.users u where " + strings.Join(queryElems, " and ") + " order by u.full_name, u.id"
+
+	rows, err := r.db.Query(
+		ctx,
+		query,
+		queryArgs...,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("получение списка предпринимателей: %w", err)
+	}
+	defer rows.Close()
+
+	ids = make([]uuid.UUID, 0)
+	for rows.Next() {
+		var id uuid.UUID
+
+		err = rows.Scan(&id)
+		if err != nil {
+			return nil, fmt.Errorf("сканирование полученных строк: %w", err)
+		}
+
+		ids = append(ids, id)
+	}
+
+	return ids, nil
+}
+
+// GetMaxRevenue возвращает наибольшую среди совладельцев компаний выручку за период с учетом долей.
+func (r *UserRepository) GetMaxRevenue(ctx context.Context, period *domain.Period) (revenue decimal.Decimal, err error) {
+	query := `
+		select coalesce(max(revenue), 0)
+		from (
+			select sum(fr.revenue * co.share / 100) as revenue
+			from ppo.company_owners co
+			join ppo.fin_reports fr on fr.company_id = co.company_id
+				and (fr.year, fr.quarter) >= ($1, $2)
+				and (fr.year, fr.quarter) <= ($3, $4)
+			where co.accepted_at is not null
+			group by co.user_id
+		) owner_revenue`
+
+	err = r.db.QueryRow(
+		ctx,
+		query,
+		period.StartYear,
+		period.StartQuarter,
+		period.EndYear,
+		period.EndQuarter,
+	).Scan(&revenue)
+	if err != nil {
+		return decimal.Zero, fmt.Errorf("получение наибольшей выручки предпринимателя: %w", err)
+	}
+
+	return revenue, nil
+}
+
+// GetInfluence считает по каждой сфере деятельности компаний пользователя его выручку с учетом долей
+// и место среди совладельцев компаний этой сферы. Компания относится ко всем своим сферам деятельности.
+func (r *UserRepository) GetInfluence(ctx context.Context, userId uuid.UUID, period *domain.Period) (influence []*domain.ActivityFieldInfluence, err error) {
+	query := `
+		with company_fields as (
+			select id as company_id, activity_field_id from ppo.companies
+			union
+			select company_id, activity_field_id from ppo.company_activity_fields
+		),
+		company_revenue as (
+			select
+			    c.id as company_id,
+			    coalesce(sum(fr.revenue), 0) as revenue
+			from ppo.companies c
+			left join ppo.fin_reports fr on fr.company_id = c.id
+				and (fr.year, fr.quarter) >= ($2, $3)
+				and (fr.year, fr.quarter) <= ($4, $5)
+			group by c.id
+		),
+		field_totals as (
+			select
+			    cf.activity_field_id,
+			    sum(cr.revenue) as revenue
+			from company_fields cf
+			join company_revenue cr on cr.company_id = cf.company_id
+			group by cf.activity_field_id
+		),
+		owner_revenue as (
+			select
+			    cf.activity_field_id,
+			    co.user_id,
+			    sum(cr.revenue * co.share / 100) as revenue
+			from company_fields cf
+			join company_revenue cr on cr.company_id = cf.company_id
+			join ppo.company_owners co on co.company_id = cf.company_id
+			where co.accepted_at is not null
+			group by cf.activity_field_id, co.user_id
+		),
+		ranked as (
+			select
+			    activity_field_id,
+			    user_id,
+			    revenue,
+			    rank() over (partition by activity_field_id order by revenue desc) as rank,
+			    count(*) over (partition by activity_field_id) as participants
+			from owner_revenue
+		)
+		select
+		    af.id,
+		    af.parent_id,
+		    coalesce(af.code, ''),
+		    af.name,
+		    af.description,
+		    af.cost,
+		    r.revenue,
+		    ft.revenue,
+		    r.rank,
+		    r.participants
+		from ranked r
+		join field_totals ft on ft.activity_field_id = r.activity_field_id
+		join ppo.activity_fields af on af.id = r.activity_field_id
+		where r.user_id = $1
+		order by r.revenue desc, af.name`
+
+	rows, err := r.db.Query(
+		ctx,
+		query,
+		userId,
+		period.StartYear,
+		period.StartQuarter,
+		period.EndYear,
+		period.EndQuarter,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("получение влияния предпринимателя в сферах деятельности: %w", err)
+	}
+	defer rows.Close()
+
+	influence = make([]*domain.ActivityFieldInfluence, 0)
+	for rows.Next() {
+		field := new(domain.ActivityField)
+		entry := &domain.ActivityFieldInfluence{ActivityField: field}
+		var parentId uuid.NullUUID
+
+		err = rows.Scan(
+			&field.ID,
+			&parentId,
+			&field.Code,
+			&field.Name,
+			&field.Description,
+			&field.Cost,
+			&entry.Revenue,
+			&entry.TotalRevenue,
+			&entry.Rank,
+			&entry.Participants,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("сканирование полученных строк: %w", err)
+		}
+		field.ParentID = parentId.UUID
+
+		influence = append(influence, entry)
+	}
+
+	return influence, nil
+}
+
 func (r *UserRepository) Update(ctx context.Context, user *domain.User) (err error) {
 	queryArgs := make([]any, 0)
 	queryElems := make([]string, 0)
@@ -0,0 +1,171 @@
+package postgres
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"ppo/domain"
+	"testing"
+)
+
+// сферы деятельности из тестовых данных: field1 - основная у Company1 и дополнительная у Company3,
+// field2 - основная у Company2 и дополнительная у Company1 и Company3
+var (
+	testField1 = uuid.MustParse("f80426b8-27e7-4bfa-8721-23075f125165")
+	testField2 = uuid.MustParse("b9bacee6-3d2d-48f8-a7bc-493f44b0652a")
+)
+
+// навыки из тестовых данных: skill1 - у user1 и user2, skill3 - у user2 и user3
+var (
+	testSkill1 = uuid.MustParse("805d93a8-c66a-490d-a104-c561a40dc8b6")
+	testSkill3 = uuid.MustParse("5a665fb5-376f-4b70-8fd6-62d7d01f8738")
+)
+
+// весь период тестовых отчетов: user1 - 1.0 (Company1), user2 - 70% от 3.0 (Company2),
+// user3 - 30% от 3.0 (Company2) и 2.0 (Company3)
+var testReportsPeriod = &domain.Period{StartYear: 1, StartQuarter: 1, EndYear: 2, EndQuarter: 4}
+
+// testMaxAge отсекает пользователя, зарегистрированного в TestAuthRepository_Register:
+// у него нет компаний, а предприниматели из тестовых данных моложе
+const testMaxAge = 35
+
+func TestUserRepository_GetAll(t *testing.T) {
+	repo := NewUserRepository(testDbInstance)
+
+	testCases := []struct {
+		name             string
+		filter           *domain.UserFilter
+		expected         []string
+		expectedNumPages int
+	}{
+		{
+			name: "выручка совладельцев с учетом долей",
+			filter: &domain.UserFilter{
+				MaxAge: testMaxAge,
+				SortBy: domain.SortByRevenue,
+				Period: testReportsPeriod,
+			},
+			expected:         []string{"user3", "user2", "user1"},
+			expectedNumPages: 1,
+		},
+		{
+			name: "выручка за часть периода",
+			filter: &domain.UserFilter{
+				MaxAge: testMaxAge,
+				SortBy: domain.SortByRevenue,
+				Period: &domain.Period{StartYear: 1, StartQuarter: 1, EndYear: 1, EndQuarter: 4},
+			},
+			expected:         []string{"user2", "user1", "user3"},
+			expectedNumPages: 1,
+		},
+		{
+			name: "основная и дополнительная сфера деятельности",
+			filter: &domain.UserFilter{
+				ActivityFieldId: testField1,
+			},
+			expected:         []string{"user1", "user3"},
+			expectedNumPages: 1,
+		},
+		{
+			name: "совладелец компании сферы деятельности",
+			filter: &domain.UserFilter{
+				ActivityFieldId: testField2,
+			},
+			expected:         []string{"user1", "user2", "user3"},
+			expectedNumPages: 1,
+		},
+		// при сортировке по выручке параметры периода идут первыми, а в запрос количества
+		// не передаются: numPages проверяет, что условия фильтра получают те же значения
+		{
+			name: "город, пол, возраст и сфера деятельности с сортировкой по выручке",
+			filter: &domain.UserFilter{
+				City:            "Moscow",
+				Gender:          "m",
+				MinAge:          18,
+				MaxAge:          testMaxAge,
+				ActivityFieldId: testField1,
+				SortBy:          domain.SortByRevenue,
+				Period:          testReportsPeriod,
+			},
+			expected:         []string{"user3", "user1"},
+			expectedNumPages: 1,
+		},
+		{
+			name: "город, пол, возраст и сфера деятельности с сортировкой по выручке за часть периода",
+			filter: &domain.UserFilter{
+				City:            "Moscow",
+				Gender:          "m",
+				MinAge:          18,
+				MaxAge:          testMaxAge,
+				ActivityFieldId: testField1,
+				SortBy:          domain.SortByRevenue,
+				Period:          &domain.Period{StartYear: 1, StartQuarter: 1, EndYear: 1, EndQuarter: 4},
+			},
+			expected:         []string{"user1", "user3"},
+			expectedNumPages: 1,
+		},
+		{
+			name: "город, возраст и навык с сортировкой по имени",
+			filter: &domain.UserFilter{
+				City:    "Moscow",
+				MinAge:  18,
+				MaxAge:  testMaxAge,
+				SkillId: testSkill1,
+				SortBy:  domain.SortByName,
+			},
+			expected:         []string{"user1"},
+			expectedNumPages: 1,
+		},
+		{
+			name: "имя, пол, сфера деятельности и навык без сортировки",
+			filter: &domain.UserFilter{
+				Gender:          "m",
+				ActivityFieldId: testField2,
+				SkillId:         testSkill3,
+				Name:            "Third",
+			},
+			expected:         []string{"user3"},
+			expectedNumPages: 1,
+		},
+		{
+			name: "все условия фильтра с сортировкой по выручке",
+			filter: &domain.UserFilter{
+				City:            "Moscow",
+				Gender:          "m",
+				MinAge:          18,
+				MaxAge:          testMaxAge,
+				ActivityFieldId: testField2,
+				SkillId:         testSkill3,
+				Name:            "Third",
+				SortBy:          domain.SortByRevenue,
+				Period:          testReportsPeriod,
+			},
+			expected:         []string{"user3"},
+			expectedNumPages: 1,
+		},
+		{
+			name: "никто не подходит под фильтр при сортировке по выручке",
+			filter: &domain.UserFilter{
+				City:   "Voronezh",
+				Gender: "m",
+				SortBy: domain.SortByRevenue,
+				Period: testReportsPeriod,
+			},
+			expected:         []string{},
+			expectedNumPages: 0,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			users, numPages, err := repo.GetAll(context.Background(), tc.filter, 1)
+
+			require.Nil(t, err)
+			usernames := make([]string, len(users))
+			for i, user := range users {
+				usernames[i] = user.Username
+			}
+			require.Equal(t, tc.expected, usernames)
+			require.Equal(t, tc.expectedNumPages, numPages)
+		})
+	}
+}
@@ -8,19 +8,25 @@ import (
 	"net/http"
 	"os"
 	"path/filepath"
+	"ppo/domain"
 	"ppo/internal/app"
 	"ppo/internal/config"
 	loggerPackage "ppo/pkg/logger"
 	"ppo/web"
+	"time"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/chi/v5/middleware"
 	"github.com/go-chi/cors"
-	"github.com/go-chi/jwtauth/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
-var tokenAuth *jwtauth.JWTAuth
+// keyRotationCheckInterval - как часто проверяется необходимость ротации ключа подписи
+// и подхватываются ключи, созданные другими экземплярами.
+const keyRotationCheckInterval = time.Minute
+
+// ratingSnapshotCheckInterval - как часто проверяется, сохранены ли рейтинги за последний завершенный квартал
+const ratingSnapshotCheckInterval = time.Hour
 
 func newConn(ctx context.Context, cfg *config.Database) (pool *pgxpool.Pool, err error) {
 	connStr := fmt.Sprintf("%s://%s:%s@%s:%s/%s", cfg.Driver, cfg.User, cfg.Password,
@@ -63,8 +69,6 @@ func main() {
 		log.Fatalln("cоздание логгера:", err)
 	}
 
-	tokenAuth = jwtauth.New("HS256", []byte(cfg.Server.JwtKey), nil)
-
 	pool, err := newConn(context.Background(), &cfg.Database)
 	if err != nil {
 		logger.Fatalf(err.Error())
@@ -72,40 +76,90 @@ func main() {
 
 	a := app.NewApp(pool, cfg, logger)
 
+	err = a.Keys.Rotate(context.Background())
+	if err != nil {
+		logger.Fatalf("подготовка ключей подписи: %v", err)
+	}
+	go a.Keys.Run(context.Background(), keyRotationCheckInterval)
+	go a.Snapshotter.Run(context.Background(), ratingSnapshotCheckInterval)
+
 	mux := chi.NewMux()
 
 	mux.Use(cors.Handler(cors.Options{
-		AllowedOrigins:   []string{"https://*", "http://*"},
+		AllowedOrigins:   cfg.Server.Cors.AllowedOrigins,
 		AllowedMethods:   []string{"GET", "POST", "PUT", "DELETE", "OPTIONS", "PATCH"},
 		AllowedHeaders:   []string{"Accept", "Authorization", "Content-Type", "X-CSRF-Token"},
 		ExposedHeaders:   []string{"Link"},
-		AllowCredentials: false,
+		AllowCredentials: true,
 		MaxAge:           300, // Maximum value not ignored by any of major browsers
 	}))
 
 	mux.Use(middleware.Logger)
 
+	mux.Get("/.well-known/jwks.json", web.JWKSHandler(a))
+
 	mux.Route("/api/v1", func(rOuter chi.Router) {
 		rOuter.Route("/entrepreneurs", func(r chi.Router) {
 			r.Get("/{id}", web.GetEntrepreneur(a))
 			r.Get("/", web.ListEntrepreneurs(a))
+			r.Get("/ranking", web.ListEntrepreneursRanking(a))
 			r.Get("/{id}/rating", web.CalculateRating(a))
+			r.Get("/{id}/rating/breakdown", web.GetRatingBreakdown(a))
+			r.Get("/{id}/rating/history", web.GetRatingHistory(a))
+			r.Get("/{id}/influence", web.GetEntrepreneurInfluence(a))
+			r.Get("/{id}/reviews", web.ListEntrepreneurReviews(a))
 
 			r.Group(func(r chi.Router) {
-				r.Use(jwtauth.Verifier(tokenAuth))
-				r.Use(jwtauth.Authenticator(tokenAuth))
-				r.Use(web.ValidateAdminRoleJWT)
+				r.Use(web.Verifier(a))
+				r.Use(web.Authenticator(a))
+				r.Use(web.RequirePermission(a, domain.PermManageUsers))
 
 				r.Patch("/{id}", web.UpdateEntrepreneur(a))
 				r.Delete("/{id}", web.DeleteEntrepreneur(a))
 			})
+
+			r.Group(func(r chi.Router) {
+				r.Use(web.Verifier(a))
+				r.Use(web.Authenticator(a))
+				r.Use(web.RequirePermission(a, domain.PermManageRoles))
+
+				r.Patch("/{id}/role", web.AssignEntrepreneurRole(a))
+			})
+
+			r.Group(func(r chi.Router) {
+				r.Use(web.Verifier(a))
+				r.Use(web.Authenticator(a))
+				r.Use(web.RequirePermission(a, domain.PermManageBusiness))
+
+				r.Post("/skills", web.AddEntrepreneurSkill(a))
+				r.Delete("/skills/{id}", web.DeleteEntrepreneurSkill(a))
+
+				r.Post("/{id}/reviews", web.CreateReview(a))
+				r.Patch("/{id}/reviews/{reviewId}", web.UpdateReview(a))
+				r.Delete("/{id}/reviews/{reviewId}", web.DeleteReview(a))
+			})
+		})
+
+		rOuter.Route("/skills", func(r chi.Router) {
+			r.Get("/{id}", web.GetSkill(a))
+			r.Get("/", web.ListSkills(a))
+
+			r.Group(func(r chi.Router) {
+				r.Use(web.Verifier(a))
+				r.Use(web.Authenticator(a))
+				r.Use(web.RequirePermission(a, domain.PermManageSkills))
+
+				r.Post("/", web.CreateSkill(a))
+				r.Patch("/{id}", web.UpdateSkill(a))
+				r.Delete("/{id}", web.DeleteSkill(a))
+			})
 		})
 
 		rOuter.Route("/contacts", func(r chi.Router) {
 			r.Group(func(r chi.Router) {
-				r.Use(jwtauth.Verifier(tokenAuth))
-				r.Use(jwtauth.Authenticator(tokenAuth))
-				r.Use(web.ValidateUserRoleJWT)
+				r.Use(web.Verifier(a, domain.ScopeContacts))
+				r.Use(web.Authenticator(a))
+				r.Use(web.RequirePermission(a, domain.PermManageBusiness))
 
 				r.Get("/", web.ListEntrepreneurContacts(a))
 				r.Post("/", web.CreateContact(a))
@@ -118,11 +172,14 @@ func main() {
 		rOuter.Route("/activity_fields", func(r chi.Router) {
 			r.Get("/{id}", web.GetActivityField(a))
 			r.Get("/", web.ListActivityFields(a))
+			r.Get("/tree", web.GetActivityFieldTree(a))
+			r.Get("/{id}/tree", web.GetActivityFieldTree(a))
+			r.Get("/{id}/companies", web.ListActivityFieldCompanies(a))
 
 			r.Group(func(r chi.Router) {
-				r.Use(jwtauth.Verifier(tokenAuth))
-				r.Use(jwtauth.Authenticator(tokenAuth))
-				r.Use(web.ValidateAdminRoleJWT)
+				r.Use(web.Verifier(a))
+				r.Use(web.Authenticator(a))
+				r.Use(web.RequirePermission(a, domain.PermManageActivityFields))
 
 				r.Post("/", web.CreateActivityField(a))
 				r.Patch("/{id}", web.UpdateActivityField(a))
@@ -130,24 +187,47 @@ func main() {
 			})
 		})
 
+		rOuter.Route("/tax_schedules", func(r chi.Router) {
+			r.Group(func(r chi.Router) {
+				r.Use(web.Verifier(a))
+				r.Use(web.Authenticator(a))
+				r.Use(web.RequirePermission(a, domain.PermManageTaxSchedules))
+
+				r.Get("/", web.ListTaxSchedules(a))
+				r.Get("/{id}", web.GetTaxSchedule(a))
+				r.Post("/", web.CreateTaxSchedule(a))
+				r.Patch("/{id}", web.UpdateTaxSchedule(a))
+				r.Delete("/{id}", web.DeleteTaxSchedule(a))
+			})
+		})
+
 		rOuter.Route("/companies", func(r chi.Router) {
 			r.Get("/{id}", web.GetCompany(a))
+			r.Get("/by-inn/{inn}", web.GetCompanyByINN(a))
 			r.Get("/", web.ListEntrepreneurCompanies(a))
+			r.Get("/{id}/owners", web.ListCompanyOwners(a))
+			r.Get("/{id}/transfers", web.ListCompanyTransfers(a))
 
 			r.Group(func(r chi.Router) {
-				r.Use(jwtauth.Verifier(tokenAuth))
-				r.Use(jwtauth.Authenticator(tokenAuth))
-				r.Use(web.ValidateUserRoleJWT)
+				r.Use(web.Verifier(a, domain.ScopeCompanies))
+				r.Use(web.Authenticator(a))
+				r.Use(web.RequirePermission(a, domain.PermManageBusiness))
 
 				r.Post("/", web.CreateCompany(a))
 				r.Patch("/{id}", web.UpdateCompany(a))
 				r.Delete("/{id}", web.DeleteCompany(a))
+
+				r.Post("/{id}/owners", web.InviteCompanyOwner(a))
+				r.Post("/{id}/owners/accept", web.AcceptCompanyOwnership(a))
+				r.Patch("/{id}/owners/{userId}", web.UpdateCompanyOwnerShare(a))
+
+				r.Post("/{id}/transfers", web.InitiateCompanyTransfer(a))
 			})
 
 			r.Route("/{id}/financials", func(r chi.Router) {
-				r.Use(jwtauth.Verifier(tokenAuth))
-				r.Use(jwtauth.Authenticator(tokenAuth))
-				r.Use(web.ValidateUserRoleJWT)
+				r.Use(web.Verifier(a, domain.ScopeFinancials))
+				r.Use(web.Authenticator(a))
+				r.Use(web.RequirePermission(a, domain.PermManageBusiness))
 
 				r.Post("/", web.CreateReport(a))
 				r.Get("/", web.ListCompanyReports(a))
@@ -156,9 +236,9 @@ func main() {
 
 		rOuter.Route("/financials", func(r chi.Router) {
 			r.Group(func(r chi.Router) {
-				r.Use(jwtauth.Verifier(tokenAuth))
-				r.Use(jwtauth.Authenticator(tokenAuth))
-				r.Use(web.ValidateUserRoleJWT)
+				r.Use(web.Verifier(a, domain.ScopeFinancials))
+				r.Use(web.Authenticator(a))
+				r.Use(web.RequirePermission(a, domain.PermManageBusiness))
 
 				r.Get("/", web.GetEntrepreneurFinancials(a))
 				r.Delete("/{id}", web.DeleteFinReport(a))
@@ -166,8 +246,54 @@ func main() {
 			})
 		})
 
+		rOuter.Route("/transfers", func(r chi.Router) {
+			r.Use(web.Verifier(a, domain.ScopeCompanies))
+			r.Use(web.Authenticator(a))
+			r.Use(web.RequirePermission(a, domain.PermManageBusiness))
+
+			r.Get("/", web.ListIncomingTransfers(a))
+			r.Post("/{id}/accept", web.AcceptCompanyTransfer(a))
+			r.Post("/{id}/decline", web.DeclineCompanyTransfer(a))
+		})
+
+		rOuter.Route("/reports", func(r chi.Router) {
+			r.Use(web.Verifier(a, domain.ScopeReports))
+			r.Use(web.Authenticator(a))
+
+			r.With(web.RequirePermission(a, domain.PermViewAllReports)).Get("/", web.ListReports(a))
+			r.With(web.RequirePermission(a, domain.PermVerifyReports)).Post("/{id}/verify", web.VerifyReport(a))
+		})
+
+		rOuter.Route("/roles", func(r chi.Router) {
+			r.Use(web.Verifier(a))
+			r.Use(web.Authenticator(a))
+			r.Use(web.RequirePermission(a, domain.PermManageRoles))
+
+			r.Get("/", web.ListRoles(a))
+		})
+
 		rOuter.Post("/login", web.LoginHandler(a))
+		rOuter.Post("/login/mfa", web.VerifyMfaHandler(a))
 		rOuter.Post("/signup", web.RegisterHandler(a))
+		rOuter.Post("/refresh", web.RefreshHandler(a))
+		rOuter.Post("/password/reset-request", web.RequestPasswordResetHandler(a))
+		rOuter.Post("/password/reset", web.ResetPasswordHandler(a))
+
+		rOuter.Group(func(r chi.Router) {
+			r.Use(web.Verifier(a))
+			r.Use(web.Authenticator(a))
+
+			r.Post("/logout", web.LogoutHandler(a))
+			r.Patch("/password", web.ChangePasswordHandler(a))
+			r.Post("/profile", web.CompleteProfileHandler(a))
+			r.Post("/mfa/enroll", web.EnrollMfaHandler(a))
+			r.Post("/mfa/confirm", web.ConfirmMfaHandler(a))
+			r.Delete("/mfa", web.DisableMfaHandler(a))
+
+			r.Get("/api_keys", web.ListApiKeys(a))
+			r.Post("/api_keys", web.CreateApiKey(a))
+			r.Delete("/api_keys/{id}", web.RevokeApiKey(a))
+		})
 	})
 
 	go func() {
@@ -0,0 +1 @@
+alter table ppo.reviews drop constraint u_reviewer_target;
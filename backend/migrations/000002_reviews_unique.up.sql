@@ -0,0 +1 @@
+alter table ppo.reviews add constraint u_reviewer_target unique (reviewer_id, target_id);
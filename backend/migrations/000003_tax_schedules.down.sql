@@ -0,0 +1,2 @@
+drop table ppo.tax_brackets;
+drop table ppo.tax_schedules;
@@ -0,0 +1,29 @@
+create table if not exists ppo.tax_schedules(
+    id uuid primary key default gen_random_uuid(),
+    year int not null,
+    regime varchar(64) not null
+);
+
+create table if not exists ppo.tax_brackets(
+    schedule_id uuid not null,
+    upper_bound float4,
+    rate float4 not null
+);
+
+alter table ppo.tax_schedules add constraint u_year_regime unique (year, regime);
+alter table ppo.tax_schedules add constraint chk_year check ( year > 0 );
+
+alter table ppo.tax_brackets add constraint fk_schedule foreign key (schedule_id) references ppo.tax_schedules(id) on delete cascade;
+alter table ppo.tax_brackets add constraint chk_upper_bound check ( upper_bound > 0.0 );
+alter table ppo.tax_brackets add constraint chk_rate check ( rate >= 0.0 and rate <= 100.0 );
+
+with schedule as (
+    insert into ppo.tax_schedules(year, regime)
+    values (1, 'general')
+    returning id
+)
+insert into ppo.tax_brackets(schedule_id, upper_bound, rate)
+select schedule.id, brackets.upper_bound, brackets.rate
+from schedule,
+     (values (10000000, 4), (50000000, 7), (150000000, 13), (500000000, 20), (null, 30))
+         as brackets(upper_bound, rate);
@@ -0,0 +1,10 @@
+alter table ppo.tax_brackets
+    alter column upper_bound type float4 using upper_bound::float4,
+    alter column rate type float4 using rate::float4;
+
+alter table ppo.activity_fields
+    alter column cost type float4 using cost::float4;
+
+alter table ppo.fin_reports
+    alter column revenue type float4 using revenue::float4,
+    alter column costs type float4 using costs::float4;
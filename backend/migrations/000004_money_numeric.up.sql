@@ -0,0 +1,10 @@
+alter table ppo.fin_reports
+    alter column revenue type numeric(20, 2) using revenue::numeric(20, 2),
+    alter column costs type numeric(20, 2) using costs::numeric(20, 2);
+
+alter table ppo.activity_fields
+    alter column cost type numeric(10, 4) using cost::numeric(10, 4);
+
+alter table ppo.tax_brackets
+    alter column upper_bound type numeric(20, 2) using upper_bound::numeric(20, 2),
+    alter column rate type numeric(5, 2) using rate::numeric(5, 2);
@@ -0,0 +1,2 @@
+drop index if exists ppo.idx_companies_owner_id;
+drop index if exists ppo.idx_users_full_name_fts;
@@ -0,0 +1,4 @@
+create index if not exists idx_users_full_name_fts
+    on ppo.users using gin (to_tsvector('simple', coalesce(full_name, '')));
+
+create index if not exists idx_companies_owner_id on ppo.companies(owner_id);
@@ -0,0 +1,2 @@
+drop table ppo.refresh_tokens;
+drop table ppo.sessions;
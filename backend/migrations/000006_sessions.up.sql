@@ -0,0 +1,19 @@
+create table if not exists ppo.sessions(
+    id uuid primary key default gen_random_uuid(),
+    user_id uuid not null,
+    created_at timestamptz not null default now(),
+    revoked_at timestamptz
+);
+
+create table if not exists ppo.refresh_tokens(
+    id uuid primary key default gen_random_uuid(),
+    session_id uuid not null,
+    token_hash varchar(64) not null,
+    expires_at timestamptz not null,
+    used_at timestamptz
+);
+
+alter table ppo.sessions add constraint fk_user foreign key (user_id) references ppo.users(id) on delete cascade;
+
+alter table ppo.refresh_tokens add constraint fk_session foreign key (session_id) references ppo.sessions(id) on delete cascade;
+alter table ppo.refresh_tokens add constraint u_token_hash unique (token_hash);
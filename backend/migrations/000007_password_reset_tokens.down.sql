@@ -0,0 +1 @@
+drop table ppo.password_reset_tokens;
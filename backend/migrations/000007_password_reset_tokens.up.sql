@@ -0,0 +1,10 @@
+create table if not exists ppo.password_reset_tokens(
+    id uuid primary key default gen_random_uuid(),
+    user_id uuid not null,
+    token_hash varchar(64) not null,
+    expires_at timestamptz not null,
+    used_at timestamptz
+);
+
+alter table ppo.password_reset_tokens add constraint fk_user foreign key (user_id) references ppo.users(id) on delete cascade;
+alter table ppo.password_reset_tokens add constraint u_token_hash unique (token_hash);
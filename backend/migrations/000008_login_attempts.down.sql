@@ -0,0 +1 @@
+drop table ppo.login_attempts;
@@ -0,0 +1,6 @@
+create table if not exists ppo.login_attempts(
+    key varchar(320) primary key,
+    failures int not null default 0,
+    last_failure_at timestamptz not null default now(),
+    locked_until timestamptz
+);
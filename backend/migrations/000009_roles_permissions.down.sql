@@ -0,0 +1,10 @@
+alter table ppo.fin_reports drop column if exists verified_at;
+alter table ppo.fin_reports drop column if exists verified_by;
+
+alter table ppo.users drop constraint if exists fk_role;
+alter table ppo.users alter column role drop not null;
+alter table ppo.users alter column role drop default;
+
+drop table if exists ppo.role_permissions;
+drop table if exists ppo.permissions;
+drop table if exists ppo.roles;
@@ -0,0 +1,49 @@
+create table if not exists ppo.roles(
+    name varchar(32) primary key,
+    description text not null
+);
+
+create table if not exists ppo.permissions(
+    name varchar(64) primary key,
+    description text not null
+);
+
+create table if not exists ppo.role_permissions(
+    role varchar(32) not null references ppo.roles(name) on delete cascade,
+    permission varchar(64) not null references ppo.permissions(name) on delete cascade,
+    primary key (role, permission)
+);
+
+insert into ppo.roles(name, description)
+values ('admin', 'Администратор'),
+       ('user', 'Предприниматель'),
+       ('accountant', 'Бухгалтер: просмотр и проверка финансовых отчетов всех компаний'),
+       ('editor', 'Редактор справочников навыков и сфер деятельности');
+
+insert into ppo.permissions(name, description)
+values ('business.manage', 'Ведение собственных компаний, отчетов, контактов, навыков и отзывов'),
+       ('users.manage', 'Изменение и удаление предпринимателей'),
+       ('roles.manage', 'Назначение ролей'),
+       ('skills.manage', 'Ведение справочника навыков'),
+       ('activity_fields.manage', 'Ведение справочника сфер деятельности'),
+       ('tax_schedules.manage', 'Ведение налоговых шкал'),
+       ('reports.view_all', 'Просмотр финансовых отчетов всех компаний'),
+       ('reports.verify', 'Проверка финансовых отчетов');
+
+insert into ppo.role_permissions(role, permission)
+select 'admin', name from ppo.permissions;
+
+insert into ppo.role_permissions(role, permission)
+values ('user', 'business.manage'),
+       ('accountant', 'reports.view_all'),
+       ('accountant', 'reports.verify'),
+       ('editor', 'skills.manage'),
+       ('editor', 'activity_fields.manage');
+
+update ppo.users set role = 'user' where role is null;
+alter table ppo.users alter column role set default 'user';
+alter table ppo.users alter column role set not null;
+alter table ppo.users add constraint fk_role foreign key (role) references ppo.roles(name);
+
+alter table ppo.fin_reports add column verified_by uuid references ppo.users(id) on delete set null;
+alter table ppo.fin_reports add column verified_at timestamptz;
@@ -0,0 +1 @@
+drop table if exists ppo.signing_keys;
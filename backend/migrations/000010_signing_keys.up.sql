@@ -0,0 +1,10 @@
+create table if not exists ppo.signing_keys(
+    id varchar(64) primary key,
+    algorithm varchar(16) not null,
+    private_key bytea not null,
+    created_at timestamptz not null default now(),
+    retired_at timestamptz,
+    expires_at timestamptz
+);
+
+create index if not exists idx_signing_keys_expires_at on ppo.signing_keys(expires_at);
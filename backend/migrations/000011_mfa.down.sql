@@ -0,0 +1,2 @@
+drop table if exists ppo.mfa_recovery_codes;
+drop table if exists ppo.user_mfa;
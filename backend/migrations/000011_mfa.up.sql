@@ -0,0 +1,16 @@
+create table if not exists ppo.user_mfa(
+    user_id uuid primary key references ppo.users(id) on delete cascade,
+    secret varchar(64) not null,
+    confirmed_at timestamptz,
+    -- шаг TOTP последнего принятого кода: повторно тот же код не принимается
+    last_used_step bigint
+);
+
+create table if not exists ppo.mfa_recovery_codes(
+    id uuid primary key default gen_random_uuid(),
+    user_id uuid not null references ppo.users(id) on delete cascade,
+    hash varchar(64) not null,
+    used_at timestamptz
+);
+
+create index if not exists idx_mfa_recovery_codes_user_id on ppo.mfa_recovery_codes(user_id);
@@ -0,0 +1,17 @@
+create table if not exists ppo.api_keys(
+    id uuid primary key default gen_random_uuid(),
+    user_id uuid not null,
+    name varchar(128) not null,
+    prefix varchar(16) not null,
+    key_hash varchar(64) not null,
+    scopes text[] not null,
+    expires_at timestamptz,
+    created_at timestamptz not null default now(),
+    last_used_at timestamptz,
+    revoked_at timestamptz
+);
+
+alter table ppo.api_keys add constraint fk_user foreign key (user_id) references ppo.users(id) on delete cascade;
+alter table ppo.api_keys add constraint u_key_hash unique (key_hash);
+
+create index if not exists idx_api_keys_user_id on ppo.api_keys(user_id);
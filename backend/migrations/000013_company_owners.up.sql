@@ -0,0 +1,20 @@
+create table if not exists ppo.company_owners(
+    company_id uuid not null,
+    user_id uuid not null,
+    share numeric(5, 2) not null,
+    invited_by uuid,
+    accepted_at timestamptz,
+    primary key (company_id, user_id)
+);
+
+alter table ppo.company_owners add constraint fk_company foreign key (company_id) references ppo.companies(id) on delete cascade;
+alter table ppo.company_owners add constraint fk_user foreign key (user_id) references ppo.users(id) on delete cascade;
+alter table ppo.company_owners add constraint fk_invited_by foreign key (invited_by) references ppo.users(id) on delete set null;
+alter table ppo.company_owners add constraint ch_share check (share > 0 and share <= 100);
+
+create index if not exists idx_company_owners_user_id on ppo.company_owners(user_id);
+
+-- до появления совладельцев основной владелец владел компанией целиком
+insert into ppo.company_owners(company_id, user_id, share, accepted_at)
+select id, owner_id, 100, now() from ppo.companies
+on conflict do nothing;
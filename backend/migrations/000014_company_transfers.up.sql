@@ -0,0 +1,19 @@
+create table if not exists ppo.company_transfers(
+    id uuid primary key default gen_random_uuid(),
+    company_id uuid not null,
+    from_user_id uuid not null,
+    to_user_id uuid not null,
+    status varchar(16) not null default 'pending',
+    created_at timestamptz not null default now(),
+    expires_at timestamptz not null,
+    resolved_at timestamptz
+);
+
+alter table ppo.company_transfers add constraint fk_company foreign key (company_id) references ppo.companies(id) on delete cascade;
+alter table ppo.company_transfers add constraint fk_from_user foreign key (from_user_id) references ppo.users(id) on delete cascade;
+alter table ppo.company_transfers add constraint fk_to_user foreign key (to_user_id) references ppo.users(id) on delete cascade;
+alter table ppo.company_transfers add constraint ch_status check (status in ('pending', 'accepted', 'declined', 'expired'));
+
+-- у компании может быть только одна незавершенная передача
+create unique index if not exists u_company_pending_transfer on ppo.company_transfers(company_id) where status = 'pending';
+create index if not exists idx_company_transfers_to_user_id on ppo.company_transfers(to_user_id);
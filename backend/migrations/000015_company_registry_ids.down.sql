@@ -0,0 +1,9 @@
+alter table ppo.companies drop constraint if exists ch_companies_kpp;
+alter table ppo.companies drop constraint if exists ch_companies_ogrn;
+alter table ppo.companies drop constraint if exists ch_companies_inn;
+alter table ppo.companies drop constraint if exists u_companies_ogrn;
+alter table ppo.companies drop constraint if exists u_companies_inn;
+
+alter table ppo.companies drop column if exists kpp;
+alter table ppo.companies drop column if exists ogrn;
+alter table ppo.companies drop column if exists inn;
@@ -0,0 +1,10 @@
+-- у компаний, добавленных до появления реквизитов, они остаются пустыми до обновления
+alter table ppo.companies add column if not exists inn varchar(12);
+alter table ppo.companies add column if not exists ogrn varchar(15);
+alter table ppo.companies add column if not exists kpp varchar(9);
+
+alter table ppo.companies add constraint u_companies_inn unique (inn);
+alter table ppo.companies add constraint u_companies_ogrn unique (ogrn);
+alter table ppo.companies add constraint ch_companies_inn check (inn ~ '^([0-9]{10}|[0-9]{12})$');
+alter table ppo.companies add constraint ch_companies_ogrn check (ogrn ~ '^([0-9]{13}|[0-9]{15})$');
+alter table ppo.companies add constraint ch_companies_kpp check (kpp ~ '^[0-9]{4}[0-9A-Z]{2}[0-9]{3}$');
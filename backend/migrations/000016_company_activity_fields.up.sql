@@ -0,0 +1,11 @@
+-- дополнительные сферы деятельности компании; основная по-прежнему хранится в companies.activity_field_id
+create table if not exists ppo.company_activity_fields(
+    company_id uuid not null,
+    activity_field_id uuid not null,
+    primary key (company_id, activity_field_id)
+);
+
+alter table ppo.company_activity_fields add constraint fk_company foreign key (company_id) references ppo.companies(id) on delete cascade;
+alter table ppo.company_activity_fields add constraint fk_activity_field foreign key (activity_field_id) references ppo.activity_fields(id) on delete cascade;
+
+create index if not exists idx_company_activity_fields_activity_field_id on ppo.company_activity_fields(activity_field_id);
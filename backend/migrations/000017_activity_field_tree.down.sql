@@ -0,0 +1,8 @@
+drop index if exists ppo.idx_activity_fields_parent_id;
+
+alter table ppo.activity_fields drop constraint if exists u_activity_fields_code;
+alter table ppo.activity_fields drop constraint if exists fk_parent;
+
+alter table ppo.activity_fields alter column name type varchar(128) using left(name, 128);
+alter table ppo.activity_fields drop column if exists code;
+alter table ppo.activity_fields drop column if exists parent_id;
@@ -0,0 +1,9 @@
+alter table ppo.activity_fields add column if not exists parent_id uuid;
+alter table ppo.activity_fields add column if not exists code varchar(16);
+-- наименования в ОКВЭД длиннее прежнего ограничения
+alter table ppo.activity_fields alter column name type varchar(512);
+
+alter table ppo.activity_fields add constraint fk_parent foreign key (parent_id) references ppo.activity_fields(id);
+alter table ppo.activity_fields add constraint u_activity_fields_code unique (code);
+
+create index if not exists idx_activity_fields_parent_id on ppo.activity_fields(parent_id);
@@ -0,0 +1,23 @@
+-- рейтинг предпринимателя по итогам квартала, вычисленный за четыре квартала, последний из которых - (year, quarter)
+create table if not exists ppo.rating_snapshots(
+    user_id uuid not null,
+    year int not null,
+    quarter int not null,
+    company_id uuid,
+    share numeric(5, 2) not null default 0,
+    cost numeric(10, 4) not null default 0,
+    max_cost numeric(10, 4) not null default 0,
+    revenue numeric(20, 2) not null default 0,
+    profit numeric(20, 2) not null default 0,
+    cost_contribution real not null default 0,
+    profitability_contribution real not null default 0,
+    rating real not null default 0,
+    created_at timestamptz not null default now(),
+    primary key (user_id, year, quarter)
+);
+
+alter table ppo.rating_snapshots add constraint fk_user foreign key (user_id) references ppo.users(id) on delete cascade;
+alter table ppo.rating_snapshots add constraint fk_company foreign key (company_id) references ppo.companies(id) on delete set null;
+alter table ppo.rating_snapshots add constraint ch_quarter check (quarter between 1 and 4);
+
+create index if not exists idx_rating_snapshots_year_quarter on ppo.rating_snapshots(year, quarter);
@@ -0,0 +1 @@
+alter table ppo.rating_snapshots drop column if exists strategy;
@@ -0,0 +1,2 @@
+-- стратегия, которой вычислен сохраненный рейтинг; до появления стратегий формула была одна
+alter table ppo.rating_snapshots add column if not exists strategy varchar(32) not null default 'current';
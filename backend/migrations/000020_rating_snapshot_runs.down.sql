@@ -0,0 +1 @@
+drop table if exists ppo.rating_snapshot_runs;
@@ -0,0 +1,9 @@
+-- кварталы, за которые рейтинги сохранены для всех предпринимателей; квартал без отметки сохраняется повторно
+create table if not exists ppo.rating_snapshot_runs(
+    year int not null,
+    quarter int not null,
+    completed_at timestamptz not null default now(),
+    primary key (year, quarter)
+);
+
+alter table ppo.rating_snapshot_runs add constraint ch_quarter check (quarter between 1 and 4);
@@ -0,0 +1,19 @@
+-- за квартал остается последний сохраненный рейтинг предпринимателя
+delete from ppo.rating_snapshots
+where ctid not in (
+    select distinct on (user_id, year, quarter) ctid
+    from ppo.rating_snapshots
+    order by user_id, year, quarter, created_at desc
+);
+alter table ppo.rating_snapshots drop constraint if exists rating_snapshots_pkey;
+alter table ppo.rating_snapshots add primary key (user_id, year, quarter);
+
+delete from ppo.rating_snapshot_runs
+where ctid not in (
+    select distinct on (year, quarter) ctid
+    from ppo.rating_snapshot_runs
+    order by year, quarter, completed_at desc
+);
+alter table ppo.rating_snapshot_runs drop constraint if exists rating_snapshot_runs_pkey;
+alter table ppo.rating_snapshot_runs add primary key (year, quarter);
+alter table ppo.rating_snapshot_runs drop column if exists strategy;
@@ -0,0 +1,7 @@
+-- рейтинги за квартал, вычисленные разными стратегиями, хранятся независимо друг от друга
+alter table ppo.rating_snapshots drop constraint if exists rating_snapshots_pkey;
+alter table ppo.rating_snapshots add primary key (user_id, year, quarter, strategy);
+
+alter table ppo.rating_snapshot_runs add column if not exists strategy varchar(32) not null default 'current';
+alter table ppo.rating_snapshot_runs drop constraint if exists rating_snapshot_runs_pkey;
+alter table ppo.rating_snapshot_runs add primary key (year, quarter, strategy);
@@ -0,0 +1,3 @@
+drop index if exists ppo.idx_reviews_target_created_at;
+
+alter table ppo.reviews drop column if exists created_at;
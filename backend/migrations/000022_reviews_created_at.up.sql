@@ -0,0 +1,4 @@
+-- отзывы, оставленные до появления колонки, получают время миграции и упорядочиваются по id
+alter table ppo.reviews add column if not exists created_at timestamptz not null default now();
+
+create index if not exists idx_reviews_target_created_at on ppo.reviews(target_id, created_at desc, id);
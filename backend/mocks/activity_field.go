@@ -15,6 +15,7 @@ import (
 	reflect "reflect"
 
 	uuid "github.com/google/uuid"
+	decimal "github.com/shopspring/decimal"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -101,10 +102,10 @@ func (mr *MockIActivityFieldRepositoryMockRecorder) GetById(arg0, arg1 any) *gom
 }
 
 // GetMaxCost mocks base method.
-func (m *MockIActivityFieldRepository) GetMaxCost(arg0 context.Context) (float32, error) {
+func (m *MockIActivityFieldRepository) GetMaxCost(arg0 context.Context) (decimal.Decimal, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetMaxCost", arg0)
-	ret0, _ := ret[0].(float32)
+	ret0, _ := ret[0].(decimal.Decimal)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -115,6 +116,21 @@ func (mr *MockIActivityFieldRepositoryMockRecorder) GetMaxCost(arg0 any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMaxCost", reflect.TypeOf((*MockIActivityFieldRepository)(nil).GetMaxCost), arg0)
 }
 
+// GetSubtreeIds mocks base method.
+func (m *MockIActivityFieldRepository) GetSubtreeIds(arg0 context.Context, arg1 uuid.UUID) ([]uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSubtreeIds", arg0, arg1)
+	ret0, _ := ret[0].([]uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSubtreeIds indicates an expected call of GetSubtreeIds.
+func (mr *MockIActivityFieldRepositoryMockRecorder) GetSubtreeIds(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubtreeIds", reflect.TypeOf((*MockIActivityFieldRepository)(nil).GetSubtreeIds), arg0, arg1)
+}
+
 // Update mocks base method.
 func (m *MockIActivityFieldRepository) Update(arg0 context.Context, arg1 *domain.ActivityField) error {
 	m.ctrl.T.Helper()
@@ -129,6 +145,20 @@ func (mr *MockIActivityFieldRepositoryMockRecorder) Update(arg0, arg1 any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockIActivityFieldRepository)(nil).Update), arg0, arg1)
 }
 
+// UpsertByCode mocks base method.
+func (m *MockIActivityFieldRepository) UpsertByCode(arg0 context.Context, arg1 *domain.ActivityField) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertByCode", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertByCode indicates an expected call of UpsertByCode.
+func (mr *MockIActivityFieldRepositoryMockRecorder) UpsertByCode(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertByCode", reflect.TypeOf((*MockIActivityFieldRepository)(nil).UpsertByCode), arg0, arg1)
+}
+
 // MockIActivityFieldService is a mock of IActivityFieldService interface.
 type MockIActivityFieldService struct {
 	ctrl     *gomock.Controller
@@ -212,10 +242,10 @@ func (mr *MockIActivityFieldServiceMockRecorder) GetById(arg0, arg1 any) *gomock
 }
 
 // GetCostByCompanyId mocks base method.
-func (m *MockIActivityFieldService) GetCostByCompanyId(arg0 context.Context, arg1 uuid.UUID) (float32, error) {
+func (m *MockIActivityFieldService) GetCostByCompanyId(arg0 context.Context, arg1 uuid.UUID) (decimal.Decimal, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetCostByCompanyId", arg0, arg1)
-	ret0, _ := ret[0].(float32)
+	ret0, _ := ret[0].(decimal.Decimal)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -227,10 +257,10 @@ func (mr *MockIActivityFieldServiceMockRecorder) GetCostByCompanyId(arg0, arg1 a
 }
 
 // GetMaxCost mocks base method.
-func (m *MockIActivityFieldService) GetMaxCost(arg0 context.Context) (float32, error) {
+func (m *MockIActivityFieldService) GetMaxCost(arg0 context.Context) (decimal.Decimal, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetMaxCost", arg0)
-	ret0, _ := ret[0].(float32)
+	ret0, _ := ret[0].(decimal.Decimal)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -241,6 +271,21 @@ func (mr *MockIActivityFieldServiceMockRecorder) GetMaxCost(arg0 any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMaxCost", reflect.TypeOf((*MockIActivityFieldService)(nil).GetMaxCost), arg0)
 }
 
+// GetTree mocks base method.
+func (m *MockIActivityFieldService) GetTree(arg0 context.Context, arg1 uuid.UUID) ([]*domain.ActivityFieldNode, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTree", arg0, arg1)
+	ret0, _ := ret[0].([]*domain.ActivityFieldNode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTree indicates an expected call of GetTree.
+func (mr *MockIActivityFieldServiceMockRecorder) GetTree(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTree", reflect.TypeOf((*MockIActivityFieldService)(nil).GetTree), arg0, arg1)
+}
+
 // Update mocks base method.
 func (m *MockIActivityFieldService) Update(arg0 context.Context, arg1 *domain.ActivityField) error {
 	m.ctrl.T.Helper()
@@ -254,3 +299,17 @@ func (mr *MockIActivityFieldServiceMockRecorder) Update(arg0, arg1 any) *gomock.
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockIActivityFieldService)(nil).Update), arg0, arg1)
 }
+
+// UpsertByCode mocks base method.
+func (m *MockIActivityFieldService) UpsertByCode(arg0 context.Context, arg1 *domain.ActivityField) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertByCode", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertByCode indicates an expected call of UpsertByCode.
+func (mr *MockIActivityFieldServiceMockRecorder) UpsertByCode(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertByCode", reflect.TypeOf((*MockIActivityFieldService)(nil).UpsertByCode), arg0, arg1)
+}
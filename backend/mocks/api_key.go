@@ -0,0 +1,197 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: domain/api_key.go
+//
+// Generated by this command:
+//
+//	mockgen -source=domain/api_key.go -destination=mocks/api_key.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	domain "ppo/domain"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIApiKeyRepository is a mock of IApiKeyRepository interface.
+type MockIApiKeyRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIApiKeyRepositoryMockRecorder
+}
+
+// MockIApiKeyRepositoryMockRecorder is the mock recorder for MockIApiKeyRepository.
+type MockIApiKeyRepositoryMockRecorder struct {
+	mock *MockIApiKeyRepository
+}
+
+// NewMockIApiKeyRepository creates a new mock instance.
+func NewMockIApiKeyRepository(ctrl *gomock.Controller) *MockIApiKeyRepository {
+	mock := &MockIApiKeyRepository{ctrl: ctrl}
+	mock.recorder = &MockIApiKeyRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIApiKeyRepository) EXPECT() *MockIApiKeyRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockIApiKeyRepository) Create(arg0 context.Context, arg1 *domain.ApiKey) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockIApiKeyRepositoryMockRecorder) Create(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockIApiKeyRepository)(nil).Create), arg0, arg1)
+}
+
+// GetByHash mocks base method.
+func (m *MockIApiKeyRepository) GetByHash(arg0 context.Context, arg1 string) (*domain.ApiKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByHash", arg0, arg1)
+	ret0, _ := ret[0].(*domain.ApiKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByHash indicates an expected call of GetByHash.
+func (mr *MockIApiKeyRepositoryMockRecorder) GetByHash(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByHash", reflect.TypeOf((*MockIApiKeyRepository)(nil).GetByHash), arg0, arg1)
+}
+
+// GetByUserId mocks base method.
+func (m *MockIApiKeyRepository) GetByUserId(arg0 context.Context, arg1 uuid.UUID) ([]*domain.ApiKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByUserId", arg0, arg1)
+	ret0, _ := ret[0].([]*domain.ApiKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByUserId indicates an expected call of GetByUserId.
+func (mr *MockIApiKeyRepositoryMockRecorder) GetByUserId(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByUserId", reflect.TypeOf((*MockIApiKeyRepository)(nil).GetByUserId), arg0, arg1)
+}
+
+// Revoke mocks base method.
+func (m *MockIApiKeyRepository) Revoke(arg0 context.Context, arg1, arg2 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Revoke", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Revoke indicates an expected call of Revoke.
+func (mr *MockIApiKeyRepositoryMockRecorder) Revoke(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Revoke", reflect.TypeOf((*MockIApiKeyRepository)(nil).Revoke), arg0, arg1, arg2)
+}
+
+// Touch mocks base method.
+func (m *MockIApiKeyRepository) Touch(arg0 context.Context, arg1 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Touch", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Touch indicates an expected call of Touch.
+func (mr *MockIApiKeyRepositoryMockRecorder) Touch(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Touch", reflect.TypeOf((*MockIApiKeyRepository)(nil).Touch), arg0, arg1)
+}
+
+// MockIApiKeyService is a mock of IApiKeyService interface.
+type MockIApiKeyService struct {
+	ctrl     *gomock.Controller
+	recorder *MockIApiKeyServiceMockRecorder
+}
+
+// MockIApiKeyServiceMockRecorder is the mock recorder for MockIApiKeyService.
+type MockIApiKeyServiceMockRecorder struct {
+	mock *MockIApiKeyService
+}
+
+// NewMockIApiKeyService creates a new mock instance.
+func NewMockIApiKeyService(ctrl *gomock.Controller) *MockIApiKeyService {
+	mock := &MockIApiKeyService{ctrl: ctrl}
+	mock.recorder = &MockIApiKeyServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIApiKeyService) EXPECT() *MockIApiKeyServiceMockRecorder {
+	return m.recorder
+}
+
+// Authenticate mocks base method.
+func (m *MockIApiKeyService) Authenticate(arg0 context.Context, arg1 string) (*domain.ApiKey, *domain.UserAuth, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Authenticate", arg0, arg1)
+	ret0, _ := ret[0].(*domain.ApiKey)
+	ret1, _ := ret[1].(*domain.UserAuth)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Authenticate indicates an expected call of Authenticate.
+func (mr *MockIApiKeyServiceMockRecorder) Authenticate(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Authenticate", reflect.TypeOf((*MockIApiKeyService)(nil).Authenticate), arg0, arg1)
+}
+
+// Create mocks base method.
+func (m *MockIApiKeyService) Create(arg0 context.Context, arg1 *domain.ApiKey) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockIApiKeyServiceMockRecorder) Create(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockIApiKeyService)(nil).Create), arg0, arg1)
+}
+
+// GetByUserId mocks base method.
+func (m *MockIApiKeyService) GetByUserId(arg0 context.Context, arg1 uuid.UUID) ([]*domain.ApiKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByUserId", arg0, arg1)
+	ret0, _ := ret[0].([]*domain.ApiKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByUserId indicates an expected call of GetByUserId.
+func (mr *MockIApiKeyServiceMockRecorder) GetByUserId(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByUserId", reflect.TypeOf((*MockIApiKeyService)(nil).GetByUserId), arg0, arg1)
+}
+
+// Revoke mocks base method.
+func (m *MockIApiKeyService) Revoke(arg0 context.Context, arg1, arg2 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Revoke", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Revoke indicates an expected call of Revoke.
+func (mr *MockIApiKeyServiceMockRecorder) Revoke(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Revoke", reflect.TypeOf((*MockIApiKeyService)(nil).Revoke), arg0, arg1, arg2)
+}
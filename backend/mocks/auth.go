@@ -14,6 +14,7 @@ import (
 	domain "ppo/domain"
 	reflect "reflect"
 
+	uuid "github.com/google/uuid"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -40,6 +41,21 @@ func (m *MockIAuthRepository) EXPECT() *MockIAuthRepositoryMockRecorder {
 	return m.recorder
 }
 
+// GetById mocks base method.
+func (m *MockIAuthRepository) GetById(arg0 context.Context, arg1 uuid.UUID) (*domain.UserAuth, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetById", arg0, arg1)
+	ret0, _ := ret[0].(*domain.UserAuth)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetById indicates an expected call of GetById.
+func (mr *MockIAuthRepositoryMockRecorder) GetById(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetById", reflect.TypeOf((*MockIAuthRepository)(nil).GetById), arg0, arg1)
+}
+
 // GetByUsername mocks base method.
 func (m *MockIAuthRepository) GetByUsername(arg0 context.Context, arg1 string) (*domain.UserAuth, error) {
 	m.ctrl.T.Helper()
@@ -56,17 +72,31 @@ func (mr *MockIAuthRepositoryMockRecorder) GetByUsername(arg0, arg1 any) *gomock
 }
 
 // Register mocks base method.
-func (m *MockIAuthRepository) Register(arg0 context.Context, arg1 *domain.UserAuth) error {
+func (m *MockIAuthRepository) Register(arg0 context.Context, arg1 *domain.UserAuth, arg2 *domain.User) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Register", arg0, arg1)
+	ret := m.ctrl.Call(m, "Register", arg0, arg1, arg2)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Register indicates an expected call of Register.
-func (mr *MockIAuthRepositoryMockRecorder) Register(arg0, arg1 any) *gomock.Call {
+func (mr *MockIAuthRepositoryMockRecorder) Register(arg0, arg1, arg2 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Register", reflect.TypeOf((*MockIAuthRepository)(nil).Register), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Register", reflect.TypeOf((*MockIAuthRepository)(nil).Register), arg0, arg1, arg2)
+}
+
+// UpdatePassword mocks base method.
+func (m *MockIAuthRepository) UpdatePassword(arg0 context.Context, arg1 uuid.UUID, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePassword", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePassword indicates an expected call of UpdatePassword.
+func (mr *MockIAuthRepositoryMockRecorder) UpdatePassword(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePassword", reflect.TypeOf((*MockIAuthRepository)(nil).UpdatePassword), arg0, arg1, arg2)
 }
 
 // MockIAuthService is a mock of IAuthService interface.
@@ -92,31 +122,176 @@ func (m *MockIAuthService) EXPECT() *MockIAuthServiceMockRecorder {
 	return m.recorder
 }
 
+// ChangePassword mocks base method.
+func (m *MockIAuthService) ChangePassword(arg0 context.Context, arg1 uuid.UUID, arg2, arg3 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangePassword", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ChangePassword indicates an expected call of ChangePassword.
+func (mr *MockIAuthServiceMockRecorder) ChangePassword(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangePassword", reflect.TypeOf((*MockIAuthService)(nil).ChangePassword), arg0, arg1, arg2, arg3)
+}
+
+// ConfirmMfa mocks base method.
+func (m *MockIAuthService) ConfirmMfa(arg0 context.Context, arg1 uuid.UUID, arg2 string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConfirmMfa", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConfirmMfa indicates an expected call of ConfirmMfa.
+func (mr *MockIAuthServiceMockRecorder) ConfirmMfa(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfirmMfa", reflect.TypeOf((*MockIAuthService)(nil).ConfirmMfa), arg0, arg1, arg2)
+}
+
+// DisableMfa mocks base method.
+func (m *MockIAuthService) DisableMfa(arg0 context.Context, arg1 uuid.UUID, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisableMfa", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DisableMfa indicates an expected call of DisableMfa.
+func (mr *MockIAuthServiceMockRecorder) DisableMfa(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableMfa", reflect.TypeOf((*MockIAuthService)(nil).DisableMfa), arg0, arg1, arg2)
+}
+
+// EnrollMfa mocks base method.
+func (m *MockIAuthService) EnrollMfa(arg0 context.Context, arg1 uuid.UUID) (*domain.MfaEnrollment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnrollMfa", arg0, arg1)
+	ret0, _ := ret[0].(*domain.MfaEnrollment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnrollMfa indicates an expected call of EnrollMfa.
+func (mr *MockIAuthServiceMockRecorder) EnrollMfa(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnrollMfa", reflect.TypeOf((*MockIAuthService)(nil).EnrollMfa), arg0, arg1)
+}
+
+// IsSessionActive mocks base method.
+func (m *MockIAuthService) IsSessionActive(arg0 context.Context, arg1 uuid.UUID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsSessionActive", arg0, arg1)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsSessionActive indicates an expected call of IsSessionActive.
+func (mr *MockIAuthServiceMockRecorder) IsSessionActive(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsSessionActive", reflect.TypeOf((*MockIAuthService)(nil).IsSessionActive), arg0, arg1)
+}
+
 // Login mocks base method.
-func (m *MockIAuthService) Login(arg0 context.Context, arg1 *domain.UserAuth) (string, error) {
+func (m *MockIAuthService) Login(arg0 context.Context, arg1 *domain.UserAuth, arg2 string) (*domain.LoginResult, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Login", arg0, arg1)
-	ret0, _ := ret[0].(string)
+	ret := m.ctrl.Call(m, "Login", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*domain.LoginResult)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Login indicates an expected call of Login.
-func (mr *MockIAuthServiceMockRecorder) Login(arg0, arg1 any) *gomock.Call {
+func (mr *MockIAuthServiceMockRecorder) Login(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Login", reflect.TypeOf((*MockIAuthService)(nil).Login), arg0, arg1, arg2)
+}
+
+// Logout mocks base method.
+func (m *MockIAuthService) Logout(arg0 context.Context, arg1 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Logout", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Logout indicates an expected call of Logout.
+func (mr *MockIAuthServiceMockRecorder) Logout(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Logout", reflect.TypeOf((*MockIAuthService)(nil).Logout), arg0, arg1)
+}
+
+// Refresh mocks base method.
+func (m *MockIAuthService) Refresh(arg0 context.Context, arg1 string) (*domain.TokenPair, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Refresh", arg0, arg1)
+	ret0, _ := ret[0].(*domain.TokenPair)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Refresh indicates an expected call of Refresh.
+func (mr *MockIAuthServiceMockRecorder) Refresh(arg0, arg1 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Login", reflect.TypeOf((*MockIAuthService)(nil).Login), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Refresh", reflect.TypeOf((*MockIAuthService)(nil).Refresh), arg0, arg1)
 }
 
 // Register mocks base method.
-func (m *MockIAuthService) Register(arg0 context.Context, arg1 *domain.UserAuth) error {
+func (m *MockIAuthService) Register(arg0 context.Context, arg1 *domain.UserAuth, arg2 *domain.User) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Register", arg0, arg1)
+	ret := m.ctrl.Call(m, "Register", arg0, arg1, arg2)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Register indicates an expected call of Register.
-func (mr *MockIAuthServiceMockRecorder) Register(arg0, arg1 any) *gomock.Call {
+func (mr *MockIAuthServiceMockRecorder) Register(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Register", reflect.TypeOf((*MockIAuthService)(nil).Register), arg0, arg1, arg2)
+}
+
+// RequestPasswordReset mocks base method.
+func (m *MockIAuthService) RequestPasswordReset(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequestPasswordReset", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RequestPasswordReset indicates an expected call of RequestPasswordReset.
+func (mr *MockIAuthServiceMockRecorder) RequestPasswordReset(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestPasswordReset", reflect.TypeOf((*MockIAuthService)(nil).RequestPasswordReset), arg0, arg1)
+}
+
+// ResetPassword mocks base method.
+func (m *MockIAuthService) ResetPassword(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetPassword", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResetPassword indicates an expected call of ResetPassword.
+func (mr *MockIAuthServiceMockRecorder) ResetPassword(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetPassword", reflect.TypeOf((*MockIAuthService)(nil).ResetPassword), arg0, arg1, arg2)
+}
+
+// VerifyMfa mocks base method.
+func (m *MockIAuthService) VerifyMfa(arg0 context.Context, arg1, arg2 string) (*domain.TokenPair, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyMfa", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*domain.TokenPair)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyMfa indicates an expected call of VerifyMfa.
+func (mr *MockIAuthServiceMockRecorder) VerifyMfa(arg0, arg1, arg2 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Register", reflect.TypeOf((*MockIAuthService)(nil).Register), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyMfa", reflect.TypeOf((*MockIAuthService)(nil).VerifyMfa), arg0, arg1, arg2)
 }
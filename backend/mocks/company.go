@@ -84,6 +84,37 @@ func (mr *MockICompanyRepositoryMockRecorder) GetAll(arg0, arg1 any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockICompanyRepository)(nil).GetAll), arg0, arg1)
 }
 
+// GetByActivityFieldIds mocks base method.
+func (m *MockICompanyRepository) GetByActivityFieldIds(arg0 context.Context, arg1 []uuid.UUID, arg2 int) ([]*domain.Company, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByActivityFieldIds", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*domain.Company)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetByActivityFieldIds indicates an expected call of GetByActivityFieldIds.
+func (mr *MockICompanyRepositoryMockRecorder) GetByActivityFieldIds(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByActivityFieldIds", reflect.TypeOf((*MockICompanyRepository)(nil).GetByActivityFieldIds), arg0, arg1, arg2)
+}
+
+// GetByINN mocks base method.
+func (m *MockICompanyRepository) GetByINN(arg0 context.Context, arg1 string) (*domain.Company, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByINN", arg0, arg1)
+	ret0, _ := ret[0].(*domain.Company)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByINN indicates an expected call of GetByINN.
+func (mr *MockICompanyRepositoryMockRecorder) GetByINN(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByINN", reflect.TypeOf((*MockICompanyRepository)(nil).GetByINN), arg0, arg1)
+}
+
 // GetById mocks base method.
 func (m *MockICompanyRepository) GetById(arg0 context.Context, arg1 uuid.UUID) (*domain.Company, error) {
 	m.ctrl.T.Helper()
@@ -152,6 +183,20 @@ func (m *MockICompanyService) EXPECT() *MockICompanyServiceMockRecorder {
 	return m.recorder
 }
 
+// AcceptOwnership mocks base method.
+func (m *MockICompanyService) AcceptOwnership(arg0 context.Context, arg1, arg2 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcceptOwnership", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AcceptOwnership indicates an expected call of AcceptOwnership.
+func (mr *MockICompanyServiceMockRecorder) AcceptOwnership(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptOwnership", reflect.TypeOf((*MockICompanyService)(nil).AcceptOwnership), arg0, arg1, arg2)
+}
+
 // Create mocks base method.
 func (m *MockICompanyService) Create(arg0 context.Context, arg1 *domain.Company) error {
 	m.ctrl.T.Helper()
@@ -195,6 +240,37 @@ func (mr *MockICompanyServiceMockRecorder) GetAll(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockICompanyService)(nil).GetAll), arg0, arg1)
 }
 
+// GetByActivityFieldTree mocks base method.
+func (m *MockICompanyService) GetByActivityFieldTree(arg0 context.Context, arg1 uuid.UUID, arg2 int) ([]*domain.Company, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByActivityFieldTree", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*domain.Company)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetByActivityFieldTree indicates an expected call of GetByActivityFieldTree.
+func (mr *MockICompanyServiceMockRecorder) GetByActivityFieldTree(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByActivityFieldTree", reflect.TypeOf((*MockICompanyService)(nil).GetByActivityFieldTree), arg0, arg1, arg2)
+}
+
+// GetByINN mocks base method.
+func (m *MockICompanyService) GetByINN(arg0 context.Context, arg1 string) (*domain.Company, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByINN", arg0, arg1)
+	ret0, _ := ret[0].(*domain.Company)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByINN indicates an expected call of GetByINN.
+func (mr *MockICompanyServiceMockRecorder) GetByINN(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByINN", reflect.TypeOf((*MockICompanyService)(nil).GetByINN), arg0, arg1)
+}
+
 // GetById mocks base method.
 func (m *MockICompanyService) GetById(arg0 context.Context, arg1 uuid.UUID) (*domain.Company, error) {
 	m.ctrl.T.Helper()
@@ -226,6 +302,64 @@ func (mr *MockICompanyServiceMockRecorder) GetByOwnerId(arg0, arg1, arg2, arg3 a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByOwnerId", reflect.TypeOf((*MockICompanyService)(nil).GetByOwnerId), arg0, arg1, arg2, arg3)
 }
 
+// GetOwners mocks base method.
+func (m *MockICompanyService) GetOwners(arg0 context.Context, arg1 uuid.UUID) ([]*domain.CompanyOwner, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOwners", arg0, arg1)
+	ret0, _ := ret[0].([]*domain.CompanyOwner)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOwners indicates an expected call of GetOwners.
+func (mr *MockICompanyServiceMockRecorder) GetOwners(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOwners", reflect.TypeOf((*MockICompanyService)(nil).GetOwners), arg0, arg1)
+}
+
+// GetOwnerships mocks base method.
+func (m *MockICompanyService) GetOwnerships(arg0 context.Context, arg1 uuid.UUID) ([]*domain.CompanyOwner, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOwnerships", arg0, arg1)
+	ret0, _ := ret[0].([]*domain.CompanyOwner)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOwnerships indicates an expected call of GetOwnerships.
+func (mr *MockICompanyServiceMockRecorder) GetOwnerships(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOwnerships", reflect.TypeOf((*MockICompanyService)(nil).GetOwnerships), arg0, arg1)
+}
+
+// InviteOwner mocks base method.
+func (m *MockICompanyService) InviteOwner(arg0 context.Context, arg1 *domain.CompanyOwner) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InviteOwner", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InviteOwner indicates an expected call of InviteOwner.
+func (mr *MockICompanyServiceMockRecorder) InviteOwner(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InviteOwner", reflect.TypeOf((*MockICompanyService)(nil).InviteOwner), arg0, arg1)
+}
+
+// SetOwnerShare mocks base method.
+func (m *MockICompanyService) SetOwnerShare(arg0 context.Context, arg1 *domain.CompanyOwner, arg2 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetOwnerShare", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetOwnerShare indicates an expected call of SetOwnerShare.
+func (mr *MockICompanyServiceMockRecorder) SetOwnerShare(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetOwnerShare", reflect.TypeOf((*MockICompanyService)(nil).SetOwnerShare), arg0, arg1, arg2)
+}
+
 // Update mocks base method.
 func (m *MockICompanyService) Update(arg0 context.Context, arg1 *domain.Company, arg2 uuid.UUID) error {
 	m.ctrl.T.Helper()
@@ -0,0 +1,115 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: domain/company_owner.go
+//
+// Generated by this command:
+//
+//	mockgen -source=domain/company_owner.go -destination=mocks/company_owner.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	domain "ppo/domain"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	decimal "github.com/shopspring/decimal"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockICompanyOwnerRepository is a mock of ICompanyOwnerRepository interface.
+type MockICompanyOwnerRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockICompanyOwnerRepositoryMockRecorder
+}
+
+// MockICompanyOwnerRepositoryMockRecorder is the mock recorder for MockICompanyOwnerRepository.
+type MockICompanyOwnerRepositoryMockRecorder struct {
+	mock *MockICompanyOwnerRepository
+}
+
+// NewMockICompanyOwnerRepository creates a new mock instance.
+func NewMockICompanyOwnerRepository(ctrl *gomock.Controller) *MockICompanyOwnerRepository {
+	mock := &MockICompanyOwnerRepository{ctrl: ctrl}
+	mock.recorder = &MockICompanyOwnerRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockICompanyOwnerRepository) EXPECT() *MockICompanyOwnerRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Accept mocks base method.
+func (m *MockICompanyOwnerRepository) Accept(arg0 context.Context, arg1, arg2 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Accept", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Accept indicates an expected call of Accept.
+func (mr *MockICompanyOwnerRepositoryMockRecorder) Accept(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Accept", reflect.TypeOf((*MockICompanyOwnerRepository)(nil).Accept), arg0, arg1, arg2)
+}
+
+// GetAcceptedByUserId mocks base method.
+func (m *MockICompanyOwnerRepository) GetAcceptedByUserId(arg0 context.Context, arg1 uuid.UUID) ([]*domain.CompanyOwner, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAcceptedByUserId", arg0, arg1)
+	ret0, _ := ret[0].([]*domain.CompanyOwner)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAcceptedByUserId indicates an expected call of GetAcceptedByUserId.
+func (mr *MockICompanyOwnerRepositoryMockRecorder) GetAcceptedByUserId(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAcceptedByUserId", reflect.TypeOf((*MockICompanyOwnerRepository)(nil).GetAcceptedByUserId), arg0, arg1)
+}
+
+// GetByCompanyId mocks base method.
+func (m *MockICompanyOwnerRepository) GetByCompanyId(arg0 context.Context, arg1 uuid.UUID) ([]*domain.CompanyOwner, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByCompanyId", arg0, arg1)
+	ret0, _ := ret[0].([]*domain.CompanyOwner)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByCompanyId indicates an expected call of GetByCompanyId.
+func (mr *MockICompanyOwnerRepositoryMockRecorder) GetByCompanyId(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByCompanyId", reflect.TypeOf((*MockICompanyOwnerRepository)(nil).GetByCompanyId), arg0, arg1)
+}
+
+// Invite mocks base method.
+func (m *MockICompanyOwnerRepository) Invite(arg0 context.Context, arg1 *domain.CompanyOwner) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Invite", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Invite indicates an expected call of Invite.
+func (mr *MockICompanyOwnerRepositoryMockRecorder) Invite(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Invite", reflect.TypeOf((*MockICompanyOwnerRepository)(nil).Invite), arg0, arg1)
+}
+
+// SetShare mocks base method.
+func (m *MockICompanyOwnerRepository) SetShare(arg0 context.Context, arg1, arg2 uuid.UUID, arg3 decimal.Decimal) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetShare", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetShare indicates an expected call of SetShare.
+func (mr *MockICompanyOwnerRepositoryMockRecorder) SetShare(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetShare", reflect.TypeOf((*MockICompanyOwnerRepository)(nil).SetShare), arg0, arg1, arg2, arg3)
+}
@@ -0,0 +1,224 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: domain/company_transfer.go
+//
+// Generated by this command:
+//
+//	mockgen -source=domain/company_transfer.go -destination=mocks/company_transfer.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	domain "ppo/domain"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockICompanyTransferRepository is a mock of ICompanyTransferRepository interface.
+type MockICompanyTransferRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockICompanyTransferRepositoryMockRecorder
+}
+
+// MockICompanyTransferRepositoryMockRecorder is the mock recorder for MockICompanyTransferRepository.
+type MockICompanyTransferRepositoryMockRecorder struct {
+	mock *MockICompanyTransferRepository
+}
+
+// NewMockICompanyTransferRepository creates a new mock instance.
+func NewMockICompanyTransferRepository(ctrl *gomock.Controller) *MockICompanyTransferRepository {
+	mock := &MockICompanyTransferRepository{ctrl: ctrl}
+	mock.recorder = &MockICompanyTransferRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockICompanyTransferRepository) EXPECT() *MockICompanyTransferRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Accept mocks base method.
+func (m *MockICompanyTransferRepository) Accept(arg0 context.Context, arg1, arg2 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Accept", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Accept indicates an expected call of Accept.
+func (mr *MockICompanyTransferRepositoryMockRecorder) Accept(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Accept", reflect.TypeOf((*MockICompanyTransferRepository)(nil).Accept), arg0, arg1, arg2)
+}
+
+// Create mocks base method.
+func (m *MockICompanyTransferRepository) Create(arg0 context.Context, arg1 *domain.CompanyTransfer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockICompanyTransferRepositoryMockRecorder) Create(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockICompanyTransferRepository)(nil).Create), arg0, arg1)
+}
+
+// Decline mocks base method.
+func (m *MockICompanyTransferRepository) Decline(arg0 context.Context, arg1, arg2 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Decline", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Decline indicates an expected call of Decline.
+func (mr *MockICompanyTransferRepositoryMockRecorder) Decline(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Decline", reflect.TypeOf((*MockICompanyTransferRepository)(nil).Decline), arg0, arg1, arg2)
+}
+
+// GetByCompanyId mocks base method.
+func (m *MockICompanyTransferRepository) GetByCompanyId(arg0 context.Context, arg1 uuid.UUID) ([]*domain.CompanyTransfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByCompanyId", arg0, arg1)
+	ret0, _ := ret[0].([]*domain.CompanyTransfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByCompanyId indicates an expected call of GetByCompanyId.
+func (mr *MockICompanyTransferRepositoryMockRecorder) GetByCompanyId(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByCompanyId", reflect.TypeOf((*MockICompanyTransferRepository)(nil).GetByCompanyId), arg0, arg1)
+}
+
+// GetById mocks base method.
+func (m *MockICompanyTransferRepository) GetById(arg0 context.Context, arg1 uuid.UUID) (*domain.CompanyTransfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetById", arg0, arg1)
+	ret0, _ := ret[0].(*domain.CompanyTransfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetById indicates an expected call of GetById.
+func (mr *MockICompanyTransferRepositoryMockRecorder) GetById(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetById", reflect.TypeOf((*MockICompanyTransferRepository)(nil).GetById), arg0, arg1)
+}
+
+// GetPendingByUserId mocks base method.
+func (m *MockICompanyTransferRepository) GetPendingByUserId(arg0 context.Context, arg1 uuid.UUID) ([]*domain.CompanyTransfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPendingByUserId", arg0, arg1)
+	ret0, _ := ret[0].([]*domain.CompanyTransfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPendingByUserId indicates an expected call of GetPendingByUserId.
+func (mr *MockICompanyTransferRepositoryMockRecorder) GetPendingByUserId(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPendingByUserId", reflect.TypeOf((*MockICompanyTransferRepository)(nil).GetPendingByUserId), arg0, arg1)
+}
+
+// MockICompanyTransferService is a mock of ICompanyTransferService interface.
+type MockICompanyTransferService struct {
+	ctrl     *gomock.Controller
+	recorder *MockICompanyTransferServiceMockRecorder
+}
+
+// MockICompanyTransferServiceMockRecorder is the mock recorder for MockICompanyTransferService.
+type MockICompanyTransferServiceMockRecorder struct {
+	mock *MockICompanyTransferService
+}
+
+// NewMockICompanyTransferService creates a new mock instance.
+func NewMockICompanyTransferService(ctrl *gomock.Controller) *MockICompanyTransferService {
+	mock := &MockICompanyTransferService{ctrl: ctrl}
+	mock.recorder = &MockICompanyTransferServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockICompanyTransferService) EXPECT() *MockICompanyTransferServiceMockRecorder {
+	return m.recorder
+}
+
+// Accept mocks base method.
+func (m *MockICompanyTransferService) Accept(arg0 context.Context, arg1, arg2 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Accept", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Accept indicates an expected call of Accept.
+func (mr *MockICompanyTransferServiceMockRecorder) Accept(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Accept", reflect.TypeOf((*MockICompanyTransferService)(nil).Accept), arg0, arg1, arg2)
+}
+
+// Decline mocks base method.
+func (m *MockICompanyTransferService) Decline(arg0 context.Context, arg1, arg2 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Decline", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Decline indicates an expected call of Decline.
+func (mr *MockICompanyTransferServiceMockRecorder) Decline(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Decline", reflect.TypeOf((*MockICompanyTransferService)(nil).Decline), arg0, arg1, arg2)
+}
+
+// GetHistory mocks base method.
+func (m *MockICompanyTransferService) GetHistory(arg0 context.Context, arg1 uuid.UUID) ([]*domain.CompanyTransfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHistory", arg0, arg1)
+	ret0, _ := ret[0].([]*domain.CompanyTransfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHistory indicates an expected call of GetHistory.
+func (mr *MockICompanyTransferServiceMockRecorder) GetHistory(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHistory", reflect.TypeOf((*MockICompanyTransferService)(nil).GetHistory), arg0, arg1)
+}
+
+// GetIncoming mocks base method.
+func (m *MockICompanyTransferService) GetIncoming(arg0 context.Context, arg1 uuid.UUID) ([]*domain.CompanyTransfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIncoming", arg0, arg1)
+	ret0, _ := ret[0].([]*domain.CompanyTransfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetIncoming indicates an expected call of GetIncoming.
+func (mr *MockICompanyTransferServiceMockRecorder) GetIncoming(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIncoming", reflect.TypeOf((*MockICompanyTransferService)(nil).GetIncoming), arg0, arg1)
+}
+
+// Initiate mocks base method.
+func (m *MockICompanyTransferService) Initiate(arg0 context.Context, arg1 *domain.CompanyTransfer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Initiate", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Initiate indicates an expected call of Initiate.
+func (mr *MockICompanyTransferServiceMockRecorder) Initiate(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Initiate", reflect.TypeOf((*MockICompanyTransferService)(nil).Initiate), arg0, arg1)
+}
@@ -69,6 +69,22 @@ func (mr *MockIFinancialReportRepositoryMockRecorder) DeleteById(arg0, arg1 any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteById", reflect.TypeOf((*MockIFinancialReportRepository)(nil).DeleteById), arg0, arg1)
 }
 
+// GetAll mocks base method.
+func (m *MockIFinancialReportRepository) GetAll(arg0 context.Context, arg1 *domain.FinancialReportFilter, arg2 int) ([]*domain.FinancialReport, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*domain.FinancialReport)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockIFinancialReportRepositoryMockRecorder) GetAll(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockIFinancialReportRepository)(nil).GetAll), arg0, arg1, arg2)
+}
+
 // GetByCompany mocks base method.
 func (m *MockIFinancialReportRepository) GetByCompany(arg0 context.Context, arg1 uuid.UUID, arg2 *domain.Period) (*domain.FinancialReportByPeriod, error) {
 	m.ctrl.T.Helper()
@@ -113,6 +129,20 @@ func (mr *MockIFinancialReportRepositoryMockRecorder) Update(arg0, arg1 any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockIFinancialReportRepository)(nil).Update), arg0, arg1)
 }
 
+// Verify mocks base method.
+func (m *MockIFinancialReportRepository) Verify(arg0 context.Context, arg1, arg2 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Verify", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Verify indicates an expected call of Verify.
+func (mr *MockIFinancialReportRepositoryMockRecorder) Verify(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Verify", reflect.TypeOf((*MockIFinancialReportRepository)(nil).Verify), arg0, arg1, arg2)
+}
+
 // MockIFinancialReportService is a mock of IFinancialReportService interface.
 type MockIFinancialReportService struct {
 	ctrl     *gomock.Controller
@@ -178,6 +208,22 @@ func (mr *MockIFinancialReportServiceMockRecorder) DeleteById(arg0, arg1, arg2 a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteById", reflect.TypeOf((*MockIFinancialReportService)(nil).DeleteById), arg0, arg1, arg2)
 }
 
+// GetAll mocks base method.
+func (m *MockIFinancialReportService) GetAll(arg0 context.Context, arg1 *domain.FinancialReportFilter, arg2 int) ([]*domain.FinancialReport, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*domain.FinancialReport)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockIFinancialReportServiceMockRecorder) GetAll(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockIFinancialReportService)(nil).GetAll), arg0, arg1, arg2)
+}
+
 // GetByCompany mocks base method.
 func (m *MockIFinancialReportService) GetByCompany(arg0 context.Context, arg1 uuid.UUID, arg2 *domain.Period) (*domain.FinancialReportByPeriod, error) {
 	m.ctrl.T.Helper()
@@ -221,3 +267,17 @@ func (mr *MockIFinancialReportServiceMockRecorder) Update(arg0, arg1, arg2 any)
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockIFinancialReportService)(nil).Update), arg0, arg1, arg2)
 }
+
+// Verify mocks base method.
+func (m *MockIFinancialReportService) Verify(arg0 context.Context, arg1, arg2 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Verify", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Verify indicates an expected call of Verify.
+func (mr *MockIFinancialReportServiceMockRecorder) Verify(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Verify", reflect.TypeOf((*MockIFinancialReportService)(nil).Verify), arg0, arg1, arg2)
+}
@@ -0,0 +1,129 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: domain/mfa.go
+//
+// Generated by this command:
+//
+//	mockgen -source=domain/mfa.go -destination=mocks/mfa.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	domain "ppo/domain"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIMfaRepository is a mock of IMfaRepository interface.
+type MockIMfaRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIMfaRepositoryMockRecorder
+}
+
+// MockIMfaRepositoryMockRecorder is the mock recorder for MockIMfaRepository.
+type MockIMfaRepositoryMockRecorder struct {
+	mock *MockIMfaRepository
+}
+
+// NewMockIMfaRepository creates a new mock instance.
+func NewMockIMfaRepository(ctrl *gomock.Controller) *MockIMfaRepository {
+	mock := &MockIMfaRepository{ctrl: ctrl}
+	mock.recorder = &MockIMfaRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIMfaRepository) EXPECT() *MockIMfaRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Confirm mocks base method.
+func (m *MockIMfaRepository) Confirm(arg0 context.Context, arg1 uuid.UUID, arg2 []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Confirm", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Confirm indicates an expected call of Confirm.
+func (mr *MockIMfaRepositoryMockRecorder) Confirm(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Confirm", reflect.TypeOf((*MockIMfaRepository)(nil).Confirm), arg0, arg1, arg2)
+}
+
+// Delete mocks base method.
+func (m *MockIMfaRepository) Delete(arg0 context.Context, arg1 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockIMfaRepositoryMockRecorder) Delete(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockIMfaRepository)(nil).Delete), arg0, arg1)
+}
+
+// GetByUserId mocks base method.
+func (m *MockIMfaRepository) GetByUserId(arg0 context.Context, arg1 uuid.UUID) (*domain.UserMfa, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByUserId", arg0, arg1)
+	ret0, _ := ret[0].(*domain.UserMfa)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByUserId indicates an expected call of GetByUserId.
+func (mr *MockIMfaRepositoryMockRecorder) GetByUserId(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByUserId", reflect.TypeOf((*MockIMfaRepository)(nil).GetByUserId), arg0, arg1)
+}
+
+// Save mocks base method.
+func (m *MockIMfaRepository) Save(arg0 context.Context, arg1 *domain.UserMfa) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockIMfaRepositoryMockRecorder) Save(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockIMfaRepository)(nil).Save), arg0, arg1)
+}
+
+// UseRecoveryCode mocks base method.
+func (m *MockIMfaRepository) UseRecoveryCode(arg0 context.Context, arg1 uuid.UUID, arg2 string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UseRecoveryCode", arg0, arg1, arg2)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UseRecoveryCode indicates an expected call of UseRecoveryCode.
+func (mr *MockIMfaRepositoryMockRecorder) UseRecoveryCode(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UseRecoveryCode", reflect.TypeOf((*MockIMfaRepository)(nil).UseRecoveryCode), arg0, arg1, arg2)
+}
+
+// UseStep mocks base method.
+func (m *MockIMfaRepository) UseStep(arg0 context.Context, arg1 uuid.UUID, arg2 int64) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UseStep", arg0, arg1, arg2)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UseStep indicates an expected call of UseStep.
+func (mr *MockIMfaRepositoryMockRecorder) UseStep(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UseStep", reflect.TypeOf((*MockIMfaRepository)(nil).UseStep), arg0, arg1, arg2)
+}
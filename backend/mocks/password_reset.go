@@ -0,0 +1,70 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: domain/password_reset.go
+//
+// Generated by this command:
+//
+//	mockgen -source=domain/password_reset.go -destination=mocks/password_reset.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	domain "ppo/domain"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIPasswordResetRepository is a mock of IPasswordResetRepository interface.
+type MockIPasswordResetRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIPasswordResetRepositoryMockRecorder
+}
+
+// MockIPasswordResetRepositoryMockRecorder is the mock recorder for MockIPasswordResetRepository.
+type MockIPasswordResetRepositoryMockRecorder struct {
+	mock *MockIPasswordResetRepository
+}
+
+// NewMockIPasswordResetRepository creates a new mock instance.
+func NewMockIPasswordResetRepository(ctrl *gomock.Controller) *MockIPasswordResetRepository {
+	mock := &MockIPasswordResetRepository{ctrl: ctrl}
+	mock.recorder = &MockIPasswordResetRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIPasswordResetRepository) EXPECT() *MockIPasswordResetRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockIPasswordResetRepository) Create(arg0 context.Context, arg1 *domain.PasswordResetToken) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockIPasswordResetRepositoryMockRecorder) Create(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockIPasswordResetRepository)(nil).Create), arg0, arg1)
+}
+
+// Use mocks base method.
+func (m *MockIPasswordResetRepository) Use(arg0 context.Context, arg1 string) (*domain.PasswordResetToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Use", arg0, arg1)
+	ret0, _ := ret[0].(*domain.PasswordResetToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Use indicates an expected call of Use.
+func (mr *MockIPasswordResetRepositoryMockRecorder) Use(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Use", reflect.TypeOf((*MockIPasswordResetRepository)(nil).Use), arg0, arg1)
+}
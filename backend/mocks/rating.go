@@ -0,0 +1,84 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: domain/rating.go
+//
+// Generated by this command:
+//
+//	mockgen -source=domain/rating.go -destination=mocks/rating.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	domain "ppo/domain"
+	reflect "reflect"
+
+	decimal "github.com/shopspring/decimal"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRatingStrategy is a mock of RatingStrategy interface.
+type MockRatingStrategy struct {
+	ctrl     *gomock.Controller
+	recorder *MockRatingStrategyMockRecorder
+}
+
+// MockRatingStrategyMockRecorder is the mock recorder for MockRatingStrategy.
+type MockRatingStrategyMockRecorder struct {
+	mock *MockRatingStrategy
+}
+
+// NewMockRatingStrategy creates a new mock instance.
+func NewMockRatingStrategy(ctrl *gomock.Controller) *MockRatingStrategy {
+	mock := &MockRatingStrategy{ctrl: ctrl}
+	mock.recorder = &MockRatingStrategyMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRatingStrategy) EXPECT() *MockRatingStrategyMockRecorder {
+	return m.recorder
+}
+
+// Components mocks base method.
+func (m *MockRatingStrategy) Components(arg0 *domain.RatingInput) (decimal.Decimal, decimal.Decimal) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Components", arg0)
+	ret0, _ := ret[0].(decimal.Decimal)
+	ret1, _ := ret[1].(decimal.Decimal)
+	return ret0, ret1
+}
+
+// Components indicates an expected call of Components.
+func (mr *MockRatingStrategyMockRecorder) Components(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Components", reflect.TypeOf((*MockRatingStrategy)(nil).Components), arg0)
+}
+
+// Name mocks base method.
+func (m *MockRatingStrategy) Name() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Name")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// Name indicates an expected call of Name.
+func (mr *MockRatingStrategyMockRecorder) Name() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Name", reflect.TypeOf((*MockRatingStrategy)(nil).Name))
+}
+
+// Requirements mocks base method.
+func (m *MockRatingStrategy) Requirements() domain.RatingRequirements {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Requirements")
+	ret0, _ := ret[0].(domain.RatingRequirements)
+	return ret0
+}
+
+// Requirements indicates an expected call of Requirements.
+func (mr *MockRatingStrategyMockRecorder) Requirements() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Requirements", reflect.TypeOf((*MockRatingStrategy)(nil).Requirements))
+}
@@ -0,0 +1,183 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: domain/rating_snapshot.go
+//
+// Generated by this command:
+//
+//	mockgen -source=domain/rating_snapshot.go -destination=mocks/rating_snapshot.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	domain "ppo/domain"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIRatingSnapshotRepository is a mock of IRatingSnapshotRepository interface.
+type MockIRatingSnapshotRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIRatingSnapshotRepositoryMockRecorder
+}
+
+// MockIRatingSnapshotRepositoryMockRecorder is the mock recorder for MockIRatingSnapshotRepository.
+type MockIRatingSnapshotRepositoryMockRecorder struct {
+	mock *MockIRatingSnapshotRepository
+}
+
+// NewMockIRatingSnapshotRepository creates a new mock instance.
+func NewMockIRatingSnapshotRepository(ctrl *gomock.Controller) *MockIRatingSnapshotRepository {
+	mock := &MockIRatingSnapshotRepository{ctrl: ctrl}
+	mock.recorder = &MockIRatingSnapshotRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIRatingSnapshotRepository) EXPECT() *MockIRatingSnapshotRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CompleteQuarter mocks base method.
+func (m *MockIRatingSnapshotRepository) CompleteQuarter(arg0 context.Context, arg1, arg2 int, arg3 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompleteQuarter", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CompleteQuarter indicates an expected call of CompleteQuarter.
+func (mr *MockIRatingSnapshotRepositoryMockRecorder) CompleteQuarter(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteQuarter", reflect.TypeOf((*MockIRatingSnapshotRepository)(nil).CompleteQuarter), arg0, arg1, arg2, arg3)
+}
+
+// GetByUserId mocks base method.
+func (m *MockIRatingSnapshotRepository) GetByUserId(arg0 context.Context, arg1 uuid.UUID, arg2 *domain.Period, arg3 string) ([]*domain.RatingSnapshot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByUserId", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*domain.RatingSnapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByUserId indicates an expected call of GetByUserId.
+func (mr *MockIRatingSnapshotRepositoryMockRecorder) GetByUserId(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByUserId", reflect.TypeOf((*MockIRatingSnapshotRepository)(nil).GetByUserId), arg0, arg1, arg2, arg3)
+}
+
+// GetLastCompletedQuarter mocks base method.
+func (m *MockIRatingSnapshotRepository) GetLastCompletedQuarter(arg0 context.Context, arg1 string) (int, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLastCompletedQuarter", arg0, arg1)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetLastCompletedQuarter indicates an expected call of GetLastCompletedQuarter.
+func (mr *MockIRatingSnapshotRepositoryMockRecorder) GetLastCompletedQuarter(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLastCompletedQuarter", reflect.TypeOf((*MockIRatingSnapshotRepository)(nil).GetLastCompletedQuarter), arg0, arg1)
+}
+
+// Save mocks base method.
+func (m *MockIRatingSnapshotRepository) Save(arg0 context.Context, arg1 *domain.RatingSnapshot) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockIRatingSnapshotRepositoryMockRecorder) Save(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockIRatingSnapshotRepository)(nil).Save), arg0, arg1)
+}
+
+// MockIRatingSnapshotService is a mock of IRatingSnapshotService interface.
+type MockIRatingSnapshotService struct {
+	ctrl     *gomock.Controller
+	recorder *MockIRatingSnapshotServiceMockRecorder
+}
+
+// MockIRatingSnapshotServiceMockRecorder is the mock recorder for MockIRatingSnapshotService.
+type MockIRatingSnapshotServiceMockRecorder struct {
+	mock *MockIRatingSnapshotService
+}
+
+// NewMockIRatingSnapshotService creates a new mock instance.
+func NewMockIRatingSnapshotService(ctrl *gomock.Controller) *MockIRatingSnapshotService {
+	mock := &MockIRatingSnapshotService{ctrl: ctrl}
+	mock.recorder = &MockIRatingSnapshotServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIRatingSnapshotService) EXPECT() *MockIRatingSnapshotServiceMockRecorder {
+	return m.recorder
+}
+
+// CompleteQuarter mocks base method.
+func (m *MockIRatingSnapshotService) CompleteQuarter(arg0 context.Context, arg1, arg2 int, arg3 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompleteQuarter", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CompleteQuarter indicates an expected call of CompleteQuarter.
+func (mr *MockIRatingSnapshotServiceMockRecorder) CompleteQuarter(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteQuarter", reflect.TypeOf((*MockIRatingSnapshotService)(nil).CompleteQuarter), arg0, arg1, arg2, arg3)
+}
+
+// GetByUserId mocks base method.
+func (m *MockIRatingSnapshotService) GetByUserId(arg0 context.Context, arg1 uuid.UUID, arg2 *domain.Period, arg3 string) ([]*domain.RatingSnapshot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByUserId", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*domain.RatingSnapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByUserId indicates an expected call of GetByUserId.
+func (mr *MockIRatingSnapshotServiceMockRecorder) GetByUserId(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByUserId", reflect.TypeOf((*MockIRatingSnapshotService)(nil).GetByUserId), arg0, arg1, arg2, arg3)
+}
+
+// GetLastCompletedQuarter mocks base method.
+func (m *MockIRatingSnapshotService) GetLastCompletedQuarter(arg0 context.Context, arg1 string) (int, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLastCompletedQuarter", arg0, arg1)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetLastCompletedQuarter indicates an expected call of GetLastCompletedQuarter.
+func (mr *MockIRatingSnapshotServiceMockRecorder) GetLastCompletedQuarter(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLastCompletedQuarter", reflect.TypeOf((*MockIRatingSnapshotService)(nil).GetLastCompletedQuarter), arg0, arg1)
+}
+
+// Save mocks base method.
+func (m *MockIRatingSnapshotService) Save(arg0 context.Context, arg1 *domain.RatingSnapshot) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockIRatingSnapshotServiceMockRecorder) Save(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockIRatingSnapshotService)(nil).Save), arg0, arg1)
+}
@@ -0,0 +1,256 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: domain/review.go
+//
+// Generated by this command:
+//
+//	mockgen -source=domain/review.go -destination=mocks/review.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	domain "ppo/domain"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIReviewRepository is a mock of IReviewRepository interface.
+type MockIReviewRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIReviewRepositoryMockRecorder
+}
+
+// MockIReviewRepositoryMockRecorder is the mock recorder for MockIReviewRepository.
+type MockIReviewRepositoryMockRecorder struct {
+	mock *MockIReviewRepository
+}
+
+// NewMockIReviewRepository creates a new mock instance.
+func NewMockIReviewRepository(ctrl *gomock.Controller) *MockIReviewRepository {
+	mock := &MockIReviewRepository{ctrl: ctrl}
+	mock.recorder = &MockIReviewRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIReviewRepository) EXPECT() *MockIReviewRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockIReviewRepository) Create(arg0 context.Context, arg1 *domain.Review) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockIReviewRepositoryMockRecorder) Create(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockIReviewRepository)(nil).Create), arg0, arg1)
+}
+
+// DeleteById mocks base method.
+func (m *MockIReviewRepository) DeleteById(arg0 context.Context, arg1 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteById", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteById indicates an expected call of DeleteById.
+func (mr *MockIReviewRepositoryMockRecorder) DeleteById(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteById", reflect.TypeOf((*MockIReviewRepository)(nil).DeleteById), arg0, arg1)
+}
+
+// ExistsByReviewer mocks base method.
+func (m *MockIReviewRepository) ExistsByReviewer(arg0 context.Context, arg1, arg2 uuid.UUID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExistsByReviewer", arg0, arg1, arg2)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExistsByReviewer indicates an expected call of ExistsByReviewer.
+func (mr *MockIReviewRepositoryMockRecorder) ExistsByReviewer(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExistsByReviewer", reflect.TypeOf((*MockIReviewRepository)(nil).ExistsByReviewer), arg0, arg1, arg2)
+}
+
+// GetById mocks base method.
+func (m *MockIReviewRepository) GetById(arg0 context.Context, arg1 uuid.UUID) (*domain.Review, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetById", arg0, arg1)
+	ret0, _ := ret[0].(*domain.Review)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetById indicates an expected call of GetById.
+func (mr *MockIReviewRepositoryMockRecorder) GetById(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetById", reflect.TypeOf((*MockIReviewRepository)(nil).GetById), arg0, arg1)
+}
+
+// GetByTarget mocks base method.
+func (m *MockIReviewRepository) GetByTarget(arg0 context.Context, arg1 uuid.UUID, arg2 int) ([]*domain.Review, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByTarget", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*domain.Review)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetByTarget indicates an expected call of GetByTarget.
+func (mr *MockIReviewRepositoryMockRecorder) GetByTarget(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByTarget", reflect.TypeOf((*MockIReviewRepository)(nil).GetByTarget), arg0, arg1, arg2)
+}
+
+// GetStatsByTarget mocks base method.
+func (m *MockIReviewRepository) GetStatsByTarget(arg0 context.Context, arg1 uuid.UUID) (*domain.ReviewStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStatsByTarget", arg0, arg1)
+	ret0, _ := ret[0].(*domain.ReviewStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStatsByTarget indicates an expected call of GetStatsByTarget.
+func (mr *MockIReviewRepositoryMockRecorder) GetStatsByTarget(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStatsByTarget", reflect.TypeOf((*MockIReviewRepository)(nil).GetStatsByTarget), arg0, arg1)
+}
+
+// Update mocks base method.
+func (m *MockIReviewRepository) Update(arg0 context.Context, arg1 *domain.Review) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockIReviewRepositoryMockRecorder) Update(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockIReviewRepository)(nil).Update), arg0, arg1)
+}
+
+// MockIReviewService is a mock of IReviewService interface.
+type MockIReviewService struct {
+	ctrl     *gomock.Controller
+	recorder *MockIReviewServiceMockRecorder
+}
+
+// MockIReviewServiceMockRecorder is the mock recorder for MockIReviewService.
+type MockIReviewServiceMockRecorder struct {
+	mock *MockIReviewService
+}
+
+// NewMockIReviewService creates a new mock instance.
+func NewMockIReviewService(ctrl *gomock.Controller) *MockIReviewService {
+	mock := &MockIReviewService{ctrl: ctrl}
+	mock.recorder = &MockIReviewServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIReviewService) EXPECT() *MockIReviewServiceMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockIReviewService) Create(arg0 context.Context, arg1 *domain.Review) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockIReviewServiceMockRecorder) Create(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockIReviewService)(nil).Create), arg0, arg1)
+}
+
+// DeleteById mocks base method.
+func (m *MockIReviewService) DeleteById(arg0 context.Context, arg1, arg2, arg3 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteById", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteById indicates an expected call of DeleteById.
+func (mr *MockIReviewServiceMockRecorder) DeleteById(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteById", reflect.TypeOf((*MockIReviewService)(nil).DeleteById), arg0, arg1, arg2, arg3)
+}
+
+// GetById mocks base method.
+func (m *MockIReviewService) GetById(arg0 context.Context, arg1 uuid.UUID) (*domain.Review, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetById", arg0, arg1)
+	ret0, _ := ret[0].(*domain.Review)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetById indicates an expected call of GetById.
+func (mr *MockIReviewServiceMockRecorder) GetById(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetById", reflect.TypeOf((*MockIReviewService)(nil).GetById), arg0, arg1)
+}
+
+// GetByTarget mocks base method.
+func (m *MockIReviewService) GetByTarget(arg0 context.Context, arg1 uuid.UUID, arg2 int) ([]*domain.Review, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByTarget", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*domain.Review)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetByTarget indicates an expected call of GetByTarget.
+func (mr *MockIReviewServiceMockRecorder) GetByTarget(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByTarget", reflect.TypeOf((*MockIReviewService)(nil).GetByTarget), arg0, arg1, arg2)
+}
+
+// GetStatsByTarget mocks base method.
+func (m *MockIReviewService) GetStatsByTarget(arg0 context.Context, arg1 uuid.UUID) (*domain.ReviewStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStatsByTarget", arg0, arg1)
+	ret0, _ := ret[0].(*domain.ReviewStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStatsByTarget indicates an expected call of GetStatsByTarget.
+func (mr *MockIReviewServiceMockRecorder) GetStatsByTarget(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStatsByTarget", reflect.TypeOf((*MockIReviewService)(nil).GetStatsByTarget), arg0, arg1)
+}
+
+// Update mocks base method.
+func (m *MockIReviewService) Update(arg0 context.Context, arg1 *domain.Review, arg2 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockIReviewServiceMockRecorder) Update(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockIReviewService)(nil).Update), arg0, arg1, arg2)
+}
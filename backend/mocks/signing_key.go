@@ -0,0 +1,72 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: domain/signing_key.go
+//
+// Generated by this command:
+//
+//	mockgen -source=domain/signing_key.go -destination=mocks/signing_key.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	domain "ppo/domain"
+	reflect "reflect"
+	time "time"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockISigningKeyRepository is a mock of ISigningKeyRepository interface.
+type MockISigningKeyRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockISigningKeyRepositoryMockRecorder
+}
+
+// MockISigningKeyRepositoryMockRecorder is the mock recorder for MockISigningKeyRepository.
+type MockISigningKeyRepositoryMockRecorder struct {
+	mock *MockISigningKeyRepository
+}
+
+// NewMockISigningKeyRepository creates a new mock instance.
+func NewMockISigningKeyRepository(ctrl *gomock.Controller) *MockISigningKeyRepository {
+	mock := &MockISigningKeyRepository{ctrl: ctrl}
+	mock.recorder = &MockISigningKeyRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockISigningKeyRepository) EXPECT() *MockISigningKeyRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetValid mocks base method.
+func (m *MockISigningKeyRepository) GetValid(arg0 context.Context) ([]*domain.SigningKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetValid", arg0)
+	ret0, _ := ret[0].([]*domain.SigningKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetValid indicates an expected call of GetValid.
+func (mr *MockISigningKeyRepositoryMockRecorder) GetValid(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetValid", reflect.TypeOf((*MockISigningKeyRepository)(nil).GetValid), arg0)
+}
+
+// Rotate mocks base method.
+func (m *MockISigningKeyRepository) Rotate(arg0 context.Context, arg1 *domain.SigningKey, arg2, arg3 time.Time) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Rotate", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Rotate indicates an expected call of Rotate.
+func (mr *MockISigningKeyRepositoryMockRecorder) Rotate(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rotate", reflect.TypeOf((*MockISigningKeyRepository)(nil).Rotate), arg0, arg1, arg2, arg3)
+}
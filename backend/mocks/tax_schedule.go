@@ -0,0 +1,239 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: domain/tax_schedule.go
+//
+// Generated by this command:
+//
+//	mockgen -source=domain/tax_schedule.go -destination=mocks/tax_schedule.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	domain "ppo/domain"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockITaxScheduleRepository is a mock of ITaxScheduleRepository interface.
+type MockITaxScheduleRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockITaxScheduleRepositoryMockRecorder
+}
+
+// MockITaxScheduleRepositoryMockRecorder is the mock recorder for MockITaxScheduleRepository.
+type MockITaxScheduleRepositoryMockRecorder struct {
+	mock *MockITaxScheduleRepository
+}
+
+// NewMockITaxScheduleRepository creates a new mock instance.
+func NewMockITaxScheduleRepository(ctrl *gomock.Controller) *MockITaxScheduleRepository {
+	mock := &MockITaxScheduleRepository{ctrl: ctrl}
+	mock.recorder = &MockITaxScheduleRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockITaxScheduleRepository) EXPECT() *MockITaxScheduleRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockITaxScheduleRepository) Create(arg0 context.Context, arg1 *domain.TaxSchedule) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockITaxScheduleRepositoryMockRecorder) Create(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockITaxScheduleRepository)(nil).Create), arg0, arg1)
+}
+
+// DeleteById mocks base method.
+func (m *MockITaxScheduleRepository) DeleteById(arg0 context.Context, arg1 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteById", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteById indicates an expected call of DeleteById.
+func (mr *MockITaxScheduleRepositoryMockRecorder) DeleteById(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteById", reflect.TypeOf((*MockITaxScheduleRepository)(nil).DeleteById), arg0, arg1)
+}
+
+// GetAll mocks base method.
+func (m *MockITaxScheduleRepository) GetAll(arg0 context.Context) ([]*domain.TaxSchedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", arg0)
+	ret0, _ := ret[0].([]*domain.TaxSchedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockITaxScheduleRepositoryMockRecorder) GetAll(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockITaxScheduleRepository)(nil).GetAll), arg0)
+}
+
+// GetById mocks base method.
+func (m *MockITaxScheduleRepository) GetById(arg0 context.Context, arg1 uuid.UUID) (*domain.TaxSchedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetById", arg0, arg1)
+	ret0, _ := ret[0].(*domain.TaxSchedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetById indicates an expected call of GetById.
+func (mr *MockITaxScheduleRepositoryMockRecorder) GetById(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetById", reflect.TypeOf((*MockITaxScheduleRepository)(nil).GetById), arg0, arg1)
+}
+
+// GetByYear mocks base method.
+func (m *MockITaxScheduleRepository) GetByYear(arg0 context.Context, arg1 int, arg2 string) (*domain.TaxSchedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByYear", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*domain.TaxSchedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByYear indicates an expected call of GetByYear.
+func (mr *MockITaxScheduleRepositoryMockRecorder) GetByYear(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByYear", reflect.TypeOf((*MockITaxScheduleRepository)(nil).GetByYear), arg0, arg1, arg2)
+}
+
+// Update mocks base method.
+func (m *MockITaxScheduleRepository) Update(arg0 context.Context, arg1 *domain.TaxSchedule) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockITaxScheduleRepositoryMockRecorder) Update(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockITaxScheduleRepository)(nil).Update), arg0, arg1)
+}
+
+// MockITaxScheduleService is a mock of ITaxScheduleService interface.
+type MockITaxScheduleService struct {
+	ctrl     *gomock.Controller
+	recorder *MockITaxScheduleServiceMockRecorder
+}
+
+// MockITaxScheduleServiceMockRecorder is the mock recorder for MockITaxScheduleService.
+type MockITaxScheduleServiceMockRecorder struct {
+	mock *MockITaxScheduleService
+}
+
+// NewMockITaxScheduleService creates a new mock instance.
+func NewMockITaxScheduleService(ctrl *gomock.Controller) *MockITaxScheduleService {
+	mock := &MockITaxScheduleService{ctrl: ctrl}
+	mock.recorder = &MockITaxScheduleServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockITaxScheduleService) EXPECT() *MockITaxScheduleServiceMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockITaxScheduleService) Create(arg0 context.Context, arg1 *domain.TaxSchedule) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockITaxScheduleServiceMockRecorder) Create(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockITaxScheduleService)(nil).Create), arg0, arg1)
+}
+
+// DeleteById mocks base method.
+func (m *MockITaxScheduleService) DeleteById(arg0 context.Context, arg1 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteById", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteById indicates an expected call of DeleteById.
+func (mr *MockITaxScheduleServiceMockRecorder) DeleteById(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteById", reflect.TypeOf((*MockITaxScheduleService)(nil).DeleteById), arg0, arg1)
+}
+
+// GetAll mocks base method.
+func (m *MockITaxScheduleService) GetAll(arg0 context.Context) ([]*domain.TaxSchedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", arg0)
+	ret0, _ := ret[0].([]*domain.TaxSchedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockITaxScheduleServiceMockRecorder) GetAll(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockITaxScheduleService)(nil).GetAll), arg0)
+}
+
+// GetById mocks base method.
+func (m *MockITaxScheduleService) GetById(arg0 context.Context, arg1 uuid.UUID) (*domain.TaxSchedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetById", arg0, arg1)
+	ret0, _ := ret[0].(*domain.TaxSchedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetById indicates an expected call of GetById.
+func (mr *MockITaxScheduleServiceMockRecorder) GetById(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetById", reflect.TypeOf((*MockITaxScheduleService)(nil).GetById), arg0, arg1)
+}
+
+// GetByYear mocks base method.
+func (m *MockITaxScheduleService) GetByYear(arg0 context.Context, arg1 int, arg2 string) (*domain.TaxSchedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByYear", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*domain.TaxSchedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByYear indicates an expected call of GetByYear.
+func (mr *MockITaxScheduleServiceMockRecorder) GetByYear(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByYear", reflect.TypeOf((*MockITaxScheduleService)(nil).GetByYear), arg0, arg1, arg2)
+}
+
+// Update mocks base method.
+func (m *MockITaxScheduleService) Update(arg0 context.Context, arg1 *domain.TaxSchedule) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockITaxScheduleServiceMockRecorder) Update(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockITaxScheduleService)(nil).Update), arg0, arg1)
+}
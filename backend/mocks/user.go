@@ -15,6 +15,7 @@ import (
 	reflect "reflect"
 
 	uuid "github.com/google/uuid"
+	decimal "github.com/shopspring/decimal"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -70,9 +71,9 @@ func (mr *MockIUserRepositoryMockRecorder) DeleteById(arg0, arg1 any) *gomock.Ca
 }
 
 // GetAll mocks base method.
-func (m *MockIUserRepository) GetAll(arg0 context.Context, arg1 int) ([]*domain.User, int, error) {
+func (m *MockIUserRepository) GetAll(arg0 context.Context, arg1 *domain.UserFilter, arg2 int) ([]*domain.User, int, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAll", arg0, arg1)
+	ret := m.ctrl.Call(m, "GetAll", arg0, arg1, arg2)
 	ret0, _ := ret[0].([]*domain.User)
 	ret1, _ := ret[1].(int)
 	ret2, _ := ret[2].(error)
@@ -80,9 +81,9 @@ func (m *MockIUserRepository) GetAll(arg0 context.Context, arg1 int) ([]*domain.
 }
 
 // GetAll indicates an expected call of GetAll.
-func (mr *MockIUserRepositoryMockRecorder) GetAll(arg0, arg1 any) *gomock.Call {
+func (mr *MockIUserRepositoryMockRecorder) GetAll(arg0, arg1, arg2 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockIUserRepository)(nil).GetAll), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockIUserRepository)(nil).GetAll), arg0, arg1, arg2)
 }
 
 // GetById mocks base method.
@@ -115,6 +116,66 @@ func (mr *MockIUserRepositoryMockRecorder) GetByUsername(arg0, arg1 any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByUsername", reflect.TypeOf((*MockIUserRepository)(nil).GetByUsername), arg0, arg1)
 }
 
+// GetEntrepreneurIds mocks base method.
+func (m *MockIUserRepository) GetEntrepreneurIds(arg0 context.Context) ([]uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEntrepreneurIds", arg0)
+	ret0, _ := ret[0].([]uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEntrepreneurIds indicates an expected call of GetEntrepreneurIds.
+func (mr *MockIUserRepositoryMockRecorder) GetEntrepreneurIds(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEntrepreneurIds", reflect.TypeOf((*MockIUserRepository)(nil).GetEntrepreneurIds), arg0)
+}
+
+// GetInfluence mocks base method.
+func (m *MockIUserRepository) GetInfluence(arg0 context.Context, arg1 uuid.UUID, arg2 *domain.Period) ([]*domain.ActivityFieldInfluence, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInfluence", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*domain.ActivityFieldInfluence)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetInfluence indicates an expected call of GetInfluence.
+func (mr *MockIUserRepositoryMockRecorder) GetInfluence(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInfluence", reflect.TypeOf((*MockIUserRepository)(nil).GetInfluence), arg0, arg1, arg2)
+}
+
+// GetMaxRevenue mocks base method.
+func (m *MockIUserRepository) GetMaxRevenue(arg0 context.Context, arg1 *domain.Period) (decimal.Decimal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMaxRevenue", arg0, arg1)
+	ret0, _ := ret[0].(decimal.Decimal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMaxRevenue indicates an expected call of GetMaxRevenue.
+func (mr *MockIUserRepositoryMockRecorder) GetMaxRevenue(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMaxRevenue", reflect.TypeOf((*MockIUserRepository)(nil).GetMaxRevenue), arg0, arg1)
+}
+
+// GetRatingData mocks base method.
+func (m *MockIUserRepository) GetRatingData(arg0 context.Context, arg1 *domain.Period, arg2 *domain.UserFilter) ([]*domain.UserRatingData, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRatingData", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*domain.UserRatingData)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRatingData indicates an expected call of GetRatingData.
+func (mr *MockIUserRepositoryMockRecorder) GetRatingData(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRatingData", reflect.TypeOf((*MockIUserRepository)(nil).GetRatingData), arg0, arg1, arg2)
+}
+
 // Update mocks base method.
 func (m *MockIUserRepository) Update(arg0 context.Context, arg1 *domain.User) error {
 	m.ctrl.T.Helper()
@@ -181,9 +242,9 @@ func (mr *MockIUserServiceMockRecorder) DeleteById(arg0, arg1 any) *gomock.Call
 }
 
 // GetAll mocks base method.
-func (m *MockIUserService) GetAll(arg0 context.Context, arg1 int) ([]*domain.User, int, error) {
+func (m *MockIUserService) GetAll(arg0 context.Context, arg1 *domain.UserFilter, arg2 int) ([]*domain.User, int, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAll", arg0, arg1)
+	ret := m.ctrl.Call(m, "GetAll", arg0, arg1, arg2)
 	ret0, _ := ret[0].([]*domain.User)
 	ret1, _ := ret[1].(int)
 	ret2, _ := ret[2].(error)
@@ -191,9 +252,9 @@ func (m *MockIUserService) GetAll(arg0 context.Context, arg1 int) ([]*domain.Use
 }
 
 // GetAll indicates an expected call of GetAll.
-func (mr *MockIUserServiceMockRecorder) GetAll(arg0, arg1 any) *gomock.Call {
+func (mr *MockIUserServiceMockRecorder) GetAll(arg0, arg1, arg2 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockIUserService)(nil).GetAll), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockIUserService)(nil).GetAll), arg0, arg1, arg2)
 }
 
 // GetById mocks base method.
@@ -226,6 +287,66 @@ func (mr *MockIUserServiceMockRecorder) GetByUsername(arg0, arg1 any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByUsername", reflect.TypeOf((*MockIUserService)(nil).GetByUsername), arg0, arg1)
 }
 
+// GetEntrepreneurIds mocks base method.
+func (m *MockIUserService) GetEntrepreneurIds(arg0 context.Context) ([]uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEntrepreneurIds", arg0)
+	ret0, _ := ret[0].([]uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEntrepreneurIds indicates an expected call of GetEntrepreneurIds.
+func (mr *MockIUserServiceMockRecorder) GetEntrepreneurIds(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEntrepreneurIds", reflect.TypeOf((*MockIUserService)(nil).GetEntrepreneurIds), arg0)
+}
+
+// GetInfluence mocks base method.
+func (m *MockIUserService) GetInfluence(arg0 context.Context, arg1 uuid.UUID, arg2 *domain.Period) ([]*domain.ActivityFieldInfluence, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInfluence", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*domain.ActivityFieldInfluence)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetInfluence indicates an expected call of GetInfluence.
+func (mr *MockIUserServiceMockRecorder) GetInfluence(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInfluence", reflect.TypeOf((*MockIUserService)(nil).GetInfluence), arg0, arg1, arg2)
+}
+
+// GetMaxRevenue mocks base method.
+func (m *MockIUserService) GetMaxRevenue(arg0 context.Context, arg1 *domain.Period) (decimal.Decimal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMaxRevenue", arg0, arg1)
+	ret0, _ := ret[0].(decimal.Decimal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMaxRevenue indicates an expected call of GetMaxRevenue.
+func (mr *MockIUserServiceMockRecorder) GetMaxRevenue(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMaxRevenue", reflect.TypeOf((*MockIUserService)(nil).GetMaxRevenue), arg0, arg1)
+}
+
+// GetRatingData mocks base method.
+func (m *MockIUserService) GetRatingData(arg0 context.Context, arg1 *domain.Period, arg2 *domain.UserFilter) ([]*domain.UserRatingData, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRatingData", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*domain.UserRatingData)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRatingData indicates an expected call of GetRatingData.
+func (mr *MockIUserServiceMockRecorder) GetRatingData(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRatingData", reflect.TypeOf((*MockIUserService)(nil).GetRatingData), arg0, arg1, arg2)
+}
+
 // Update mocks base method.
 func (m *MockIUserService) Update(arg0 context.Context, arg1 *domain.User) error {
 	m.ctrl.T.Helper()
@@ -42,18 +42,18 @@ func (m *MockIInteractor) EXPECT() *MockIInteractorMockRecorder {
 }
 
 // CalculateUserRating mocks base method.
-func (m *MockIInteractor) CalculateUserRating(arg0 context.Context, arg1 uuid.UUID) (float32, error) {
+func (m *MockIInteractor) CalculateUserRating(arg0 context.Context, arg1 uuid.UUID, arg2 *domain.Period, arg3 string) (*domain.RatingBreakdown, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CalculateUserRating", arg0, arg1)
-	ret0, _ := ret[0].(float32)
+	ret := m.ctrl.Call(m, "CalculateUserRating", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*domain.RatingBreakdown)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CalculateUserRating indicates an expected call of CalculateUserRating.
-func (mr *MockIInteractorMockRecorder) CalculateUserRating(arg0, arg1 any) *gomock.Call {
+func (mr *MockIInteractorMockRecorder) CalculateUserRating(arg0, arg1, arg2, arg3 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CalculateUserRating", reflect.TypeOf((*MockIInteractor)(nil).CalculateUserRating), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CalculateUserRating", reflect.TypeOf((*MockIInteractor)(nil).CalculateUserRating), arg0, arg1, arg2, arg3)
 }
 
 // GetMostProfitableCompany mocks base method.
@@ -71,6 +71,22 @@ func (mr *MockIInteractorMockRecorder) GetMostProfitableCompany(arg0, arg1, arg2
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMostProfitableCompany", reflect.TypeOf((*MockIInteractor)(nil).GetMostProfitableCompany), arg0, arg1, arg2)
 }
 
+// GetRanking mocks base method.
+func (m *MockIInteractor) GetRanking(arg0 context.Context, arg1 *domain.UserFilter, arg2 *domain.Period, arg3 int, arg4 string) ([]*domain.UserRating, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRanking", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].([]*domain.UserRating)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetRanking indicates an expected call of GetRanking.
+func (mr *MockIInteractorMockRecorder) GetRanking(arg0, arg1, arg2, arg3, arg4 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRanking", reflect.TypeOf((*MockIInteractor)(nil).GetRanking), arg0, arg1, arg2, arg3, arg4)
+}
+
 // GetUserFinancialReport mocks base method.
 func (m *MockIInteractor) GetUserFinancialReport(arg0 context.Context, arg1 uuid.UUID, arg2 *domain.Period) (*domain.FinancialReportByPeriod, error) {
 	m.ctrl.T.Helper()
@@ -85,3 +101,48 @@ func (mr *MockIInteractorMockRecorder) GetUserFinancialReport(arg0, arg1, arg2 a
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserFinancialReport", reflect.TypeOf((*MockIInteractor)(nil).GetUserFinancialReport), arg0, arg1, arg2)
 }
+
+// GetUserInfluence mocks base method.
+func (m *MockIInteractor) GetUserInfluence(arg0 context.Context, arg1 uuid.UUID, arg2 *domain.Period) ([]*domain.ActivityFieldInfluence, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserInfluence", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*domain.ActivityFieldInfluence)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserInfluence indicates an expected call of GetUserInfluence.
+func (mr *MockIInteractorMockRecorder) GetUserInfluence(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserInfluence", reflect.TypeOf((*MockIInteractor)(nil).GetUserInfluence), arg0, arg1, arg2)
+}
+
+// ResolvePeriod mocks base method.
+func (m *MockIInteractor) ResolvePeriod(arg0 string) (*domain.Period, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolvePeriod", arg0)
+	ret0, _ := ret[0].(*domain.Period)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResolvePeriod indicates an expected call of ResolvePeriod.
+func (mr *MockIInteractorMockRecorder) ResolvePeriod(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolvePeriod", reflect.TypeOf((*MockIInteractor)(nil).ResolvePeriod), arg0)
+}
+
+// ResolveStrategy mocks base method.
+func (m *MockIInteractor) ResolveStrategy(arg0 string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveStrategy", arg0)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResolveStrategy indicates an expected call of ResolveStrategy.
+func (mr *MockIInteractorMockRecorder) ResolveStrategy(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveStrategy", reflect.TypeOf((*MockIInteractor)(nil).ResolveStrategy), arg0)
+}
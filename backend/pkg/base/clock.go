@@ -0,0 +1,18 @@
+package base
+
+import "time"
+
+// IClock - источник текущего времени; в тестах подменяется фиксированным.
+type IClock interface {
+	Now() time.Time
+}
+
+type systemClock struct{}
+
+func NewSystemClock() IClock {
+	return systemClock{}
+}
+
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
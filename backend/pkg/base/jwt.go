@@ -1,26 +1,149 @@
 package base
 
 import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
 	"fmt"
 	"github.com/golang-jwt/jwt/v5"
+	"math/big"
 	"time"
 )
 
+const (
+	AlgEdDSA = "EdDSA"
+	AlgRS256 = "RS256"
+
+	rsaKeyBits = 2048
+)
+
 type JwtPayload struct {
-	ID   string
-	Role string
+	ID        string
+	Role      string
+	SessionID string
 }
 
-func GenerateAuthToken(id, jwtKey, role string) (tokenString string, err error) {
-	token := jwt.NewWithClaims(
-		jwt.SigningMethodHS256,
-		jwt.MapClaims{
-			"sub":  id,
-			"exp":  time.Now().Add(time.Hour * 24).Unix(),
-			"role": role,
-		})
+// JwtKey - ключ подписи токенов. ID попадает в заголовок токена как kid.
+type JwtKey struct {
+	ID         string
+	Algorithm  string
+	PrivateKey crypto.Signer
+}
+
+// IJwtKeySet - набор ключей: токены подписываются ключом SigningKey, а проверяются любым
+// из VerificationKeys, в том числе выведенными из оборота, пока выданные ими токены не истекли.
+type IJwtKeySet interface {
+	SigningKey() (*JwtKey, error)
+	VerificationKeys() []*JwtKey
+}
+
+func signingMethod(algorithm string) (method jwt.SigningMethod, err error) {
+	switch algorithm {
+	case AlgEdDSA:
+		return jwt.SigningMethodEdDSA, nil
+	case AlgRS256:
+		return jwt.SigningMethodRS256, nil
+	default:
+		return nil, fmt.Errorf("неподдерживаемый алгоритм подписи: %s", algorithm)
+	}
+}
+
+func GenerateJwtKey(algorithm string) (key *JwtKey, err error) {
+	var signer crypto.Signer
+	switch algorithm {
+	case AlgEdDSA:
+		_, signer, err = ed25519.GenerateKey(rand.Reader)
+	case AlgRS256:
+		signer, err = rsa.GenerateKey(rand.Reader, rsaKeyBits)
+	default:
+		return nil, fmt.Errorf("неподдерживаемый алгоритм подписи: %s", algorithm)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("генерация ключа подписи: %w", err)
+	}
+
+	kid := make([]byte, 8)
+	_, err = rand.Read(kid)
+	if err != nil {
+		return nil, fmt.Errorf("генерация идентификатора ключа: %w", err)
+	}
 
-	tokenString, err = token.SignedString([]byte(jwtKey))
+	return &JwtKey{
+		ID:         hex.EncodeToString(kid),
+		Algorithm:  algorithm,
+		PrivateKey: signer,
+	}, nil
+}
+
+// MarshalJwtPrivateKey кодирует закрытый ключ в PKCS #8 DER для хранения.
+func MarshalJwtPrivateKey(key *JwtKey) (der []byte, err error) {
+	der, err = x509.MarshalPKCS8PrivateKey(key.PrivateKey)
+	if err != nil {
+		return nil, fmt.Errorf("кодирование ключа подписи: %w", err)
+	}
+
+	return der, nil
+}
+
+func ParseJwtPrivateKey(id, algorithm string, der []byte) (key *JwtKey, err error) {
+	parsed, err := x509.ParsePKCS8PrivateKey(der)
+	if err != nil {
+		return nil, fmt.Errorf("декодирование ключа подписи %s: %w", id, err)
+	}
+
+	signer, ok := parsed.(crypto.Signer)
+	if !ok {
+		return nil, fmt.Errorf("ключ %s не может использоваться для подписи", id)
+	}
+
+	return &JwtKey{
+		ID:         id,
+		Algorithm:  algorithm,
+		PrivateKey: signer,
+	}, nil
+}
+
+// JWK возвращает открытую часть ключа в формате JSON Web Key (RFC 7517).
+func (k *JwtKey) JWK() map[string]string {
+	jwk := map[string]string{
+		"kid": k.ID,
+		"alg": k.Algorithm,
+		"use": "sig",
+	}
+
+	switch pub := k.PrivateKey.Public().(type) {
+	case ed25519.PublicKey:
+		jwk["kty"] = "OKP"
+		jwk["crv"] = "Ed25519"
+		jwk["x"] = base64.RawURLEncoding.EncodeToString(pub)
+	case *rsa.PublicKey:
+		jwk["kty"] = "RSA"
+		jwk["n"] = base64.RawURLEncoding.EncodeToString(pub.N.Bytes())
+		jwk["e"] = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(pub.E)).Bytes())
+	}
+
+	return jwk
+}
+
+func signToken(claims jwt.MapClaims, keys IJwtKeySet) (tokenString string, err error) {
+	key, err := keys.SigningKey()
+	if err != nil {
+		return "", fmt.Errorf("получение ключа подписи: %w", err)
+	}
+
+	method, err := signingMethod(key.Algorithm)
+	if err != nil {
+		return "", err
+	}
+
+	token := jwt.NewWithClaims(method, claims)
+	token.Header["kid"] = key.ID
+
+	tokenString, err = token.SignedString(key.PrivateKey)
 	if err != nil {
 		return "", fmt.Errorf("формирование JWT-ключа: %w", err)
 	}
@@ -28,9 +151,23 @@ func GenerateAuthToken(id, jwtKey, role string) (tokenString string, err error)
 	return tokenString, nil
 }
 
-func VerifyAuthToken(tokenString, jwtKey string) (payload *JwtPayload, err error) {
+func parseToken(tokenString string, keys IJwtKeySet) (claims jwt.MapClaims, err error) {
 	token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
-		return []byte(jwtKey), nil
+		kid, _ := token.Header["kid"].(string)
+		for _, key := range keys.VerificationKeys() {
+			if key.ID != kid {
+				continue
+			}
+
+			// алгоритм берется из ключа, а не из заголовка токена
+			if token.Method.Alg() != key.Algorithm {
+				return nil, fmt.Errorf("алгоритм токена не совпадает с алгоритмом ключа")
+			}
+
+			return key.PrivateKey.Public(), nil
+		}
+
+		return nil, fmt.Errorf("неизвестный ключ подписи: %s", kid)
 	})
 
 	if err != nil {
@@ -41,11 +178,69 @@ func VerifyAuthToken(tokenString, jwtKey string) (payload *JwtPayload, err error
 		return nil, fmt.Errorf("токен невалидный")
 	}
 
-	payload = new(JwtPayload)
-	if claims, ok := token.Claims.(jwt.MapClaims); ok {
-		payload.ID = fmt.Sprint(claims["sub"])
-		payload.Role = fmt.Sprint(claims["role"])
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok {
+		return nil, fmt.Errorf("токен невалидный")
+	}
+
+	return claims, nil
+}
+
+func GenerateAuthToken(id, role, sessionId string, ttl time.Duration, keys IJwtKeySet) (tokenString string, err error) {
+	return signToken(
+		jwt.MapClaims{
+			"sub":  id,
+			"exp":  time.Now().Add(ttl).Unix(),
+			"role": role,
+			"sid":  sessionId,
+		},
+		keys,
+	)
+}
+
+func VerifyAuthToken(tokenString string, keys IJwtKeySet) (payload *JwtPayload, err error) {
+	claims, err := parseToken(tokenString, keys)
+	if err != nil {
+		return nil, err
+	}
+
+	if claims["typ"] != nil {
+		return nil, fmt.Errorf("токен не является access-токеном")
+	}
+
+	payload = &JwtPayload{
+		ID:        fmt.Sprint(claims["sub"]),
+		Role:      fmt.Sprint(claims["role"]),
+		SessionID: fmt.Sprint(claims["sid"]),
 	}
 
 	return payload, nil
 }
+
+const mfaPendingTokenType = "mfa_pending"
+
+// GenerateMfaPendingToken выдает токен входа, ожидающего второго фактора. Сессии и роли в нем нет,
+// поэтому доступа к API он не дает и принимается только при проверке кода.
+func GenerateMfaPendingToken(id string, ttl time.Duration, keys IJwtKeySet) (tokenString string, err error) {
+	return signToken(
+		jwt.MapClaims{
+			"sub": id,
+			"exp": time.Now().Add(ttl).Unix(),
+			"typ": mfaPendingTokenType,
+		},
+		keys,
+	)
+}
+
+func VerifyMfaPendingToken(tokenString string, keys IJwtKeySet) (id string, err error) {
+	claims, err := parseToken(tokenString, keys)
+	if err != nil {
+		return "", err
+	}
+
+	if claims["typ"] != mfaPendingTokenType {
+		return "", fmt.Errorf("токен не является токеном ожидания второго фактора")
+	}
+
+	return fmt.Sprint(claims["sub"]), nil
+}
@@ -0,0 +1,80 @@
+package base
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+type keySet struct {
+	signing      *JwtKey
+	verification []*JwtKey
+}
+
+func (k *keySet) SigningKey() (*JwtKey, error) {
+	return k.signing, nil
+}
+
+func (k *keySet) VerificationKeys() []*JwtKey {
+	return k.verification
+}
+
+func TestVerifyAuthToken(t *testing.T) {
+	for _, alg := range []string{AlgEdDSA, AlgRS256} {
+		t.Run(alg, func(t *testing.T) {
+			oldKey, err := GenerateJwtKey(alg)
+			require.Nil(t, err)
+			newKey, err := GenerateJwtKey(alg)
+			require.Nil(t, err)
+
+			oldToken, err := GenerateAuthToken("user", "admin", "session", time.Minute, &keySet{signing: oldKey})
+			require.Nil(t, err)
+
+			// после ротации токен, подписанный прежним ключом, проверяется, пока ключ в наборе
+			rotated := &keySet{signing: newKey, verification: []*JwtKey{newKey, oldKey}}
+			payload, err := VerifyAuthToken(oldToken, rotated)
+			require.Nil(t, err)
+			require.Equal(t, "user", payload.ID)
+			require.Equal(t, "admin", payload.Role)
+			require.Equal(t, "session", payload.SessionID)
+
+			_, err = VerifyAuthToken(oldToken, &keySet{signing: newKey, verification: []*JwtKey{newKey}})
+			require.NotNil(t, err)
+		})
+	}
+}
+
+func TestJwtPrivateKeyRoundTrip(t *testing.T) {
+	key, err := GenerateJwtKey(AlgEdDSA)
+	require.Nil(t, err)
+
+	der, err := MarshalJwtPrivateKey(key)
+	require.Nil(t, err)
+
+	parsed, err := ParseJwtPrivateKey(key.ID, key.Algorithm, der)
+	require.Nil(t, err)
+	require.Equal(t, key.JWK(), parsed.JWK())
+}
+
+func TestMfaPendingToken(t *testing.T) {
+	key, err := GenerateJwtKey(AlgEdDSA)
+	require.Nil(t, err)
+	keys := &keySet{signing: key, verification: []*JwtKey{key}}
+
+	pending, err := GenerateMfaPendingToken("user", time.Minute, keys)
+	require.Nil(t, err)
+
+	id, err := VerifyMfaPendingToken(pending, keys)
+	require.Nil(t, err)
+	require.Equal(t, "user", id)
+
+	// токены не взаимозаменяемы
+	_, err = VerifyAuthToken(pending, keys)
+	require.NotNil(t, err)
+
+	access, err := GenerateAuthToken("user", "user", "session", time.Minute, keys)
+	require.Nil(t, err)
+
+	_, err = VerifyMfaPendingToken(access, keys)
+	require.NotNil(t, err)
+}
@@ -0,0 +1,106 @@
+package base
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// Длины идентификаторов в ЕГРЮЛ/ЕГРИП: ИНН и ОГРН юридического лица, ИНН и ОГРНИП индивидуального предпринимателя.
+const (
+	LegalEntityINNLength = 10
+	PersonINNLength      = 12
+	OGRNLength           = 13
+	OGRNIPLength         = 15
+)
+
+var (
+	innFirstWeights  = []int{2, 4, 10, 3, 5, 9, 4, 6, 8}
+	innSecondWeights = []int{7, 2, 4, 10, 3, 5, 9, 4, 6, 8}
+	innThirdWeights  = []int{3, 7, 2, 4, 10, 3, 5, 9, 4, 6, 8}
+
+	// КПП: код налогового органа, причина постановки на учет (цифры или заглавные латинские буквы), порядковый номер
+	kppPattern = regexp.MustCompile(`^\d{4}[\dA-Z]{2}\d{3}$`)
+)
+
+func parseDigits(s string) (digits []int, ok bool) {
+	digits = make([]int, len(s))
+	for i, c := range s {
+		if c < '0' || c > '9' {
+			return nil, false
+		}
+		digits[i] = int(c - '0')
+	}
+
+	return digits, true
+}
+
+// innCheckDigit - контрольная цифра ИНН: взвешенная сумма предыдущих цифр по модулю 11, затем по модулю 10.
+func innCheckDigit(digits, weights []int) int {
+	var sum int
+	for i, w := range weights {
+		sum += digits[i] * w
+	}
+
+	return sum % 11 % 10
+}
+
+func ValidateINN(inn string) (err error) {
+	digits, ok := parseDigits(inn)
+	if !ok {
+		return fmt.Errorf("ИНН должен состоять из цифр")
+	}
+
+	switch len(digits) {
+	case LegalEntityINNLength:
+		if innCheckDigit(digits, innFirstWeights) != digits[9] {
+			return fmt.Errorf("неверная контрольная цифра ИНН")
+		}
+	case PersonINNLength:
+		if innCheckDigit(digits, innSecondWeights) != digits[10] || innCheckDigit(digits, innThirdWeights) != digits[11] {
+			return fmt.Errorf("неверная контрольная цифра ИНН")
+		}
+	default:
+		return fmt.Errorf("ИНН должен состоять из %d или %d цифр", LegalEntityINNLength, PersonINNLength)
+	}
+
+	return nil
+}
+
+// ValidateOGRN проверяет ОГРН (13 цифр) или ОГРНИП (15 цифр): контрольная цифра - остаток от деления
+// числа из предыдущих цифр на 11 (для ОГРНИП - на 13), взятый по модулю 10.
+func ValidateOGRN(ogrn string) (err error) {
+	digits, ok := parseDigits(ogrn)
+	if !ok {
+		return fmt.Errorf("ОГРН должен состоять из цифр")
+	}
+
+	var divisor int
+	switch len(digits) {
+	case OGRNLength:
+		divisor = 11
+	case OGRNIPLength:
+		divisor = 13
+	default:
+		return fmt.Errorf("ОГРН должен состоять из %d цифр, ОГРНИП - из %d", OGRNLength, OGRNIPLength)
+	}
+
+	// остаток считается по цифрам, чтобы не переполнить int64 на 14-значном числе
+	var rem int
+	for _, d := range digits[:len(digits)-1] {
+		rem = (rem*10 + d) % divisor
+	}
+
+	if rem%10 != digits[len(digits)-1] {
+		return fmt.Errorf("неверная контрольная цифра ОГРН")
+	}
+
+	return nil
+}
+
+func ValidateKPP(kpp string) (err error) {
+	if !kppPattern.MatchString(kpp) {
+		return fmt.Errorf("КПП должен состоять из 9 символов: 4 цифры, 2 цифры или заглавные латинские буквы, 3 цифры")
+	}
+
+	return nil
+}
@@ -0,0 +1,33 @@
+package base
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestValidateINN(t *testing.T) {
+	require.Nil(t, ValidateINN("7707083893"))
+	require.Nil(t, ValidateINN("500123456750"))
+
+	require.NotNil(t, ValidateINN("7707083894"))
+	require.NotNil(t, ValidateINN("500123456751"))
+	require.NotNil(t, ValidateINN("77070838"))
+	require.NotNil(t, ValidateINN("77070838a3"))
+}
+
+func TestValidateOGRN(t *testing.T) {
+	require.Nil(t, ValidateOGRN("1027700132195"))
+	require.Nil(t, ValidateOGRN("318502700123455"))
+
+	require.NotNil(t, ValidateOGRN("1027700132194"))
+	require.NotNil(t, ValidateOGRN("318502700123456"))
+	require.NotNil(t, ValidateOGRN("10277001321"))
+}
+
+func TestValidateKPP(t *testing.T) {
+	require.Nil(t, ValidateKPP("773601001"))
+	require.Nil(t, ValidateKPP("7736AB001"))
+
+	require.NotNil(t, ValidateKPP("77360100"))
+	require.NotNil(t, ValidateKPP("7736ab001"))
+}
@@ -0,0 +1,33 @@
+package base
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+)
+
+const tokenLength = 32
+
+// GenerateToken возвращает случайный непрозрачный токен и его хэш, который и следует хранить в БД.
+func GenerateToken() (token, hash string, err error) {
+	buf := make([]byte, tokenLength)
+
+	_, err = rand.Read(buf)
+	if err != nil {
+		return "", "", fmt.Errorf("генерация случайного токена: %w", err)
+	}
+
+	token = base64.RawURLEncoding.EncodeToString(buf)
+
+	return token, HashToken(token), nil
+}
+
+// HashToken - хэш токена для поиска в БД. Токены случайны и достаточно длинны,
+// поэтому медленный хэш, как для паролей, не требуется.
+func HashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,124 @@
+package base
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Параметры TOTP (RFC 6238) выбраны так, как их по умолчанию ожидают приложения-аутентификаторы.
+const (
+	totpSecretLength = 20
+	totpDigits       = 6
+	totpPeriod       = 30 * time.Second
+	// допускается расхождение часов клиента и сервера на один шаг в каждую сторону
+	totpSkew = 1
+
+	recoveryCodeLength = 10
+)
+
+var totpEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+func GenerateTOTPSecret() (secret string, err error) {
+	buf := make([]byte, totpSecretLength)
+
+	_, err = rand.Read(buf)
+	if err != nil {
+		return "", fmt.Errorf("генерация секрета TOTP: %w", err)
+	}
+
+	return totpEncoding.EncodeToString(buf), nil
+}
+
+// TOTPURI - ссылка otpauth://, которую приложение-аутентификатор считывает из QR-кода.
+func TOTPURI(issuer, account, secret string) string {
+	query := url.Values{}
+	query.Set("secret", secret)
+	query.Set("issuer", issuer)
+	query.Set("algorithm", "SHA1")
+	query.Set("digits", fmt.Sprint(totpDigits))
+	query.Set("period", fmt.Sprint(int(totpPeriod.Seconds())))
+
+	return (&url.URL{
+		Scheme:   "otpauth",
+		Host:     "totp",
+		Path:     "/" + issuer + ":" + account,
+		RawQuery: query.Encode(),
+	}).String()
+}
+
+func totpStep(t time.Time) int64 {
+	return t.Unix() / int64(totpPeriod.Seconds())
+}
+
+func totpCode(key []byte, step int64) string {
+	msg := make([]byte, 8)
+	binary.BigEndian.PutUint64(msg, uint64(step))
+
+	mac := hmac.New(sha1.New, key)
+	mac.Write(msg)
+	sum := mac.Sum(nil)
+
+	// динамическое усечение, RFC 4226, раздел 5.3
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	mod := uint32(1)
+	for i := 0; i < totpDigits; i++ {
+		mod *= 10
+	}
+
+	return fmt.Sprintf("%0*d", totpDigits, value%mod)
+}
+
+// ValidateTOTP проверяет код на момент t и возвращает шаг, которому он соответствует:
+// повторно предъявленный код того же шага следует отклонять.
+func ValidateTOTP(secret, code string, t time.Time) (step int64, ok bool) {
+	key, err := totpEncoding.DecodeString(strings.ToUpper(secret))
+	if err != nil || len(code) != totpDigits {
+		return 0, false
+	}
+
+	current := totpStep(t)
+	for i := -totpSkew; i <= totpSkew; i++ {
+		candidate := totpCode(key, current+int64(i))
+		if subtle.ConstantTimeCompare([]byte(candidate), []byte(code)) == 1 {
+			return current + int64(i), true
+		}
+	}
+
+	return 0, false
+}
+
+// GenerateRecoveryCodes возвращает n одноразовых кодов восстановления вида XXXXX-XXXXX и их хэши.
+func GenerateRecoveryCodes(n int) (codes, hashes []string, err error) {
+	codes = make([]string, n)
+	hashes = make([]string, n)
+
+	for i := range codes {
+		buf := make([]byte, recoveryCodeLength*5/8)
+
+		_, err = rand.Read(buf)
+		if err != nil {
+			return nil, nil, fmt.Errorf("генерация кода восстановления: %w", err)
+		}
+
+		code := totpEncoding.EncodeToString(buf)
+		codes[i] = code[:recoveryCodeLength/2] + "-" + code[recoveryCodeLength/2:]
+		hashes[i] = HashRecoveryCode(codes[i])
+	}
+
+	return codes, hashes, nil
+}
+
+// HashRecoveryCode хэширует код без учета регистра и дефиса, чтобы код можно было ввести как удобно.
+func HashRecoveryCode(code string) string {
+	return HashToken(strings.ToUpper(strings.ReplaceAll(strings.TrimSpace(code), "-", "")))
+}
@@ -0,0 +1,35 @@
+package base
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestValidateTOTP(t *testing.T) {
+	// тестовый вектор RFC 6238, приложение B: секрет "12345678901234567890", SHA1, 8 цифр -> 94287082;
+	// при 6 цифрах код - последние шесть цифр
+	secret := totpEncoding.EncodeToString([]byte("12345678901234567890"))
+	at := time.Unix(59, 0)
+
+	step, ok := ValidateTOTP(secret, "287082", at)
+	require.True(t, ok)
+	require.Equal(t, int64(1), step)
+
+	_, ok = ValidateTOTP(secret, "287082", at.Add(2*totpPeriod))
+	require.False(t, ok)
+
+	_, ok = ValidateTOTP(secret, "000000", at)
+	require.False(t, ok)
+}
+
+func TestRecoveryCodes(t *testing.T) {
+	codes, hashes, err := GenerateRecoveryCodes(3)
+	require.Nil(t, err)
+	require.Len(t, codes, 3)
+
+	for i, code := range codes {
+		require.Len(t, code, recoveryCodeLength+1)
+		require.Equal(t, hashes[i], HashRecoveryCode(" "+code[:5]+code[6:]+" "))
+	}
+}
@@ -0,0 +1,104 @@
+package okved
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+)
+
+// Entry - позиция классификатора ОКВЭД. ParentCode пуст у разделов.
+type Entry struct {
+	Code        string
+	ParentCode  string
+	Name        string
+	Description string
+}
+
+var (
+	// раздел обозначается латинской буквой, остальные позиции - цифрами, разделенными точками: 01, 01.1, 01.11, 01.11.1
+	sectionPattern = regexp.MustCompile(`^[A-Z]$`)
+	codePattern    = regexp.MustCompile(`^\d{2}(\.\d{1,2}){0,3}$`)
+)
+
+// ParentCode возвращает код родительской позиции: для класса (два знака) - раздел, в который он входит,
+// для остальных кодов - код без последнего знака.
+func ParentCode(code, section string) string {
+	if sectionPattern.MatchString(code) {
+		return ""
+	}
+
+	if !strings.Contains(code, ".") {
+		return section
+	}
+
+	parent := code[:len(code)-1]
+
+	return strings.TrimSuffix(parent, ".")
+}
+
+// Parse читает классификатор из CSV с разделителем ";" и колонками: код, наименование и необязательное описание.
+// Позиции должны идти в порядке классификатора: раздел перед своими классами, класс перед подклассами и т.д.
+// Строка заголовка, если есть, пропускается.
+func Parse(r io.Reader) (entries []*Entry, err error) {
+	reader := csv.NewReader(r)
+	reader.Comma = ';'
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	var section string
+	known := make(map[string]struct{})
+	entries = make([]*Entry, 0)
+	for line := 1; ; line++ {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("чтение строки %d: %w", line, err)
+		}
+
+		if len(record) < 2 {
+			return nil, fmt.Errorf("строка %d: должны быть указаны код и наименование", line)
+		}
+
+		code := strings.ToUpper(strings.TrimSpace(record[0]))
+		if line == 1 && !sectionPattern.MatchString(code) && !codePattern.MatchString(code) {
+			continue
+		}
+
+		entry := &Entry{
+			Code: code,
+			Name: strings.TrimSpace(record[1]),
+		}
+		if len(record) > 2 {
+			entry.Description = strings.TrimSpace(record[2])
+		}
+
+		switch {
+		case sectionPattern.MatchString(code):
+			section = code
+		case codePattern.MatchString(code):
+			entry.ParentCode = ParentCode(code, section)
+		default:
+			return nil, fmt.Errorf("строка %d: некорректный код ОКВЭД '%s'", line, code)
+		}
+
+		if entry.ParentCode != "" {
+			if _, ok := known[entry.ParentCode]; !ok {
+				return nil, fmt.Errorf("строка %d: позиция %s указана раньше родительской %s", line, code, entry.ParentCode)
+			}
+		}
+
+		if entry.Name == "" {
+			return nil, fmt.Errorf("строка %d: пустое наименование позиции %s", line, code)
+		}
+
+		known[code] = struct{}{}
+		entries = append(entries, entry)
+	}
+
+	return entries, nil
+}
@@ -0,0 +1,49 @@
+package okved
+
+import (
+	"github.com/stretchr/testify/require"
+	"strings"
+	"testing"
+)
+
+func TestParentCode(t *testing.T) {
+	require.Equal(t, "", ParentCode("A", ""))
+	require.Equal(t, "A", ParentCode("01", "A"))
+	require.Equal(t, "01", ParentCode("01.1", "A"))
+	require.Equal(t, "01.1", ParentCode("01.11", "A"))
+	require.Equal(t, "01.11", ParentCode("01.11.1", "A"))
+	require.Equal(t, "01.11.1", ParentCode("01.11.11", "A"))
+}
+
+func TestParse(t *testing.T) {
+	data := `Код;Наименование;Описание
+A;СЕЛЬСКОЕ, ЛЕСНОЕ ХОЗЯЙСТВО, ОХОТА, РЫБОЛОВСТВО И РЫБОВОДСТВО;
+01;Растениеводство и животноводство, охота и предоставление соответствующих услуг в этих областях;
+01.1;Выращивание однолетних культур;Включает выращивание однолетних культур
+01.11;Выращивание зерновых и зернобобовых культур, семян масличных культур
+B;ДОБЫЧА ПОЛЕЗНЫХ ИСКОПАЕМЫХ
+05;Добыча угля`
+
+	entries, err := Parse(strings.NewReader(data))
+	require.Nil(t, err)
+	require.Len(t, entries, 6)
+
+	require.Equal(t, &Entry{Code: "A", Name: "СЕЛЬСКОЕ, ЛЕСНОЕ ХОЗЯЙСТВО, ОХОТА, РЫБОЛОВСТВО И РЫБОВОДСТВО"}, entries[0])
+	require.Equal(t, "A", entries[1].ParentCode)
+	require.Equal(t, "01", entries[2].ParentCode)
+	require.Equal(t, "Включает выращивание однолетних культур", entries[2].Description)
+	require.Equal(t, "01.1", entries[3].ParentCode)
+	require.Equal(t, "", entries[4].ParentCode)
+	require.Equal(t, "B", entries[5].ParentCode)
+}
+
+func TestParse_Invalid(t *testing.T) {
+	_, err := Parse(strings.NewReader("A;Раздел\n01.1;Группа без класса"))
+	require.EqualError(t, err, "строка 2: позиция 01.1 указана раньше родительской 01")
+
+	_, err = Parse(strings.NewReader("A;Раздел\n1.2.3;Неверный код"))
+	require.EqualError(t, err, "строка 2: некорректный код ОКВЭД '1.2.3'")
+
+	_, err = Parse(strings.NewReader("A"))
+	require.EqualError(t, err, "строка 1: должны быть указаны код и наименование")
+}
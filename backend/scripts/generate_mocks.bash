@@ -9,3 +9,19 @@ mockgen -source=domain/auth.go -destination=mocks/auth.go -package=mocks
 mockgen -source=domain/fin_report.go -destination=mocks/fin_report.go -package=mocks
 mockgen -source=domain/contact.go -destination=mocks/contact.go -package=mocks
 mockgen -source=domain/user_activity_field.go -destination=mocks/user_activity_field.go -package=mocks
+mockgen -source=domain/skill.go -destination=mocks/skill.go -package=mocks
+mockgen -source=domain/review.go -destination=mocks/review.go -package=mocks
+mockgen -source=domain/tax_schedule.go -destination=mocks/tax_schedule.go -package=mocks
+mockgen -source=domain/session.go -destination=mocks/session.go -package=mocks
+mockgen -source=pkg/base/hash.go -destination=mocks/hash.go -package=mocks
+mockgen -source=domain/password_reset.go -destination=mocks/password_reset.go -package=mocks
+mockgen -source=domain/notification.go -destination=mocks/notification.go -package=mocks
+mockgen -source=domain/login_attempt.go -destination=mocks/login_attempt.go -package=mocks
+mockgen -source=domain/role.go -destination=mocks/role.go -package=mocks
+mockgen -source=domain/signing_key.go -destination=mocks/signing_key.go -package=mocks
+mockgen -source=domain/mfa.go -destination=mocks/mfa.go -package=mocks
+mockgen -source=domain/api_key.go -destination=mocks/api_key.go -package=mocks
+mockgen -source=domain/company_owner.go -destination=mocks/company_owner.go -package=mocks
+mockgen -source=domain/company_transfer.go -destination=mocks/company_transfer.go -package=mocks
+mockgen -source=domain/rating.go -destination=mocks/rating.go -package=mocks
+mockgen -source=domain/rating_snapshot.go -destination=mocks/rating_snapshot.go -package=mocks
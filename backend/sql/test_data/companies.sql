@@ -1,5 +1,5 @@
-insert into ppo.companies(id, owner_id, activity_field_id, name, city)
+insert into ppo.companies(id, owner_id, activity_field_id, name, city, inn, ogrn, kpp)
 values
-    ('fa406cca-27d6-446e-8cfd-b1a71ed680a0', 'bc3ab9bf-6a26-4212-941d-05a985fc0978', 'f80426b8-27e7-4bfa-8721-23075f125165', 'Company1', 'Moscow'),
-    ('c4f2abf1-e80c-4c31-bc77-fe5a8e5fab40', 'b384ea3b-df18-4bae-b459-fb96e2518fe7', 'b9bacee6-3d2d-48f8-a7bc-493f44b0652a', 'Company2', 'Voronezh'),
-    ('f8185baf-b552-4028-8a39-b061ac1a650f', '8a7fe516-600e-4c01-a55e-423fac892250', 'fa406cca-27d6-446e-8cfd-b1a71ed680a0', 'Company3', 'SPb');
\ No newline at end of file
+    ('fa406cca-27d6-446e-8cfd-b1a71ed680a0', 'bc3ab9bf-6a26-4212-941d-05a985fc0978', 'f80426b8-27e7-4bfa-8721-23075f125165', 'Company1', 'Moscow', '7736012343', '1177746123455', '773601001'),
+    ('c4f2abf1-e80c-4c31-bc77-fe5a8e5fab40', 'b384ea3b-df18-4bae-b459-fb96e2518fe7', 'b9bacee6-3d2d-48f8-a7bc-493f44b0652a', 'Company2', 'Voronezh', '3664012344', '1123668012346', '366401001'),
+    ('f8185baf-b552-4028-8a39-b061ac1a650f', '8a7fe516-600e-4c01-a55e-423fac892250', 'fa406cca-27d6-446e-8cfd-b1a71ed680a0', 'Company3', 'SPb', '7801012346', '1207800123453', '780101001');
\ No newline at end of file
@@ -0,0 +1,5 @@
+insert into ppo.company_activity_fields(company_id, activity_field_id)
+values
+    ('fa406cca-27d6-446e-8cfd-b1a71ed680a0', 'b9bacee6-3d2d-48f8-a7bc-493f44b0652a'),
+    ('f8185baf-b552-4028-8a39-b061ac1a650f', 'f80426b8-27e7-4bfa-8721-23075f125165'),
+    ('f8185baf-b552-4028-8a39-b061ac1a650f', 'b9bacee6-3d2d-48f8-a7bc-493f44b0652a');
\ No newline at end of file
@@ -0,0 +1,11 @@
+insert into ppo.company_owners(company_id, user_id, share, accepted_at)
+select id, owner_id, 100, now() from ppo.companies
+on conflict do nothing;
+
+-- Company2 принадлежит совладельцам: user2 - 70%, user3 - 30%
+update ppo.company_owners set share = 70
+where company_id = 'c4f2abf1-e80c-4c31-bc77-fe5a8e5fab40' and user_id = 'b384ea3b-df18-4bae-b459-fb96e2518fe7';
+
+insert into ppo.company_owners(company_id, user_id, share, invited_by, accepted_at)
+values
+    ('c4f2abf1-e80c-4c31-bc77-fe5a8e5fab40', '8a7fe516-600e-4c01-a55e-423fac892250', 30, 'b384ea3b-df18-4bae-b459-fb96e2518fe7', now());
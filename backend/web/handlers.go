@@ -2,7 +2,9 @@ package web
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"ppo/domain"
 	"ppo/internal/app"
@@ -11,6 +13,7 @@ import (
 
 	"github.com/go-chi/chi/v5"
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 )
 
 func LoginHandler(app *app.App) http.HandlerFunc {
@@ -38,29 +41,47 @@ func LoginHandler(app *app.App) http.HandlerFunc {
 		}
 
 		ua := &domain.UserAuth{Username: req.Login, Password: req.Password}
-		token, err := app.AuthSvc.Login(r.Context(), ua)
+		result, err := app.AuthSvc.Login(r.Context(), ua, clientIP(r))
 		if err != nil {
 			app.Logger.Infof("%s: %v", prompt, err)
-			errorResponse(wrappedWriter, fmt.Errorf("%s: %w", prompt, err).Error(), http.StatusUnauthorized)
+
+			status := http.StatusUnauthorized
+			switch {
+			case errors.Is(err, domain.ErrLoginLocked):
+				observeFailedLogin("locked")
+				status = http.StatusTooManyRequests
+			case errors.Is(err, domain.ErrInvalidCredentials):
+				observeFailedLogin("invalid_credentials")
+			default:
+				observeFailedLogin("other")
+			}
+
+			errorResponse(wrappedWriter, fmt.Errorf("%s: %w", prompt, err).Error(), status)
 			return
 		}
 
-		cookie := http.Cookie{
-			Name:    "access_token",
-			Value:   token,
-			Path:    "/",
-			Secure:  true,
-			Expires: time.Now().Add(3600 * 24 * time.Second),
+		if result.MfaToken != "" {
+			successResponse(wrappedWriter, http.StatusOK, map[string]interface{}{
+				"mfaRequired": true,
+				"mfaToken":    result.MfaToken,
+			})
+			return
+		}
+
+		err = setSessionCookies(w, app, result.Tokens)
+		if err != nil {
+			app.Logger.Infof("%s: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("%s: %w", prompt, err).Error(), http.StatusInternalServerError)
+			return
 		}
 
-		http.SetCookie(w, &cookie)
-		successResponse(wrappedWriter, http.StatusOK, map[string]string{"token": token})
+		successResponse(wrappedWriter, http.StatusOK, toTokenPairTransport(result.Tokens))
 	}
 }
 
-func RegisterHandler(app *app.App) http.HandlerFunc {
+func VerifyMfaHandler(app *app.App) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		prompt := "RegisterHandler"
+		prompt := "VerifyMfaHandler"
 		start := time.Now()
 
 		wrappedWriter := &statusResponseWriter{ResponseWriter: w, statusCode: http.StatusOK}
@@ -70,8 +91,8 @@ func RegisterHandler(app *app.App) http.HandlerFunc {
 		}()
 
 		type Req struct {
-			Login    string `json:"login"`
-			Password string `json:"password"`
+			MfaToken string `json:"mfaToken"`
+			Code     string `json:"code"`
 		}
 		var req Req
 
@@ -82,62 +103,39 @@ func RegisterHandler(app *app.App) http.HandlerFunc {
 			return
 		}
 
-		ua := &domain.UserAuth{Username: req.Login, Password: req.Password}
-		err = app.AuthSvc.Register(r.Context(), ua)
+		tokens, err := app.AuthSvc.VerifyMfa(r.Context(), req.MfaToken, req.Code)
 		if err != nil {
 			app.Logger.Infof("%s: %v", prompt, err)
-			errorResponse(wrappedWriter, fmt.Errorf("%s: %w", prompt, err).Error(), http.StatusBadRequest)
-			return
-		}
-
-		successResponse(wrappedWriter, http.StatusOK, nil)
-	}
-}
-
-func ListEntrepreneurs(app *app.App) http.HandlerFunc {
-	return func(w http.ResponseWriter, r *http.Request) {
-		prompt := "ListEntrepreneursHandler"
-		start := time.Now()
-
-		wrappedWriter := &statusResponseWriter{ResponseWriter: w, statusCode: http.StatusOK}
-
-		defer func() {
-			observeRequest(time.Since(start), wrappedWriter.StatusCode(), r.Method, prompt)
-		}()
 
-		page := r.URL.Query().Get("page")
-		if page == "" {
-			app.Logger.Infof("%s: пустой номер страницы", prompt)
-			errorResponse(wrappedWriter, fmt.Errorf("%s: пустой номер страницы", prompt).Error(), http.StatusBadRequest)
-			return
-		}
+			status := http.StatusUnauthorized
+			switch {
+			case errors.Is(err, domain.ErrLoginLocked):
+				observeFailedLogin("locked")
+				status = http.StatusTooManyRequests
+			case errors.Is(err, domain.ErrInvalidMfaCode):
+				observeFailedLogin("invalid_mfa_code")
+			default:
+				observeFailedLogin("other")
+			}
 
-		pageInt, err := strconv.Atoi(page)
-		if err != nil {
-			app.Logger.Infof("%s: преобразование номера страницы к int: %v", prompt, err)
-			errorResponse(wrappedWriter, fmt.Errorf("%s: преобразование номера страницы к int: %w", prompt, err).Error(), http.StatusBadRequest)
+			errorResponse(wrappedWriter, fmt.Errorf("%s: %w", prompt, err).Error(), status)
 			return
 		}
 
-		users, numPages, err := app.UserSvc.GetAll(r.Context(), pageInt)
+		err = setSessionCookies(w, app, tokens)
 		if err != nil {
 			app.Logger.Infof("%s: %v", prompt, err)
 			errorResponse(wrappedWriter, fmt.Errorf("%s: %w", prompt, err).Error(), http.StatusInternalServerError)
 			return
 		}
 
-		usersTransport := make([]User, len(users))
-		for i, user := range users {
-			usersTransport[i] = toUserTransport(user)
-		}
-
-		successResponse(wrappedWriter, http.StatusOK, map[string]interface{}{"num_pages": numPages, "users": usersTransport})
+		successResponse(wrappedWriter, http.StatusOK, toTokenPairTransport(tokens))
 	}
 }
 
-func UpdateEntrepreneur(app *app.App) http.HandlerFunc {
+func RefreshHandler(app *app.App) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		prompt := "UpdateEntrepreneurHandler"
+		prompt := "RefreshHandler"
 		start := time.Now()
 
 		wrappedWriter := &statusResponseWriter{ResponseWriter: w, statusCode: http.StatusOK}
@@ -146,46 +144,54 @@ func UpdateEntrepreneur(app *app.App) http.HandlerFunc {
 			observeRequest(time.Since(start), wrappedWriter.StatusCode(), r.Method, prompt)
 		}()
 
-		id := chi.URLParam(r, "id")
-		if id == "" {
-			app.Logger.Infof("%s: пустой id", prompt)
-			errorResponse(wrappedWriter, fmt.Errorf("%s: пустой id", prompt).Error(), http.StatusBadRequest)
-			return
+		type Req struct {
+			RefreshToken string `json:"refreshToken"`
 		}
+		var req Req
 
-		idUuid, err := uuid.Parse(id)
-		if err != nil {
-			app.Logger.Infof("%s: преобразование id к uuid: %v", prompt, err)
-			errorResponse(wrappedWriter, fmt.Errorf("%s: преобразование id к uuid: %w", prompt, err).Error(), http.StatusBadRequest)
+		// браузерный клиент присылает refresh-токен в cookie и может не передавать тело запроса
+		err := json.NewDecoder(r.Body).Decode(&req)
+		if err != nil && !errors.Is(err, io.EOF) {
+			app.Logger.Infof("%s: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("%s: %w", prompt, err).Error(), http.StatusBadRequest)
 			return
 		}
 
-		var req User
+		if req.RefreshToken == "" {
+			cookie, cookieErr := r.Cookie(refreshTokenCookie)
+			if cookieErr == nil {
+				err = checkCsrfToken(r)
+				if err != nil {
+					app.Logger.Infof("%s: %v", prompt, err)
+					errorResponse(wrappedWriter, fmt.Errorf("%s: %w", prompt, err).Error(), http.StatusForbidden)
+					return
+				}
 
-		err = json.NewDecoder(r.Body).Decode(&req)
+				req.RefreshToken = cookie.Value
+			}
+		}
+
+		tokens, err := app.AuthSvc.Refresh(r.Context(), req.RefreshToken)
 		if err != nil {
 			app.Logger.Infof("%s: %v", prompt, err)
-			errorResponse(wrappedWriter, err.Error(), http.StatusBadRequest)
+			errorResponse(wrappedWriter, fmt.Errorf("%s: %w", prompt, err).Error(), http.StatusUnauthorized)
 			return
 		}
 
-		req.ID = idUuid
-		userModel := toUserModel(&req)
-
-		err = app.UserSvc.Update(r.Context(), &userModel)
+		err = setSessionCookies(w, app, tokens)
 		if err != nil {
 			app.Logger.Infof("%s: %v", prompt, err)
-			errorResponse(wrappedWriter, fmt.Errorf("%s: %w", prompt, err).Error(), http.StatusBadRequest)
+			errorResponse(wrappedWriter, fmt.Errorf("%s: %w", prompt, err).Error(), http.StatusInternalServerError)
 			return
 		}
 
-		successResponse(wrappedWriter, http.StatusOK, nil)
+		successResponse(wrappedWriter, http.StatusOK, toTokenPairTransport(tokens))
 	}
 }
 
-func DeleteEntrepreneur(app *app.App) http.HandlerFunc {
+func LogoutHandler(app *app.App) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		prompt := "DeleteEntrepreneurHandler"
+		prompt := "LogoutHandler"
 		start := time.Now()
 
 		wrappedWriter := &statusResponseWriter{ResponseWriter: w, statusCode: http.StatusOK}
@@ -194,34 +200,35 @@ func DeleteEntrepreneur(app *app.App) http.HandlerFunc {
 			observeRequest(time.Since(start), wrappedWriter.StatusCode(), r.Method, prompt)
 		}()
 
-		id := chi.URLParam(r, "id")
-		if id == "" {
-			app.Logger.Infof("%s: пустой id", prompt)
-			errorResponse(wrappedWriter, fmt.Errorf("%s: пустой id", prompt).Error(), http.StatusBadRequest)
+		sid, err := getStringClaimFromJWT(r.Context(), "sid")
+		if err != nil {
+			app.Logger.Infof("%s: получение сессии из JWT: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("получение сессии из JWT: %w", err).Error(), http.StatusBadRequest)
 			return
 		}
 
-		idUuid, err := uuid.Parse(id)
+		sessionId, err := uuid.Parse(sid)
 		if err != nil {
-			app.Logger.Infof("%s: преобразование id к uuid: %v", prompt, err)
-			errorResponse(wrappedWriter, fmt.Errorf("%s: преобразование id к uuid: %w", prompt, err).Error(), http.StatusBadRequest)
+			app.Logger.Infof("%s: преобразование id сессии к uuid: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("преобразование id сессии к uuid: %w", err).Error(), http.StatusBadRequest)
 			return
 		}
 
-		err = app.UserSvc.DeleteById(r.Context(), idUuid)
+		err = app.AuthSvc.Logout(r.Context(), sessionId)
 		if err != nil {
 			app.Logger.Infof("%s: %v", prompt, err)
 			errorResponse(wrappedWriter, fmt.Errorf("%s: %w", prompt, err).Error(), http.StatusInternalServerError)
 			return
 		}
 
+		clearSessionCookies(w, app)
 		successResponse(wrappedWriter, http.StatusOK, nil)
 	}
 }
 
-func GetEntrepreneur(app *app.App) http.HandlerFunc {
+func RegisterHandler(app *app.App) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		prompt := "GetEntrepreneurHandler"
+		prompt := "RegisterHandler"
 		start := time.Now()
 
 		wrappedWriter := &statusResponseWriter{ResponseWriter: w, statusCode: http.StatusOK}
@@ -230,34 +237,47 @@ func GetEntrepreneur(app *app.App) http.HandlerFunc {
 			observeRequest(time.Since(start), wrappedWriter.StatusCode(), r.Method, prompt)
 		}()
 
-		id := chi.URLParam(r, "id")
-		if id == "" {
-			app.Logger.Infof("%s: пустой id", prompt)
-			errorResponse(wrappedWriter, fmt.Errorf("%s: пустой id", prompt).Error(), http.StatusBadRequest)
-			return
+		type Req struct {
+			Login    string    `json:"login"`
+			Password string    `json:"password"`
+			FullName string    `json:"fullName"`
+			Gender   string    `json:"gender"`
+			Birthday time.Time `json:"birthday"`
+			City     string    `json:"city"`
 		}
+		var req Req
 
-		idUuid, err := uuid.Parse(id)
+		err := json.NewDecoder(r.Body).Decode(&req)
 		if err != nil {
-			app.Logger.Infof("%s: преобразование id к uuid: %v", prompt, err)
-			errorResponse(wrappedWriter, fmt.Errorf("%s: преобразование id к uuid: %w", prompt, err).Error(), http.StatusBadRequest)
+			app.Logger.Infof("%s: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("%s: %w", prompt, err).Error(), http.StatusBadRequest)
 			return
 		}
 
-		user, err := app.UserSvc.GetById(r.Context(), idUuid)
+		ua := &domain.UserAuth{Username: req.Login, Password: req.Password}
+		profile := &domain.User{
+			Username: req.Login,
+			FullName: req.FullName,
+			Gender:   req.Gender,
+			Birthday: req.Birthday,
+			City:     req.City,
+		}
+		err = app.AuthSvc.Register(r.Context(), ua, profile)
 		if err != nil {
 			app.Logger.Infof("%s: %v", prompt, err)
-			errorResponse(wrappedWriter, fmt.Errorf("%s: %w", prompt, err).Error(), http.StatusInternalServerError)
+			errorResponse(wrappedWriter, fmt.Errorf("%s: %w", prompt, err).Error(), http.StatusBadRequest)
 			return
 		}
 
-		successResponse(wrappedWriter, http.StatusOK, map[string]interface{}{"entrepreneur": toUserTransport(user)})
+		successResponse(wrappedWriter, http.StatusOK, nil)
 	}
 }
 
-func CreateContact(app *app.App) http.HandlerFunc {
+// CompleteProfileHandler позволяет заполнить профиль учётным записям, зарегистрированным
+// без него: до этого карточка предпринимателя не показывается в поиске.
+func CompleteProfileHandler(app *app.App) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		prompt := "CreateContactHandler"
+		prompt := "CompleteProfileHandler"
 		start := time.Now()
 
 		wrappedWriter := &statusResponseWriter{ResponseWriter: w, statusCode: http.StatusOK}
@@ -266,32 +286,38 @@ func CreateContact(app *app.App) http.HandlerFunc {
 			observeRequest(time.Since(start), wrappedWriter.StatusCode(), r.Method, prompt)
 		}()
 
-		idStr, err := getStringClaimFromJWT(r.Context(), "sub")
+		userId, err := getStringClaimFromJWT(r.Context(), "sub")
 		if err != nil {
-			app.Logger.Infof("%s: получение записей из JWT: %v", prompt, err)
-			errorResponse(wrappedWriter, fmt.Errorf("%s: получение записей из JWT: %w", prompt, err).Error(), http.StatusBadRequest)
+			app.Logger.Infof("%s: получение id пользователя из JWT: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("получение id пользователя из JWT: %w", err).Error(), http.StatusBadRequest)
 			return
 		}
 
-		idUuid, err := uuid.Parse(idStr)
+		userIdUuid, err := uuid.Parse(userId)
 		if err != nil {
 			app.Logger.Infof("%s: преобразование id к uuid: %v", prompt, err)
-			errorResponse(wrappedWriter, fmt.Errorf("%s: преобразование id к uuid: %w", prompt, err).Error(), http.StatusInternalServerError)
+			errorResponse(wrappedWriter, fmt.Errorf("преобразование id к uuid: %w", err).Error(), http.StatusBadRequest)
 			return
 		}
 
-		var req Contact
+		var req User
+
 		err = json.NewDecoder(r.Body).Decode(&req)
 		if err != nil {
 			app.Logger.Infof("%s: %v", prompt, err)
-			errorResponse(wrappedWriter, err.Error(), http.StatusBadRequest)
+			errorResponse(wrappedWriter, fmt.Errorf("%s: %w", prompt, err).Error(), http.StatusBadRequest)
 			return
 		}
 
-		contact := toContactModel(&req)
-		contact.OwnerID = idUuid
+		userModel := domain.User{
+			ID:       userIdUuid,
+			FullName: req.FullName,
+			Gender:   req.Gender,
+			Birthday: req.Birthday,
+			City:     req.City,
+		}
 
-		err = app.ConSvc.Create(r.Context(), &contact)
+		err = app.UserSvc.Create(r.Context(), &userModel)
 		if err != nil {
 			app.Logger.Infof("%s: %v", prompt, err)
 			errorResponse(wrappedWriter, fmt.Errorf("%s: %w", prompt, err).Error(), http.StatusBadRequest)
@@ -302,9 +328,9 @@ func CreateContact(app *app.App) http.HandlerFunc {
 	}
 }
 
-func DeleteContact(app *app.App) http.HandlerFunc {
+func ChangePasswordHandler(app *app.App) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		prompt := "DeleteContactHandler"
+		prompt := "ChangePasswordHandler"
 		start := time.Now()
 
 		wrappedWriter := &statusResponseWriter{ResponseWriter: w, statusCode: http.StatusOK}
@@ -313,38 +339,37 @@ func DeleteContact(app *app.App) http.HandlerFunc {
 			observeRequest(time.Since(start), wrappedWriter.StatusCode(), r.Method, prompt)
 		}()
 
-		ownerIdStr, err := getStringClaimFromJWT(r.Context(), "sub")
+		userId, err := getStringClaimFromJWT(r.Context(), "sub")
 		if err != nil {
-			app.Logger.Infof("%s: получение записей из JWT: %v", prompt, err)
-			errorResponse(wrappedWriter, fmt.Errorf("получение записей из JWT: %w", err).Error(), http.StatusBadRequest)
+			app.Logger.Infof("%s: получение id пользователя из JWT: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("получение id пользователя из JWT: %w", err).Error(), http.StatusBadRequest)
 			return
 		}
 
-		ownerIdUuid, err := uuid.Parse(ownerIdStr)
+		userIdUuid, err := uuid.Parse(userId)
 		if err != nil {
-			app.Logger.Infof("%s: преобразование строки к uuid: %v", prompt, err)
-			errorResponse(wrappedWriter, fmt.Errorf("преобразование строки к uuid: %w", err).Error(), http.StatusInternalServerError)
+			app.Logger.Infof("%s: преобразование id к uuid: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("преобразование id к uuid: %w", err).Error(), http.StatusBadRequest)
 			return
 		}
 
-		id := chi.URLParam(r, "id")
-		if id == "" {
-			app.Logger.Infof("%s: пустой id", prompt)
-			errorResponse(wrappedWriter, fmt.Errorf("пустой id").Error(), http.StatusBadRequest)
-			return
+		type Req struct {
+			OldPassword string `json:"oldPassword"`
+			NewPassword string `json:"newPassword"`
 		}
+		var req Req
 
-		idUuid, err := uuid.Parse(id)
+		err = json.NewDecoder(r.Body).Decode(&req)
 		if err != nil {
-			app.Logger.Infof("%s: преобразование строки к uuid: %v", prompt, err)
-			errorResponse(wrappedWriter, fmt.Errorf("преобразование строки к uuid: %w", err).Error(), http.StatusBadRequest)
+			app.Logger.Infof("%s: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("%s: %w", prompt, err).Error(), http.StatusBadRequest)
 			return
 		}
 
-		err = app.ConSvc.DeleteById(r.Context(), idUuid, ownerIdUuid)
+		err = app.AuthSvc.ChangePassword(r.Context(), userIdUuid, req.OldPassword, req.NewPassword)
 		if err != nil {
-			app.Logger.Infof("%s: удаление средства связи по id: %v", prompt, err)
-			errorResponse(wrappedWriter, fmt.Errorf("удаление средства связи по id: %w", err).Error(), http.StatusInternalServerError)
+			app.Logger.Infof("%s: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("%s: %w", prompt, err).Error(), http.StatusBadRequest)
 			return
 		}
 
@@ -352,9 +377,9 @@ func DeleteContact(app *app.App) http.HandlerFunc {
 	}
 }
 
-func UpdateContact(app *app.App) http.HandlerFunc {
+func EnrollMfaHandler(app *app.App) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		prompt := "UpdateContactHandler"
+		prompt := "EnrollMfaHandler"
 		start := time.Now()
 
 		wrappedWriter := &statusResponseWriter{ResponseWriter: w, statusCode: http.StatusOK}
@@ -363,59 +388,37 @@ func UpdateContact(app *app.App) http.HandlerFunc {
 			observeRequest(time.Since(start), wrappedWriter.StatusCode(), r.Method, prompt)
 		}()
 
-		ownerIdStr, err := getStringClaimFromJWT(r.Context(), "sub")
-		if err != nil {
-			app.Logger.Infof("%s: получение записей из JWT: %v", prompt, err)
-			errorResponse(wrappedWriter, fmt.Errorf("получение записей из JWT: %w", err).Error(), http.StatusBadRequest)
-			return
-		}
-
-		ownerIdUuid, err := uuid.Parse(ownerIdStr)
+		userId, err := getStringClaimFromJWT(r.Context(), "sub")
 		if err != nil {
-			app.Logger.Infof("%s: преобразование строки к uuid: %v", prompt, err)
-			errorResponse(wrappedWriter, fmt.Errorf("преобразование строки к uuid: %w", err).Error(), http.StatusInternalServerError)
-			return
-		}
-
-		id := chi.URLParam(r, "id")
-		if id == "" {
-			app.Logger.Infof("%s: пустой id", prompt)
-			errorResponse(wrappedWriter, fmt.Errorf("пустой id").Error(), http.StatusBadRequest)
+			app.Logger.Infof("%s: получение id пользователя из JWT: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("получение id пользователя из JWT: %w", err).Error(), http.StatusBadRequest)
 			return
 		}
 
-		idUuid, err := uuid.Parse(id)
+		userIdUuid, err := uuid.Parse(userId)
 		if err != nil {
 			app.Logger.Infof("%s: преобразование id к uuid: %v", prompt, err)
 			errorResponse(wrappedWriter, fmt.Errorf("преобразование id к uuid: %w", err).Error(), http.StatusBadRequest)
 			return
 		}
 
-		var req Contact
-
-		err = json.NewDecoder(r.Body).Decode(&req)
-		if err != nil {
-			app.Logger.Infof("%s: %v", err)
-			errorResponse(wrappedWriter, err.Error(), http.StatusBadRequest)
-			return
-		}
-		req.ID = idUuid
-		model := toContactModel(&req)
-
-		err = app.ConSvc.Update(r.Context(), &model, ownerIdUuid)
+		enrollment, err := app.AuthSvc.EnrollMfa(r.Context(), userIdUuid)
 		if err != nil {
-			app.Logger.Infof("%s: обновление информации о средстве связи: %v", prompt, err)
-			errorResponse(wrappedWriter, fmt.Errorf("обновление информации о средстве связи: %w", err).Error(), http.StatusInternalServerError)
+			app.Logger.Infof("%s: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("%s: %w", prompt, err).Error(), http.StatusBadRequest)
 			return
 		}
 
-		successResponse(wrappedWriter, http.StatusOK, nil)
+		successResponse(wrappedWriter, http.StatusOK, map[string]interface{}{
+			"secret": enrollment.Secret,
+			"uri":    enrollment.URI,
+		})
 	}
 }
 
-func GetContact(app *app.App) http.HandlerFunc {
+func ConfirmMfaHandler(app *app.App) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		prompt := "GetContactHandler"
+		prompt := "ConfirmMfaHandler"
 		start := time.Now()
 
 		wrappedWriter := &statusResponseWriter{ResponseWriter: w, statusCode: http.StatusOK}
@@ -424,34 +427,46 @@ func GetContact(app *app.App) http.HandlerFunc {
 			observeRequest(time.Since(start), wrappedWriter.StatusCode(), r.Method, prompt)
 		}()
 
-		id := chi.URLParam(r, "id")
-		if id == "" {
-			app.Logger.Infof("%s: пустой id", prompt)
-			errorResponse(wrappedWriter, fmt.Errorf("пустой id").Error(), http.StatusBadRequest)
+		userId, err := getStringClaimFromJWT(r.Context(), "sub")
+		if err != nil {
+			app.Logger.Infof("%s: получение id пользователя из JWT: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("получение id пользователя из JWT: %w", err).Error(), http.StatusBadRequest)
 			return
 		}
 
-		idUuid, err := uuid.Parse(id)
+		userIdUuid, err := uuid.Parse(userId)
 		if err != nil {
 			app.Logger.Infof("%s: преобразование id к uuid: %v", prompt, err)
 			errorResponse(wrappedWriter, fmt.Errorf("преобразование id к uuid: %w", err).Error(), http.StatusBadRequest)
 			return
 		}
 
-		contact, err := app.ConSvc.GetById(r.Context(), idUuid)
+		type Req struct {
+			Code string `json:"code"`
+		}
+		var req Req
+
+		err = json.NewDecoder(r.Body).Decode(&req)
 		if err != nil {
-			app.Logger.Infof("%s: получение средства связи по id: %v", prompt, err)
-			errorResponse(wrappedWriter, fmt.Errorf("получение средства связи по id: %w", err).Error(), http.StatusInternalServerError)
+			app.Logger.Infof("%s: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("%s: %w", prompt, err).Error(), http.StatusBadRequest)
 			return
 		}
 
-		successResponse(wrappedWriter, http.StatusOK, map[string]interface{}{"contact": toContactTransport(contact)})
+		recoveryCodes, err := app.AuthSvc.ConfirmMfa(r.Context(), userIdUuid, req.Code)
+		if err != nil {
+			app.Logger.Infof("%s: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("%s: %w", prompt, err).Error(), http.StatusBadRequest)
+			return
+		}
+
+		successResponse(wrappedWriter, http.StatusOK, map[string]interface{}{"recoveryCodes": recoveryCodes})
 	}
 }
 
-func ListEntrepreneurContacts(app *app.App) http.HandlerFunc {
+func DisableMfaHandler(app *app.App) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		prompt := "ListEntrepreneursContactsHandler"
+		prompt := "DisableMfaHandler"
 		start := time.Now()
 
 		wrappedWriter := &statusResponseWriter{ResponseWriter: w, statusCode: http.StatusOK}
@@ -460,39 +475,46 @@ func ListEntrepreneurContacts(app *app.App) http.HandlerFunc {
 			observeRequest(time.Since(start), wrappedWriter.StatusCode(), r.Method, prompt)
 		}()
 
-		entId := r.URL.Query().Get("entrepreneur-id")
-		if entId == "" {
-			app.Logger.Infof("%s: пустой id предпринимателя", prompt)
-			errorResponse(wrappedWriter, fmt.Errorf("пустой id предпринимателя").Error(), http.StatusBadRequest)
+		userId, err := getStringClaimFromJWT(r.Context(), "sub")
+		if err != nil {
+			app.Logger.Infof("%s: получение id пользователя из JWT: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("получение id пользователя из JWT: %w", err).Error(), http.StatusBadRequest)
 			return
 		}
 
-		entUuid, err := uuid.Parse(entId)
+		userIdUuid, err := uuid.Parse(userId)
 		if err != nil {
 			app.Logger.Infof("%s: преобразование id к uuid: %v", prompt, err)
-			errorResponse(wrappedWriter, fmt.Errorf("преобразование id к uuid: %w", err).Error(), http.StatusInternalServerError)
+			errorResponse(wrappedWriter, fmt.Errorf("преобразование id к uuid: %w", err).Error(), http.StatusBadRequest)
 			return
 		}
 
-		contacts, err := app.ConSvc.GetByOwnerId(r.Context(), entUuid)
+		type Req struct {
+			Password string `json:"password"`
+		}
+		var req Req
+
+		err = json.NewDecoder(r.Body).Decode(&req)
 		if err != nil {
-			app.Logger.Infof("%s: получение списка контактов: %v", prompt, err)
-			errorResponse(wrappedWriter, fmt.Errorf("получение списка контактов: %w", err).Error(), http.StatusInternalServerError)
+			app.Logger.Infof("%s: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("%s: %w", prompt, err).Error(), http.StatusBadRequest)
 			return
 		}
 
-		contactsTransport := make([]Contact, len(contacts))
-		for i, contact := range contacts {
-			contactsTransport[i] = toContactTransport(contact)
+		err = app.AuthSvc.DisableMfa(r.Context(), userIdUuid, req.Password)
+		if err != nil {
+			app.Logger.Infof("%s: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("%s: %w", prompt, err).Error(), http.StatusBadRequest)
+			return
 		}
 
-		successResponse(wrappedWriter, http.StatusOK, map[string]interface{}{"entrepreneur_id": entId, "contacts": contactsTransport})
+		successResponse(wrappedWriter, http.StatusOK, nil)
 	}
 }
 
-func CreateActivityField(app *app.App) http.HandlerFunc {
+func RequestPasswordResetHandler(app *app.App) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		prompt := "CreateActivityFieldHandler"
+		prompt := "RequestPasswordResetHandler"
 		start := time.Now()
 
 		wrappedWriter := &statusResponseWriter{ResponseWriter: w, statusCode: http.StatusOK}
@@ -501,20 +523,57 @@ func CreateActivityField(app *app.App) http.HandlerFunc {
 			observeRequest(time.Since(start), wrappedWriter.StatusCode(), r.Method, prompt)
 		}()
 
-		var req ActivityField
+		type Req struct {
+			Login string `json:"login"`
+		}
+		var req Req
+
 		err := json.NewDecoder(r.Body).Decode(&req)
 		if err != nil {
 			app.Logger.Infof("%s: %v", prompt, err)
-			errorResponse(wrappedWriter, err.Error(), http.StatusBadRequest)
+			errorResponse(wrappedWriter, fmt.Errorf("%s: %w", prompt, err).Error(), http.StatusBadRequest)
 			return
 		}
 
-		actField := toActFieldModel(&req)
+		err = app.AuthSvc.RequestPasswordReset(r.Context(), req.Login)
+		if err != nil {
+			app.Logger.Infof("%s: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("%s: %w", prompt, err).Error(), http.StatusBadRequest)
+			return
+		}
 
-		err = app.ActFieldSvc.Create(r.Context(), &actField)
+		successResponse(wrappedWriter, http.StatusOK, nil)
+	}
+}
+
+func ResetPasswordHandler(app *app.App) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		prompt := "ResetPasswordHandler"
+		start := time.Now()
+
+		wrappedWriter := &statusResponseWriter{ResponseWriter: w, statusCode: http.StatusOK}
+
+		defer func() {
+			observeRequest(time.Since(start), wrappedWriter.StatusCode(), r.Method, prompt)
+		}()
+
+		type Req struct {
+			Token       string `json:"token"`
+			NewPassword string `json:"newPassword"`
+		}
+		var req Req
+
+		err := json.NewDecoder(r.Body).Decode(&req)
 		if err != nil {
-			app.Logger.Infof("%s: создание сферы деятельности: %v", prompt, err)
-			errorResponse(wrappedWriter, fmt.Errorf("создание сферы деятельности: %w", err).Error(), http.StatusBadRequest)
+			app.Logger.Infof("%s: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("%s: %w", prompt, err).Error(), http.StatusBadRequest)
+			return
+		}
+
+		err = app.AuthSvc.ResetPassword(r.Context(), req.Token, req.NewPassword)
+		if err != nil {
+			app.Logger.Infof("%s: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("%s: %w", prompt, err).Error(), http.StatusBadRequest)
 			return
 		}
 
@@ -522,9 +581,9 @@ func CreateActivityField(app *app.App) http.HandlerFunc {
 	}
 }
 
-func DeleteActivityField(app *app.App) http.HandlerFunc {
+func ListEntrepreneurs(app *app.App) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		prompt := "DeleteActivityFieldHandler"
+		prompt := "ListEntrepreneursHandler"
 		start := time.Now()
 
 		wrappedWriter := &statusResponseWriter{ResponseWriter: w, statusCode: http.StatusOK}
@@ -533,34 +592,73 @@ func DeleteActivityField(app *app.App) http.HandlerFunc {
 			observeRequest(time.Since(start), wrappedWriter.StatusCode(), r.Method, prompt)
 		}()
 
-		id := chi.URLParam(r, "id")
-		if id == "" {
-			app.Logger.Infof("%s: пустой id", prompt)
-			errorResponse(wrappedWriter, fmt.Errorf("пустой id").Error(), http.StatusBadRequest)
+		page := r.URL.Query().Get("page")
+		if page == "" {
+			app.Logger.Infof("%s: пустой номер страницы", prompt)
+			errorResponse(wrappedWriter, fmt.Errorf("%s: пустой номер страницы", prompt).Error(), http.StatusBadRequest)
 			return
 		}
 
-		idUuid, err := uuid.Parse(id)
+		pageInt, err := strconv.Atoi(page)
 		if err != nil {
-			app.Logger.Infof("%s: преобразование id к uuid: %v", prompt, err)
-			errorResponse(wrappedWriter, fmt.Errorf("преобразование id к uuid: %w", err).Error(), http.StatusBadRequest)
+			app.Logger.Infof("%s: преобразование номера страницы к int: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("%s: преобразование номера страницы к int: %w", prompt, err).Error(), http.StatusBadRequest)
 			return
 		}
 
-		err = app.ActFieldSvc.DeleteById(r.Context(), idUuid)
+		filter, err := parseUserFilterFromURL(r)
 		if err != nil {
-			app.Logger.Infof("%s: удаление сферы деятельности по id: %v", prompt, err)
-			errorResponse(wrappedWriter, fmt.Errorf("удаление сферы деятельности по id: %w", err).Error(), http.StatusInternalServerError)
+			app.Logger.Infof("%s: парсинг фильтра из URL: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("%s: парсинг фильтра из URL: %w", prompt, err).Error(), http.StatusBadRequest)
 			return
 		}
 
-		successResponse(wrappedWriter, http.StatusOK, nil)
+		// рейтинг и выручка для сортировки берутся за один и тот же период из параметров запроса
+		var period *domain.Period
+		if filter.SortBy == domain.SortByRating || filter.SortBy == domain.SortByRevenue {
+			period, err = parseRatingPeriod(app, r)
+			if err != nil {
+				app.Logger.Infof("%s: парсинг периода из URL: %v", prompt, err)
+				errorResponse(wrappedWriter, fmt.Errorf("%s: парсинг периода из URL: %w", prompt, err).Error(), http.StatusBadRequest)
+				return
+			}
+		}
+
+		var users []*domain.User
+		var numPages int
+		switch filter.SortBy {
+		case domain.SortByRating:
+			// рейтинг вычисляется по формуле интерактора, поэтому сортировка по нему выполняется там же
+			var ranking []*domain.UserRating
+			ranking, numPages, err = app.Interactor.GetRanking(r.Context(), filter, period, pageInt, r.URL.Query().Get("strategy"))
+			users = make([]*domain.User, len(ranking))
+			for i, rating := range ranking {
+				users[i] = rating.User
+			}
+		case domain.SortByRevenue:
+			filter.Period = period
+			fallthrough
+		default:
+			users, numPages, err = app.UserSvc.GetAll(r.Context(), filter, pageInt)
+		}
+		if err != nil {
+			app.Logger.Infof("%s: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("%s: %w", prompt, err).Error(), ratingErrorStatus(err))
+			return
+		}
+
+		usersTransport := make([]User, len(users))
+		for i, user := range users {
+			usersTransport[i] = toUserTransport(user)
+		}
+
+		successResponse(wrappedWriter, http.StatusOK, map[string]interface{}{"num_pages": numPages, "users": usersTransport})
 	}
 }
 
-func UpdateActivityField(app *app.App) http.HandlerFunc {
+func UpdateEntrepreneur(app *app.App) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		prompt := "UpdateActivityFieldHandler"
+		prompt := "UpdateEntrepreneurHandler"
 		start := time.Now()
 
 		wrappedWriter := &statusResponseWriter{ResponseWriter: w, statusCode: http.StatusOK}
@@ -572,18 +670,18 @@ func UpdateActivityField(app *app.App) http.HandlerFunc {
 		id := chi.URLParam(r, "id")
 		if id == "" {
 			app.Logger.Infof("%s: пустой id", prompt)
-			errorResponse(wrappedWriter, fmt.Errorf("пустой id").Error(), http.StatusBadRequest)
+			errorResponse(wrappedWriter, fmt.Errorf("%s: пустой id", prompt).Error(), http.StatusBadRequest)
 			return
 		}
 
 		idUuid, err := uuid.Parse(id)
 		if err != nil {
 			app.Logger.Infof("%s: преобразование id к uuid: %v", prompt, err)
-			errorResponse(wrappedWriter, fmt.Errorf("преобразование id к uuid: %w", err).Error(), http.StatusBadRequest)
+			errorResponse(wrappedWriter, fmt.Errorf("%s: преобразование id к uuid: %w", prompt, err).Error(), http.StatusBadRequest)
 			return
 		}
 
-		var req ActivityField
+		var req User
 
 		err = json.NewDecoder(r.Body).Decode(&req)
 		if err != nil {
@@ -593,13 +691,12 @@ func UpdateActivityField(app *app.App) http.HandlerFunc {
 		}
 
 		req.ID = idUuid
+		userModel := toUserModel(&req)
 
-		model := toActFieldModel(&req)
-
-		err = app.ActFieldSvc.Update(r.Context(), &model)
+		err = app.UserSvc.Update(r.Context(), &userModel)
 		if err != nil {
-			app.Logger.Infof("%s: обновление информации о сфере деятельности: %v", prompt, err)
-			errorResponse(wrappedWriter, fmt.Errorf("обновление информации о сфере деятельности: %w", err).Error(), http.StatusInternalServerError)
+			app.Logger.Infof("%s: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("%s: %w", prompt, err).Error(), http.StatusBadRequest)
 			return
 		}
 
@@ -607,9 +704,9 @@ func UpdateActivityField(app *app.App) http.HandlerFunc {
 	}
 }
 
-func GetActivityField(app *app.App) http.HandlerFunc {
+func DeleteEntrepreneur(app *app.App) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		prompt := "GetActivityFieldHandler"
+		prompt := "DeleteEntrepreneurHandler"
 		start := time.Now()
 
 		wrappedWriter := &statusResponseWriter{ResponseWriter: w, statusCode: http.StatusOK}
@@ -621,31 +718,31 @@ func GetActivityField(app *app.App) http.HandlerFunc {
 		id := chi.URLParam(r, "id")
 		if id == "" {
 			app.Logger.Infof("%s: пустой id", prompt)
-			errorResponse(wrappedWriter, fmt.Errorf("пустой id").Error(), http.StatusBadRequest)
+			errorResponse(wrappedWriter, fmt.Errorf("%s: пустой id", prompt).Error(), http.StatusBadRequest)
 			return
 		}
 
 		idUuid, err := uuid.Parse(id)
 		if err != nil {
 			app.Logger.Infof("%s: преобразование id к uuid: %v", prompt, err)
-			errorResponse(wrappedWriter, fmt.Errorf("преобразование id к uuid: %w", err).Error(), http.StatusBadRequest)
+			errorResponse(wrappedWriter, fmt.Errorf("%s: преобразование id к uuid: %w", prompt, err).Error(), http.StatusBadRequest)
 			return
 		}
 
-		actField, err := app.ActFieldSvc.GetById(r.Context(), idUuid)
+		err = app.UserSvc.DeleteById(r.Context(), idUuid)
 		if err != nil {
-			app.Logger.Infof("%s: получение сферы деятельности по id: %v", prompt, err)
-			errorResponse(wrappedWriter, fmt.Errorf("получение сферы деятельности по id: %w", err).Error(), http.StatusInternalServerError)
+			app.Logger.Infof("%s: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("%s: %w", prompt, err).Error(), http.StatusInternalServerError)
 			return
 		}
 
-		successResponse(wrappedWriter, http.StatusOK, map[string]interface{}{"activity_field": toActFieldTransport(actField)})
+		successResponse(wrappedWriter, http.StatusOK, nil)
 	}
 }
 
-func ListActivityFields(app *app.App) http.HandlerFunc {
+func GetEntrepreneur(app *app.App) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		prompt := "ListActivityFieldsHandler"
+		prompt := "GetEntrepreneurHandler"
 		start := time.Now()
 
 		wrappedWriter := &statusResponseWriter{ResponseWriter: w, statusCode: http.StatusOK}
@@ -654,41 +751,57 @@ func ListActivityFields(app *app.App) http.HandlerFunc {
 			observeRequest(time.Since(start), wrappedWriter.StatusCode(), r.Method, prompt)
 		}()
 
-		var paginated bool
-		var pageInt int
-		var err error
+		id := chi.URLParam(r, "id")
+		if id == "" {
+			app.Logger.Infof("%s: пустой id", prompt)
+			errorResponse(wrappedWriter, fmt.Errorf("%s: пустой id", prompt).Error(), http.StatusBadRequest)
+			return
+		}
 
-		page := r.URL.Query().Get("page")
-		if page != "" {
-			paginated = true
+		idUuid, err := uuid.Parse(id)
+		if err != nil {
+			app.Logger.Infof("%s: преобразование id к uuid: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("%s: преобразование id к uuid: %w", prompt, err).Error(), http.StatusBadRequest)
+			return
+		}
 
-			pageInt, err = strconv.Atoi(page)
-			if err != nil {
-				app.Logger.Infof("%s: преобразование страницы к int: %v", prompt, err)
-				errorResponse(wrappedWriter, fmt.Errorf("преобразование страницы к int: %w", err).Error(), http.StatusBadRequest)
-				return
-			}
+		user, err := app.UserSvc.GetById(r.Context(), idUuid)
+		if err != nil {
+			app.Logger.Infof("%s: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("%s: %w", prompt, err).Error(), http.StatusInternalServerError)
+			return
 		}
 
-		actFields, numPages, err := app.ActFieldSvc.GetAll(r.Context(), pageInt, paginated)
+		skills, err := app.SkillSvc.GetByUserId(r.Context(), idUuid)
 		if err != nil {
-			app.Logger.Infof("%s: получение списка сфер деятельности: %v", prompt, err)
-			errorResponse(wrappedWriter, fmt.Errorf("получение списка сфер деятельности: %w", err).Error(), http.StatusInternalServerError)
+			app.Logger.Infof("%s: получение навыков предпринимателя: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("%s: получение навыков предпринимателя: %w", prompt, err).Error(), http.StatusInternalServerError)
 			return
 		}
 
-		actFieldsTransport := make([]ActivityField, len(actFields))
-		for i, actField := range actFields {
-			actFieldsTransport[i] = toActFieldTransport(actField)
+		skillsTransport := make([]Skill, len(skills))
+		for i, skill := range skills {
+			skillsTransport[i] = toSkillTransport(skill)
 		}
 
-		successResponse(wrappedWriter, http.StatusOK, map[string]interface{}{"activity_fields": actFieldsTransport, "num_pages": numPages})
+		reviewStats, err := app.ReviewSvc.GetStatsByTarget(r.Context(), idUuid)
+		if err != nil {
+			app.Logger.Infof("%s: получение статистики отзывов: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("%s: получение статистики отзывов: %w", prompt, err).Error(), http.StatusInternalServerError)
+			return
+		}
+
+		successResponse(wrappedWriter, http.StatusOK, map[string]interface{}{
+			"entrepreneur": toUserTransport(user),
+			"skills":       skillsTransport,
+			"reviews":      toReviewStatsTransport(reviewStats),
+		})
 	}
 }
 
-func CreateCompany(app *app.App) http.HandlerFunc {
+func CreateContact(app *app.App) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		prompt := "CreateCompanyHandler"
+		prompt := "CreateContactHandler"
 		start := time.Now()
 
 		wrappedWriter := &statusResponseWriter{ResponseWriter: w, statusCode: http.StatusOK}
@@ -700,18 +813,18 @@ func CreateCompany(app *app.App) http.HandlerFunc {
 		idStr, err := getStringClaimFromJWT(r.Context(), "sub")
 		if err != nil {
 			app.Logger.Infof("%s: получение записей из JWT: %v", prompt, err)
-			errorResponse(wrappedWriter, fmt.Errorf("получение записей из JWT: %w", err).Error(), http.StatusBadRequest)
+			errorResponse(wrappedWriter, fmt.Errorf("%s: получение записей из JWT: %w", prompt, err).Error(), http.StatusBadRequest)
 			return
 		}
 
 		idUuid, err := uuid.Parse(idStr)
 		if err != nil {
-			app.Logger.Infof("%s: преобразование строки к uuid: %v", prompt, err)
-			errorResponse(wrappedWriter, fmt.Errorf("преобразование строки к uuid: %w", err).Error(), http.StatusInternalServerError)
+			app.Logger.Infof("%s: преобразование id к uuid: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("%s: преобразование id к uuid: %w", prompt, err).Error(), http.StatusInternalServerError)
 			return
 		}
 
-		var req Company
+		var req Contact
 		err = json.NewDecoder(r.Body).Decode(&req)
 		if err != nil {
 			app.Logger.Infof("%s: %v", prompt, err)
@@ -719,13 +832,13 @@ func CreateCompany(app *app.App) http.HandlerFunc {
 			return
 		}
 
-		company := toCompanyModel(&req)
-		company.OwnerID = idUuid
+		contact := toContactModel(&req)
+		contact.OwnerID = idUuid
 
-		err = app.CompSvc.Create(r.Context(), &company)
+		err = app.ConSvc.Create(r.Context(), &contact)
 		if err != nil {
-			app.Logger.Infof("%s: создание компании: %v", prompt, err)
-			errorResponse(wrappedWriter, fmt.Errorf("создание компании: %w", err).Error(), http.StatusBadRequest)
+			app.Logger.Infof("%s: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("%s: %w", prompt, err).Error(), http.StatusBadRequest)
 			return
 		}
 
@@ -733,9 +846,9 @@ func CreateCompany(app *app.App) http.HandlerFunc {
 	}
 }
 
-func DeleteCompany(app *app.App) http.HandlerFunc {
+func DeleteContact(app *app.App) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		prompt := "DeleteCompanyHandler"
+		prompt := "DeleteContactHandler"
 		start := time.Now()
 
 		wrappedWriter := &statusResponseWriter{ResponseWriter: w, statusCode: http.StatusOK}
@@ -767,15 +880,15 @@ func DeleteCompany(app *app.App) http.HandlerFunc {
 
 		idUuid, err := uuid.Parse(id)
 		if err != nil {
-			app.Logger.Infof("%s: преобразование id к uuid: %v", prompt, err)
-			errorResponse(wrappedWriter, fmt.Errorf("преобразование id к uuid: %w", err).Error(), http.StatusBadRequest)
+			app.Logger.Infof("%s: преобразование строки к uuid: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("преобразование строки к uuid: %w", err).Error(), http.StatusBadRequest)
 			return
 		}
 
-		err = app.CompSvc.DeleteById(r.Context(), idUuid, ownerIdUuid)
+		err = app.ConSvc.DeleteById(r.Context(), idUuid, ownerIdUuid)
 		if err != nil {
-			app.Logger.Infof("%s: удаление компании по id: %v", prompt, err)
-			errorResponse(wrappedWriter, fmt.Errorf("удаление компании по id: %w", err).Error(), http.StatusInternalServerError)
+			app.Logger.Infof("%s: удаление средства связи по id: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("удаление средства связи по id: %w", err).Error(), http.StatusInternalServerError)
 			return
 		}
 
@@ -783,9 +896,9 @@ func DeleteCompany(app *app.App) http.HandlerFunc {
 	}
 }
 
-func UpdateCompany(app *app.App) http.HandlerFunc {
+func UpdateContact(app *app.App) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		prompt := "UpdateCompanyHandler"
+		prompt := "UpdateContactHandler"
 		start := time.Now()
 
 		wrappedWriter := &statusResponseWriter{ResponseWriter: w, statusCode: http.StatusOK}
@@ -822,21 +935,21 @@ func UpdateCompany(app *app.App) http.HandlerFunc {
 			return
 		}
 
-		var req Company
+		var req Contact
 
 		err = json.NewDecoder(r.Body).Decode(&req)
 		if err != nil {
-			app.Logger.Infof("%s: %v", prompt, err)
+			app.Logger.Infof("%s: %v", err)
 			errorResponse(wrappedWriter, err.Error(), http.StatusBadRequest)
 			return
 		}
 		req.ID = idUuid
-		model := toCompanyModel(&req)
+		model := toContactModel(&req)
 
-		err = app.CompSvc.Update(r.Context(), &model, ownerIdUuid)
+		err = app.ConSvc.Update(r.Context(), &model, ownerIdUuid)
 		if err != nil {
-			app.Logger.Infof("%s: обновление информации о компании: %v", prompt, err)
-			errorResponse(wrappedWriter, fmt.Errorf("обновление информации о компании: %w", err).Error(), http.StatusInternalServerError)
+			app.Logger.Infof("%s: обновление информации о средстве связи: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("обновление информации о средстве связи: %w", err).Error(), http.StatusInternalServerError)
 			return
 		}
 
@@ -844,9 +957,9 @@ func UpdateCompany(app *app.App) http.HandlerFunc {
 	}
 }
 
-func GetCompany(app *app.App) http.HandlerFunc {
+func GetContact(app *app.App) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		prompt := "GetCompanyHandler"
+		prompt := "GetContactHandler"
 		start := time.Now()
 
 		wrappedWriter := &statusResponseWriter{ResponseWriter: w, statusCode: http.StatusOK}
@@ -869,20 +982,20 @@ func GetCompany(app *app.App) http.HandlerFunc {
 			return
 		}
 
-		company, err := app.CompSvc.GetById(r.Context(), idUuid)
+		contact, err := app.ConSvc.GetById(r.Context(), idUuid)
 		if err != nil {
-			app.Logger.Infof("%s: получение компании по id: %v", prompt, err)
-			errorResponse(wrappedWriter, fmt.Errorf("получение компании по id: %w", err).Error(), http.StatusInternalServerError)
+			app.Logger.Infof("%s: получение средства связи по id: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("получение средства связи по id: %w", err).Error(), http.StatusInternalServerError)
 			return
 		}
 
-		successResponse(wrappedWriter, http.StatusOK, map[string]interface{}{"company": toCompanyTransport(company)})
+		successResponse(wrappedWriter, http.StatusOK, map[string]interface{}{"contact": toContactTransport(contact)})
 	}
 }
 
-func ListEntrepreneurCompanies(app *app.App) http.HandlerFunc {
+func ListEntrepreneurContacts(app *app.App) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		prompt := "ListEntrepreneurCompaniesHandler"
+		prompt := "ListEntrepreneursContactsHandler"
 		start := time.Now()
 
 		wrappedWriter := &statusResponseWriter{ResponseWriter: w, statusCode: http.StatusOK}
@@ -891,22 +1004,8 @@ func ListEntrepreneurCompanies(app *app.App) http.HandlerFunc {
 			observeRequest(time.Since(start), wrappedWriter.StatusCode(), r.Method, prompt)
 		}()
 
-		page := r.URL.Query().Get("page")
-		if page == "" {
-			app.Logger.Infof("%s: пустой номер страницы", prompt)
-			errorResponse(wrappedWriter, fmt.Errorf("пустой номер страницы").Error(), http.StatusBadRequest)
-			return
-		}
-
-		pageInt, err := strconv.Atoi(page)
-		if err != nil {
-			app.Logger.Infof("%s: преобразование к int: %v", prompt, err)
-			errorResponse(wrappedWriter, fmt.Errorf("преобразование к int: %w", err).Error(), http.StatusBadRequest)
-			return
-		}
-
 		entId := r.URL.Query().Get("entrepreneur-id")
-		if page == "" {
+		if entId == "" {
 			app.Logger.Infof("%s: пустой id предпринимателя", prompt)
 			errorResponse(wrappedWriter, fmt.Errorf("пустой id предпринимателя").Error(), http.StatusBadRequest)
 			return
@@ -914,30 +1013,2137 @@ func ListEntrepreneurCompanies(app *app.App) http.HandlerFunc {
 
 		entUuid, err := uuid.Parse(entId)
 		if err != nil {
-			app.Logger.Infof("%s: преобразование id предпринимателя к uuid: %v", prompt, err)
-			errorResponse(wrappedWriter, fmt.Errorf("преобразование id предпринимателя к uuid: %w", err).Error(), http.StatusInternalServerError)
+			app.Logger.Infof("%s: преобразование id к uuid: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("преобразование id к uuid: %w", err).Error(), http.StatusInternalServerError)
 			return
 		}
 
-		companies, numPages, err := app.CompSvc.GetByOwnerId(r.Context(), entUuid, pageInt, true)
+		contacts, err := app.ConSvc.GetByOwnerId(r.Context(), entUuid)
 		if err != nil {
-			app.Logger.Infof("%s: получение списка компаний: %v", prompt, err)
-			errorResponse(wrappedWriter, fmt.Errorf("получение списка компаний: %w", err).Error(), http.StatusInternalServerError)
+			app.Logger.Infof("%s: получение списка контактов: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("получение списка контактов: %w", err).Error(), http.StatusInternalServerError)
 			return
 		}
 
-		companiesTransport := make([]Company, len(companies))
+		contactsTransport := make([]Contact, len(contacts))
+		for i, contact := range contacts {
+			contactsTransport[i] = toContactTransport(contact)
+		}
+
+		successResponse(wrappedWriter, http.StatusOK, map[string]interface{}{"entrepreneur_id": entId, "contacts": contactsTransport})
+	}
+}
+
+func CreateActivityField(app *app.App) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		prompt := "CreateActivityFieldHandler"
+		start := time.Now()
+
+		wrappedWriter := &statusResponseWriter{ResponseWriter: w, statusCode: http.StatusOK}
+
+		defer func() {
+			observeRequest(time.Since(start), wrappedWriter.StatusCode(), r.Method, prompt)
+		}()
+
+		var req ActivityField
+		err := json.NewDecoder(r.Body).Decode(&req)
+		if err != nil {
+			app.Logger.Infof("%s: %v", prompt, err)
+			errorResponse(wrappedWriter, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		actField := toActFieldModel(&req)
+
+		err = app.ActFieldSvc.Create(r.Context(), &actField)
+		if err != nil {
+			app.Logger.Infof("%s: создание сферы деятельности: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("создание сферы деятельности: %w", err).Error(), http.StatusBadRequest)
+			return
+		}
+
+		successResponse(wrappedWriter, http.StatusOK, nil)
+	}
+}
+
+func DeleteActivityField(app *app.App) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		prompt := "DeleteActivityFieldHandler"
+		start := time.Now()
+
+		wrappedWriter := &statusResponseWriter{ResponseWriter: w, statusCode: http.StatusOK}
+
+		defer func() {
+			observeRequest(time.Since(start), wrappedWriter.StatusCode(), r.Method, prompt)
+		}()
+
+		id := chi.URLParam(r, "id")
+		if id == "" {
+			app.Logger.Infof("%s: пустой id", prompt)
+			errorResponse(wrappedWriter, fmt.Errorf("пустой id").Error(), http.StatusBadRequest)
+			return
+		}
+
+		idUuid, err := uuid.Parse(id)
+		if err != nil {
+			app.Logger.Infof("%s: преобразование id к uuid: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("преобразование id к uuid: %w", err).Error(), http.StatusBadRequest)
+			return
+		}
+
+		err = app.ActFieldSvc.DeleteById(r.Context(), idUuid)
+		if err != nil {
+			app.Logger.Infof("%s: удаление сферы деятельности по id: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("удаление сферы деятельности по id: %w", err).Error(), http.StatusInternalServerError)
+			return
+		}
+
+		successResponse(wrappedWriter, http.StatusOK, nil)
+	}
+}
+
+func UpdateActivityField(app *app.App) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		prompt := "UpdateActivityFieldHandler"
+		start := time.Now()
+
+		wrappedWriter := &statusResponseWriter{ResponseWriter: w, statusCode: http.StatusOK}
+
+		defer func() {
+			observeRequest(time.Since(start), wrappedWriter.StatusCode(), r.Method, prompt)
+		}()
+
+		id := chi.URLParam(r, "id")
+		if id == "" {
+			app.Logger.Infof("%s: пустой id", prompt)
+			errorResponse(wrappedWriter, fmt.Errorf("пустой id").Error(), http.StatusBadRequest)
+			return
+		}
+
+		idUuid, err := uuid.Parse(id)
+		if err != nil {
+			app.Logger.Infof("%s: преобразование id к uuid: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("преобразование id к uuid: %w", err).Error(), http.StatusBadRequest)
+			return
+		}
+
+		var req ActivityField
+
+		err = json.NewDecoder(r.Body).Decode(&req)
+		if err != nil {
+			app.Logger.Infof("%s: %v", prompt, err)
+			errorResponse(wrappedWriter, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		req.ID = idUuid
+
+		model := toActFieldModel(&req)
+
+		err = app.ActFieldSvc.Update(r.Context(), &model)
+		if err != nil {
+			app.Logger.Infof("%s: обновление информации о сфере деятельности: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("обновление информации о сфере деятельности: %w", err).Error(), http.StatusInternalServerError)
+			return
+		}
+
+		successResponse(wrappedWriter, http.StatusOK, nil)
+	}
+}
+
+func GetActivityField(app *app.App) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		prompt := "GetActivityFieldHandler"
+		start := time.Now()
+
+		wrappedWriter := &statusResponseWriter{ResponseWriter: w, statusCode: http.StatusOK}
+
+		defer func() {
+			observeRequest(time.Since(start), wrappedWriter.StatusCode(), r.Method, prompt)
+		}()
+
+		id := chi.URLParam(r, "id")
+		if id == "" {
+			app.Logger.Infof("%s: пустой id", prompt)
+			errorResponse(wrappedWriter, fmt.Errorf("пустой id").Error(), http.StatusBadRequest)
+			return
+		}
+
+		idUuid, err := uuid.Parse(id)
+		if err != nil {
+			app.Logger.Infof("%s: преобразование id к uuid: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("преобразование id к uuid: %w", err).Error(), http.StatusBadRequest)
+			return
+		}
+
+		actField, err := app.ActFieldSvc.GetById(r.Context(), idUuid)
+		if err != nil {
+			app.Logger.Infof("%s: получение сферы деятельности по id: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("получение сферы деятельности по id: %w", err).Error(), http.StatusInternalServerError)
+			return
+		}
+
+		successResponse(wrappedWriter, http.StatusOK, map[string]interface{}{"activity_field": toActFieldTransport(actField)})
+	}
+}
+
+func ListActivityFields(app *app.App) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		prompt := "ListActivityFieldsHandler"
+		start := time.Now()
+
+		wrappedWriter := &statusResponseWriter{ResponseWriter: w, statusCode: http.StatusOK}
+
+		defer func() {
+			observeRequest(time.Since(start), wrappedWriter.StatusCode(), r.Method, prompt)
+		}()
+
+		var paginated bool
+		var pageInt int
+		var err error
+
+		page := r.URL.Query().Get("page")
+		if page != "" {
+			paginated = true
+
+			pageInt, err = strconv.Atoi(page)
+			if err != nil {
+				app.Logger.Infof("%s: преобразование страницы к int: %v", prompt, err)
+				errorResponse(wrappedWriter, fmt.Errorf("преобразование страницы к int: %w", err).Error(), http.StatusBadRequest)
+				return
+			}
+		}
+
+		actFields, numPages, err := app.ActFieldSvc.GetAll(r.Context(), pageInt, paginated)
+		if err != nil {
+			app.Logger.Infof("%s: получение списка сфер деятельности: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("получение списка сфер деятельности: %w", err).Error(), http.StatusInternalServerError)
+			return
+		}
+
+		actFieldsTransport := make([]ActivityField, len(actFields))
+		for i, actField := range actFields {
+			actFieldsTransport[i] = toActFieldTransport(actField)
+		}
+
+		successResponse(wrappedWriter, http.StatusOK, map[string]interface{}{"activity_fields": actFieldsTransport, "num_pages": numPages})
+	}
+}
+
+// GetActivityFieldTree возвращает весь классификатор сфер деятельности или, если указан id, его поддерево.
+func GetActivityFieldTree(app *app.App) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		prompt := "GetActivityFieldTreeHandler"
+		start := time.Now()
+
+		wrappedWriter := &statusResponseWriter{ResponseWriter: w, statusCode: http.StatusOK}
+
+		defer func() {
+			observeRequest(time.Since(start), wrappedWriter.StatusCode(), r.Method, prompt)
+		}()
+
+		var rootId uuid.UUID
+		var err error
+		if chi.URLParam(r, "id") != "" {
+			rootId, err = parseUUIDFromURL(r, "id", "activity field")
+			if err != nil {
+				app.Logger.Infof("%s: %v", prompt, err)
+				errorResponse(wrappedWriter, err.Error(), http.StatusBadRequest)
+				return
+			}
+		}
+
+		tree, err := app.ActFieldSvc.GetTree(r.Context(), rootId)
+		if err != nil {
+			app.Logger.Infof("%s: получение дерева сфер деятельности: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("получение дерева сфер деятельности: %w", err).Error(), http.StatusInternalServerError)
+			return
+		}
+
+		successResponse(wrappedWriter, http.StatusOK, map[string]interface{}{"activity_fields": toActFieldNodesTransport(tree)})
+	}
+}
+
+func ListActivityFieldCompanies(app *app.App) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		prompt := "ListActivityFieldCompaniesHandler"
+		start := time.Now()
+
+		wrappedWriter := &statusResponseWriter{ResponseWriter: w, statusCode: http.StatusOK}
+
+		defer func() {
+			observeRequest(time.Since(start), wrappedWriter.StatusCode(), r.Method, prompt)
+		}()
+
+		fieldId, err := parseUUIDFromURL(r, "id", "activity field")
+		if err != nil {
+			app.Logger.Infof("%s: %v", prompt, err)
+			errorResponse(wrappedWriter, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		pageInt := 1
+		page := r.URL.Query().Get("page")
+		if page != "" {
+			pageInt, err = strconv.Atoi(page)
+			if err != nil {
+				app.Logger.Infof("%s: преобразование страницы к int: %v", prompt, err)
+				errorResponse(wrappedWriter, fmt.Errorf("преобразование страницы к int: %w", err).Error(), http.StatusBadRequest)
+				return
+			}
+		}
+
+		companies, numPages, err := app.CompSvc.GetByActivityFieldTree(r.Context(), fieldId, pageInt)
+		if err != nil {
+			app.Logger.Infof("%s: получение списка компаний: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("получение списка компаний: %w", err).Error(), http.StatusInternalServerError)
+			return
+		}
+
+		companiesTransport := make([]Company, len(companies))
+		for i, company := range companies {
+			companiesTransport[i] = toCompanyTransport(company)
+		}
+
+		successResponse(wrappedWriter, http.StatusOK, map[string]interface{}{"companies": companiesTransport, "num_pages": numPages})
+	}
+}
+
+func CreateCompany(app *app.App) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		prompt := "CreateCompanyHandler"
+		start := time.Now()
+
+		wrappedWriter := &statusResponseWriter{ResponseWriter: w, statusCode: http.StatusOK}
+
+		defer func() {
+			observeRequest(time.Since(start), wrappedWriter.StatusCode(), r.Method, prompt)
+		}()
+
+		idStr, err := getStringClaimFromJWT(r.Context(), "sub")
+		if err != nil {
+			app.Logger.Infof("%s: получение записей из JWT: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("получение записей из JWT: %w", err).Error(), http.StatusBadRequest)
+			return
+		}
+
+		idUuid, err := uuid.Parse(idStr)
+		if err != nil {
+			app.Logger.Infof("%s: преобразование строки к uuid: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("преобразование строки к uuid: %w", err).Error(), http.StatusInternalServerError)
+			return
+		}
+
+		var req Company
+		err = json.NewDecoder(r.Body).Decode(&req)
+		if err != nil {
+			app.Logger.Infof("%s: %v", prompt, err)
+			errorResponse(wrappedWriter, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		company := toCompanyModel(&req)
+		company.OwnerID = idUuid
+
+		err = app.CompSvc.Create(r.Context(), &company)
+		if err != nil {
+			app.Logger.Infof("%s: создание компании: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("создание компании: %w", err).Error(), http.StatusBadRequest)
+			return
+		}
+
+		successResponse(wrappedWriter, http.StatusOK, nil)
+	}
+}
+
+func DeleteCompany(app *app.App) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		prompt := "DeleteCompanyHandler"
+		start := time.Now()
+
+		wrappedWriter := &statusResponseWriter{ResponseWriter: w, statusCode: http.StatusOK}
+
+		defer func() {
+			observeRequest(time.Since(start), wrappedWriter.StatusCode(), r.Method, prompt)
+		}()
+
+		ownerIdStr, err := getStringClaimFromJWT(r.Context(), "sub")
+		if err != nil {
+			app.Logger.Infof("%s: получение записей из JWT: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("получение записей из JWT: %w", err).Error(), http.StatusBadRequest)
+			return
+		}
+
+		ownerIdUuid, err := uuid.Parse(ownerIdStr)
+		if err != nil {
+			app.Logger.Infof("%s: преобразование строки к uuid: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("преобразование строки к uuid: %w", err).Error(), http.StatusInternalServerError)
+			return
+		}
+
+		id := chi.URLParam(r, "id")
+		if id == "" {
+			app.Logger.Infof("%s: пустой id", prompt)
+			errorResponse(wrappedWriter, fmt.Errorf("пустой id").Error(), http.StatusBadRequest)
+			return
+		}
+
+		idUuid, err := uuid.Parse(id)
+		if err != nil {
+			app.Logger.Infof("%s: преобразование id к uuid: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("преобразование id к uuid: %w", err).Error(), http.StatusBadRequest)
+			return
+		}
+
+		err = app.CompSvc.DeleteById(r.Context(), idUuid, ownerIdUuid)
+		if err != nil {
+			app.Logger.Infof("%s: удаление компании по id: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("удаление компании по id: %w", err).Error(), http.StatusInternalServerError)
+			return
+		}
+
+		successResponse(wrappedWriter, http.StatusOK, nil)
+	}
+}
+
+func UpdateCompany(app *app.App) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		prompt := "UpdateCompanyHandler"
+		start := time.Now()
+
+		wrappedWriter := &statusResponseWriter{ResponseWriter: w, statusCode: http.StatusOK}
+
+		defer func() {
+			observeRequest(time.Since(start), wrappedWriter.StatusCode(), r.Method, prompt)
+		}()
+
+		ownerIdStr, err := getStringClaimFromJWT(r.Context(), "sub")
+		if err != nil {
+			app.Logger.Infof("%s: получение записей из JWT: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("получение записей из JWT: %w", err).Error(), http.StatusBadRequest)
+			return
+		}
+
+		ownerIdUuid, err := uuid.Parse(ownerIdStr)
+		if err != nil {
+			app.Logger.Infof("%s: преобразование строки к uuid: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("преобразование строки к uuid: %w", err).Error(), http.StatusInternalServerError)
+			return
+		}
+
+		id := chi.URLParam(r, "id")
+		if id == "" {
+			app.Logger.Infof("%s: пустой id", prompt)
+			errorResponse(wrappedWriter, fmt.Errorf("пустой id").Error(), http.StatusBadRequest)
+			return
+		}
+
+		idUuid, err := uuid.Parse(id)
+		if err != nil {
+			app.Logger.Infof("%s: преобразование id к uuid: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("преобразование id к uuid: %w", err).Error(), http.StatusBadRequest)
+			return
+		}
+
+		var req Company
+
+		err = json.NewDecoder(r.Body).Decode(&req)
+		if err != nil {
+			app.Logger.Infof("%s: %v", prompt, err)
+			errorResponse(wrappedWriter, err.Error(), http.StatusBadRequest)
+			return
+		}
+		req.ID = idUuid
+		model := toCompanyModel(&req)
+
+		err = app.CompSvc.Update(r.Context(), &model, ownerIdUuid)
+		if err != nil {
+			app.Logger.Infof("%s: обновление информации о компании: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("обновление информации о компании: %w", err).Error(), http.StatusInternalServerError)
+			return
+		}
+
+		successResponse(wrappedWriter, http.StatusOK, nil)
+	}
+}
+
+func GetCompany(app *app.App) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		prompt := "GetCompanyHandler"
+		start := time.Now()
+
+		wrappedWriter := &statusResponseWriter{ResponseWriter: w, statusCode: http.StatusOK}
+
+		defer func() {
+			observeRequest(time.Since(start), wrappedWriter.StatusCode(), r.Method, prompt)
+		}()
+
+		id := chi.URLParam(r, "id")
+		if id == "" {
+			app.Logger.Infof("%s: пустой id", prompt)
+			errorResponse(wrappedWriter, fmt.Errorf("пустой id").Error(), http.StatusBadRequest)
+			return
+		}
+
+		idUuid, err := uuid.Parse(id)
+		if err != nil {
+			app.Logger.Infof("%s: преобразование id к uuid: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("преобразование id к uuid: %w", err).Error(), http.StatusBadRequest)
+			return
+		}
+
+		company, err := app.CompSvc.GetById(r.Context(), idUuid)
+		if err != nil {
+			app.Logger.Infof("%s: получение компании по id: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("получение компании по id: %w", err).Error(), http.StatusInternalServerError)
+			return
+		}
+
+		successResponse(wrappedWriter, http.StatusOK, map[string]interface{}{"company": toCompanyTransport(company)})
+	}
+}
+
+func GetCompanyByINN(app *app.App) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		prompt := "GetCompanyByINNHandler"
+		start := time.Now()
+
+		wrappedWriter := &statusResponseWriter{ResponseWriter: w, statusCode: http.StatusOK}
+
+		defer func() {
+			observeRequest(time.Since(start), wrappedWriter.StatusCode(), r.Method, prompt)
+		}()
+
+		inn := chi.URLParam(r, "inn")
+		if inn == "" {
+			app.Logger.Infof("%s: пустой ИНН", prompt)
+			errorResponse(wrappedWriter, fmt.Errorf("пустой ИНН").Error(), http.StatusBadRequest)
+			return
+		}
+
+		company, err := app.CompSvc.GetByINN(r.Context(), inn)
+		if err != nil {
+			app.Logger.Infof("%s: получение компании по ИНН: %v", prompt, err)
+
+			status := http.StatusBadRequest
+			if errors.Is(err, domain.ErrCompanyNotFound) {
+				status = http.StatusNotFound
+			}
+
+			errorResponse(wrappedWriter, fmt.Errorf("получение компании по ИНН: %w", err).Error(), status)
+			return
+		}
+
+		successResponse(wrappedWriter, http.StatusOK, map[string]interface{}{"company": toCompanyTransport(company)})
+	}
+}
+
+func ListEntrepreneurCompanies(app *app.App) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		prompt := "ListEntrepreneurCompaniesHandler"
+		start := time.Now()
+
+		wrappedWriter := &statusResponseWriter{ResponseWriter: w, statusCode: http.StatusOK}
+
+		defer func() {
+			observeRequest(time.Since(start), wrappedWriter.StatusCode(), r.Method, prompt)
+		}()
+
+		page := r.URL.Query().Get("page")
+		if page == "" {
+			app.Logger.Infof("%s: пустой номер страницы", prompt)
+			errorResponse(wrappedWriter, fmt.Errorf("пустой номер страницы").Error(), http.StatusBadRequest)
+			return
+		}
+
+		pageInt, err := strconv.Atoi(page)
+		if err != nil {
+			app.Logger.Infof("%s: преобразование к int: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("преобразование к int: %w", err).Error(), http.StatusBadRequest)
+			return
+		}
+
+		entId := r.URL.Query().Get("entrepreneur-id")
+		if page == "" {
+			app.Logger.Infof("%s: пустой id предпринимателя", prompt)
+			errorResponse(wrappedWriter, fmt.Errorf("пустой id предпринимателя").Error(), http.StatusBadRequest)
+			return
+		}
+
+		entUuid, err := uuid.Parse(entId)
+		if err != nil {
+			app.Logger.Infof("%s: преобразование id предпринимателя к uuid: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("преобразование id предпринимателя к uuid: %w", err).Error(), http.StatusInternalServerError)
+			return
+		}
+
+		companies, numPages, err := app.CompSvc.GetByOwnerId(r.Context(), entUuid, pageInt, true)
+		if err != nil {
+			app.Logger.Infof("%s: получение списка компаний: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("получение списка компаний: %w", err).Error(), http.StatusInternalServerError)
+			return
+		}
+
+		companiesTransport := make([]Company, len(companies))
 		for i, company := range companies {
 			companiesTransport[i] = toCompanyTransport(company)
 		}
 
-		successResponse(wrappedWriter, http.StatusOK, map[string]interface{}{"entrepreneur_id": entId, "companies": companiesTransport, "num_pages": numPages})
+		successResponse(wrappedWriter, http.StatusOK, map[string]interface{}{"entrepreneur_id": entId, "companies": companiesTransport, "num_pages": numPages})
+	}
+}
+
+func CreateReport(app *app.App) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		prompt := "CreateReportHandler"
+		start := time.Now()
+
+		wrappedWriter := &statusResponseWriter{ResponseWriter: w, statusCode: http.StatusOK}
+
+		defer func() {
+			observeRequest(time.Since(start), wrappedWriter.StatusCode(), r.Method, prompt)
+		}()
+
+		userIdStr, err := getStringClaimFromJWT(r.Context(), "sub")
+		if err != nil {
+			app.Logger.Infof("%s: получение списка компаний: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("получение записей из JWT: %w", err).Error(), http.StatusBadRequest)
+			return
+		}
+
+		userIdUuid, err := uuid.Parse(userIdStr)
+		if err != nil {
+			app.Logger.Infof("%s: преобразование строки к uuid: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("преобразование строки к uuid: %w", err).Error(), http.StatusInternalServerError)
+			return
+		}
+
+		compIdStr := chi.URLParam(r, "id")
+		if compIdStr == "" {
+			app.Logger.Infof("%s: пустой id компании", prompt)
+			errorResponse(wrappedWriter, fmt.Errorf("пустой id компании").Error(), http.StatusBadRequest)
+			return
+		}
+
+		compIdUuid, err := uuid.Parse(compIdStr)
+		if err != nil {
+			app.Logger.Infof("%s: преобразование строки к uuid: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("преобразование строки к uuid: %w", err).Error(), http.StatusInternalServerError)
+			return
+		}
+
+		company, err := app.CompSvc.GetById(r.Context(), compIdUuid)
+		if err != nil {
+			app.Logger.Infof("%s: создание финансового отчета: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("создание финансового отчета: %w", err).Error(), http.StatusInternalServerError)
+			return
+		}
+
+		if company.OwnerID != userIdUuid {
+			app.Logger.Infof("%s: только владелец компании может добавлять финансовые отчеты", prompt)
+			errorResponse(wrappedWriter, fmt.Errorf("только владелец компании может добавлять финансовые отчеты").Error(), http.StatusInternalServerError)
+			return
+		}
+
+		var req FinancialReport
+		err = json.NewDecoder(r.Body).Decode(&req)
+		if err != nil {
+			app.Logger.Infof("%s: %м", prompt, err)
+			errorResponse(wrappedWriter, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		report := toFinReportModel(&req)
+		report.CompanyID = compIdUuid
+
+		err = app.FinSvc.Create(r.Context(), &report)
+		if err != nil {
+			app.Logger.Infof("%s: создание финансового отчета: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("создание финансового отчета: %w", err).Error(), http.StatusInternalServerError)
+			return
+		}
+
+		successResponse(wrappedWriter, http.StatusOK, nil)
+	}
+}
+
+func DeleteFinReport(app *app.App) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		prompt := "DeleteFinReportHandler"
+		start := time.Now()
+
+		wrappedWriter := &statusResponseWriter{ResponseWriter: w, statusCode: http.StatusOK}
+
+		defer func() {
+			observeRequest(time.Since(start), wrappedWriter.StatusCode(), r.Method, prompt)
+		}()
+
+		userIdStr, err := getStringClaimFromJWT(r.Context(), "sub")
+		if err != nil {
+			app.Logger.Infof("%s: получение записей из JWT: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("получение записей из JWT: %w", err).Error(), http.StatusBadRequest)
+			return
+		}
+
+		userIdUuid, err := uuid.Parse(userIdStr)
+		if err != nil {
+			app.Logger.Infof("%s: преобразование строки к uuid: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("преобразование строки к uuid: %w", err).Error(), http.StatusInternalServerError)
+			return
+		}
+
+		reportIdStr := chi.URLParam(r, "id")
+		if reportIdStr == "" {
+			app.Logger.Infof("%s: пустой id отчета", prompt)
+			errorResponse(wrappedWriter, fmt.Errorf("пустой id отчета").Error(), http.StatusBadRequest)
+			return
+		}
+
+		reportIdUuid, err := uuid.Parse(reportIdStr)
+		if err != nil {
+			app.Logger.Infof("%s: преобразование строки к uuid: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("преобразование строки к uuid: %w", err).Error(), http.StatusInternalServerError)
+			return
+		}
+
+		err = app.FinSvc.DeleteById(r.Context(), reportIdUuid, userIdUuid)
+		if err != nil {
+			app.Logger.Infof("%s: только владелец компании может удалять финансовые отчеты", prompt)
+			errorResponse(wrappedWriter, fmt.Errorf("удаление финансового отчета по id: %w", err).Error(), http.StatusInternalServerError)
+			return
+		}
+
+		successResponse(wrappedWriter, http.StatusOK, nil)
+	}
+}
+
+func UpdateFinReport(app *app.App) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		prompt := "UpdateFinReportHandler"
+		start := time.Now()
+
+		wrappedWriter := &statusResponseWriter{ResponseWriter: w, statusCode: http.StatusOK}
+
+		defer func() {
+			observeRequest(time.Since(start), wrappedWriter.StatusCode(), r.Method, prompt)
+		}()
+
+		userIdStr, err := getStringClaimFromJWT(r.Context(), "sub")
+		if err != nil {
+			app.Logger.Infof("%s: получение записей из JWT: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("получение записей из JWT: %w", err).Error(), http.StatusBadRequest)
+			return
+		}
+
+		userIdUuid, err := uuid.Parse(userIdStr)
+		if err != nil {
+			app.Logger.Infof("%s: преобразование строки к uuid: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("преобразование строки к uuid: %w", err).Error(), http.StatusInternalServerError)
+			return
+		}
+
+		reportIdStr := chi.URLParam(r, "id")
+		if reportIdStr == "" {
+			app.Logger.Infof("%s: пустой id отчета", prompt)
+			errorResponse(wrappedWriter, fmt.Errorf("пустой id отчета").Error(), http.StatusBadRequest)
+			return
+		}
+
+		reportIdUuid, err := uuid.Parse(reportIdStr)
+		if err != nil {
+			app.Logger.Infof("%s: преобразование строки к uuid: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("преобразование строки к uuid: %w", err).Error(), http.StatusInternalServerError)
+			return
+		}
+
+		var req FinancialReport
+
+		err = json.NewDecoder(r.Body).Decode(&req)
+		if err != nil {
+			app.Logger.Infof("%s: %v", prompt, err)
+			errorResponse(wrappedWriter, err.Error(), http.StatusBadRequest)
+			return
+		}
+		req.ID = reportIdUuid
+		model := toFinReportModel(&req)
+
+		err = app.FinSvc.Update(r.Context(), &model, userIdUuid)
+		if err != nil {
+			app.Logger.Infof("%s: обновление информации о финансовом отчете: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("обновление информации о финансовом отчете: %w", err).Error(), http.StatusInternalServerError)
+			return
+		}
+
+		successResponse(wrappedWriter, http.StatusOK, nil)
+	}
+}
+
+func GetFinReport(app *app.App) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		prompt := "GetFinReportHandler"
+		start := time.Now()
+
+		wrappedWriter := &statusResponseWriter{ResponseWriter: w, statusCode: http.StatusOK}
+
+		defer func() {
+			observeRequest(time.Since(start), wrappedWriter.StatusCode(), r.Method, prompt)
+		}()
+
+		id := chi.URLParam(r, "id")
+		if id == "" {
+			app.Logger.Infof("%s: пустой id", prompt)
+			errorResponse(wrappedWriter, fmt.Errorf("пустой id").Error(), http.StatusBadRequest)
+			return
+		}
+
+		idUuid, err := uuid.Parse(id)
+		if err != nil {
+			app.Logger.Infof("%s: преобразование id к uuid: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("преобразование id к uuid: %w", err).Error(), http.StatusBadRequest)
+			return
+		}
+
+		report, err := app.FinSvc.GetById(r.Context(), idUuid)
+		if err != nil {
+			app.Logger.Infof("%s: получение финансового отчета по id: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("получение финансового отчета по id: %w", err).Error(), http.StatusInternalServerError)
+			return
+		}
+
+		successResponse(wrappedWriter, http.StatusOK, map[string]interface{}{"financial_report": toFinReportTransport(report)})
+	}
+}
+
+func ListCompanyReports(app *app.App) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		prompt := "ListCompanyReportsHandler"
+		start := time.Now()
+
+		wrappedWriter := &statusResponseWriter{ResponseWriter: w, statusCode: http.StatusOK}
+
+		defer func() {
+			observeRequest(time.Since(start), wrappedWriter.StatusCode(), r.Method, prompt)
+		}()
+
+		period, err := parsePeriodFromURL(r)
+		if err != nil {
+			app.Logger.Infof("%s: парсинг периода из URL: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("парсинг периода из URL: %w", err).Error(), http.StatusBadRequest)
+			return
+		}
+
+		compIdUuid, err := parseUUIDFromURL(r, "id", "company")
+		if err != nil {
+			app.Logger.Infof("%s: парсинг id компании из URL: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("парсинг id компании из URL: %w", err).Error(), http.StatusBadRequest)
+			return
+		}
+
+		reports, err := app.FinSvc.GetByCompany(r.Context(), compIdUuid, period)
+		if err != nil {
+			app.Logger.Infof("%s: получение отчетов компании: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("получение отчетов компании: %w", err).Error(), http.StatusInternalServerError)
+			return
+		}
+
+		reportsTransport := make([]FinancialReport, len(reports.Reports))
+		for i, rep := range reports.Reports {
+			reportsTransport[i] = toFinReportTransport(&rep)
+		}
+
+		successResponse(wrappedWriter, http.StatusOK,
+			map[string]interface{}{
+				"company_id": compIdUuid,
+				"period":     toPeriodTransport(period),
+				"revenue":    reports.Revenue(),
+				"costs":      reports.Costs(),
+				"profit":     reports.Profit(),
+				"reports":    reportsTransport},
+		)
+	}
+}
+
+func CalculateRating(app *app.App) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		prompt := "CalculateRatingHandler"
+		start := time.Now()
+
+		wrappedWriter := &statusResponseWriter{ResponseWriter: w, statusCode: http.StatusOK}
+
+		defer func() {
+			observeRequest(time.Since(start), wrappedWriter.StatusCode(), r.Method, prompt)
+		}()
+
+		id := chi.URLParam(r, "id")
+		if id == "" {
+			app.Logger.Infof("%s: пустой id", prompt)
+			errorResponse(wrappedWriter, fmt.Errorf("пустой id").Error(), http.StatusBadRequest)
+			return
+		}
+
+		idUuid, err := uuid.Parse(id)
+		if err != nil {
+			app.Logger.Infof("%s: преобразование id к uuid: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("преобразование id к uuid: %w", err).Error(), http.StatusBadRequest)
+			return
+		}
+
+		period, err := parseRatingPeriod(app, r)
+		if err != nil {
+			app.Logger.Infof("%s: парсинг периода из URL: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("парсинг периода из URL: %w", err).Error(), http.StatusBadRequest)
+			return
+		}
+
+		breakdown, err := app.Interactor.CalculateUserRating(r.Context(), idUuid, period, r.URL.Query().Get("strategy"))
+		if err != nil {
+			app.Logger.Infof("%s: вычисление рейтинга предпринимателя: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("вычисление рейтинга предпринимателя: %w", err).Error(), ratingErrorStatus(err))
+			return
+		}
+
+		successResponse(wrappedWriter, http.StatusOK, map[string]float32{"rating": breakdown.Rating})
+	}
+}
+
+// GetRatingBreakdown возвращает рейтинг предпринимателя вместе с данными, из которых он вычислен.
+func GetRatingBreakdown(app *app.App) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		prompt := "GetRatingBreakdownHandler"
+		start := time.Now()
+
+		wrappedWriter := &statusResponseWriter{ResponseWriter: w, statusCode: http.StatusOK}
+
+		defer func() {
+			observeRequest(time.Since(start), wrappedWriter.StatusCode(), r.Method, prompt)
+		}()
+
+		userId, err := parseUUIDFromURL(r, "id", "entrepreneur")
+		if err != nil {
+			app.Logger.Infof("%s: %v", prompt, err)
+			errorResponse(wrappedWriter, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		period, err := parseRatingPeriod(app, r)
+		if err != nil {
+			app.Logger.Infof("%s: парсинг периода из URL: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("парсинг периода из URL: %w", err).Error(), http.StatusBadRequest)
+			return
+		}
+
+		breakdown, err := app.Interactor.CalculateUserRating(r.Context(), userId, period, r.URL.Query().Get("strategy"))
+		if err != nil {
+			app.Logger.Infof("%s: вычисление рейтинга предпринимателя: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("вычисление рейтинга предпринимателя: %w", err).Error(), ratingErrorStatus(err))
+			return
+		}
+
+		successResponse(wrappedWriter, http.StatusOK, map[string]interface{}{"rating": toRatingBreakdownTransport(breakdown)})
+	}
+}
+
+// GetRatingHistory возвращает рейтинги предпринимателя по итогам кварталов в хронологическом порядке:
+// за период из параметров start-year, start-quarter, end-year и end-quarter, а если они не указаны - за все время.
+// Рейтинги вычислены стратегией из параметра strategy, по умолчанию - заданной в конфиге.
+func GetRatingHistory(app *app.App) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		prompt := "GetRatingHistoryHandler"
+		start := time.Now()
+
+		wrappedWriter := &statusResponseWriter{ResponseWriter: w, statusCode: http.StatusOK}
+
+		defer func() {
+			observeRequest(time.Since(start), wrappedWriter.StatusCode(), r.Method, prompt)
+		}()
+
+		userId, err := parseUUIDFromURL(r, "id", "entrepreneur")
+		if err != nil {
+			app.Logger.Infof("%s: %v", prompt, err)
+			errorResponse(wrappedWriter, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		var period *domain.Period
+		if r.URL.Query().Get("start-year") != "" {
+			period, err = parsePeriodFromURL(r)
+			if err != nil {
+				app.Logger.Infof("%s: парсинг периода из URL: %v", prompt, err)
+				errorResponse(wrappedWriter, fmt.Errorf("парсинг периода из URL: %w", err).Error(), http.StatusBadRequest)
+				return
+			}
+		}
+
+		strategy, err := app.Interactor.ResolveStrategy(r.URL.Query().Get("strategy"))
+		if err != nil {
+			app.Logger.Infof("%s: %v", prompt, err)
+			errorResponse(wrappedWriter, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		snapshots, err := app.SnapshotSvc.GetByUserId(r.Context(), userId, period, strategy)
+		if err != nil {
+			app.Logger.Infof("%s: получение истории рейтинга: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("получение истории рейтинга: %w", err).Error(), http.StatusInternalServerError)
+			return
+		}
+
+		history := make([]RatingSnapshot, len(snapshots))
+		for i, snapshot := range snapshots {
+			history[i] = toRatingSnapshotTransport(snapshot)
+		}
+
+		successResponse(wrappedWriter, http.StatusOK, map[string]interface{}{"history": history})
+	}
+}
+
+// GetEntrepreneurInfluence возвращает влияние предпринимателя в сферах деятельности за период
+// из параметров запроса (см. parseRatingPeriod).
+func GetEntrepreneurInfluence(app *app.App) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		prompt := "GetEntrepreneurInfluenceHandler"
+		start := time.Now()
+
+		wrappedWriter := &statusResponseWriter{ResponseWriter: w, statusCode: http.StatusOK}
+
+		defer func() {
+			observeRequest(time.Since(start), wrappedWriter.StatusCode(), r.Method, prompt)
+		}()
+
+		userId, err := parseUUIDFromURL(r, "id", "entrepreneur")
+		if err != nil {
+			app.Logger.Infof("%s: %v", prompt, err)
+			errorResponse(wrappedWriter, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		period, err := parseRatingPeriod(app, r)
+		if err != nil {
+			app.Logger.Infof("%s: парсинг периода из URL: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("парсинг периода из URL: %w", err).Error(), http.StatusBadRequest)
+			return
+		}
+
+		influence, err := app.Interactor.GetUserInfluence(r.Context(), userId, period)
+		if err != nil {
+			app.Logger.Infof("%s: %v", prompt, err)
+			errorResponse(wrappedWriter, err.Error(), ratingErrorStatus(err))
+			return
+		}
+
+		influenceTransport := make([]ActivityFieldInfluence, len(influence))
+		for i, entry := range influence {
+			influenceTransport[i] = toActFieldInfluenceTransport(entry)
+		}
+
+		successResponse(wrappedWriter, http.StatusOK, map[string]interface{}{"influence": influenceTransport})
+	}
+}
+
+func ListEntrepreneursRanking(app *app.App) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		prompt := "ListEntrepreneursRankingHandler"
+		start := time.Now()
+
+		wrappedWriter := &statusResponseWriter{ResponseWriter: w, statusCode: http.StatusOK}
+
+		defer func() {
+			observeRequest(time.Since(start), wrappedWriter.StatusCode(), r.Method, prompt)
+		}()
+
+		page := r.URL.Query().Get("page")
+		if page == "" {
+			app.Logger.Infof("%s: пустой номер страницы", prompt)
+			errorResponse(wrappedWriter, fmt.Errorf("пустой номер страницы").Error(), http.StatusBadRequest)
+			return
+		}
+
+		pageInt, err := strconv.Atoi(page)
+		if err != nil {
+			app.Logger.Infof("%s: преобразование номера страницы к int: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("преобразование номера страницы к int: %w", err).Error(), http.StatusBadRequest)
+			return
+		}
+
+		filter, err := parseUserFilterFromURL(r)
+		if err != nil {
+			app.Logger.Infof("%s: парсинг фильтра из URL: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("парсинг фильтра из URL: %w", err).Error(), http.StatusBadRequest)
+			return
+		}
+
+		period, err := parseRatingPeriod(app, r)
+		if err != nil {
+			app.Logger.Infof("%s: парсинг периода из URL: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("парсинг периода из URL: %w", err).Error(), http.StatusBadRequest)
+			return
+		}
+
+		ranking, numPages, err := app.Interactor.GetRanking(r.Context(), filter, period, pageInt, r.URL.Query().Get("strategy"))
+		if err != nil {
+			app.Logger.Infof("%s: построение рейтинга предпринимателей: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("построение рейтинга предпринимателей: %w", err).Error(), ratingErrorStatus(err))
+			return
+		}
+
+		rankingTransport := make([]UserRating, len(ranking))
+		for i, rating := range ranking {
+			rankingTransport[i] = toUserRatingTransport(rating)
+		}
+
+		successResponse(wrappedWriter, http.StatusOK, map[string]interface{}{"num_pages": numPages, "ranking": rankingTransport})
+	}
+}
+
+func GetEntrepreneurFinancials(app *app.App) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		prompt := "GetEntrepreneurFinancials"
+		start := time.Now()
+
+		wrappedWriter := &statusResponseWriter{ResponseWriter: w, statusCode: http.StatusOK}
+
+		defer func() {
+			observeRequest(time.Since(start), wrappedWriter.StatusCode(), r.Method, prompt)
+		}()
+
+		id := r.URL.Query().Get("entrepreneur-id")
+		if id == "" {
+			app.Logger.Infof("%s: пустой id предпринимателя", prompt)
+			errorResponse(wrappedWriter, fmt.Errorf("пустой id предпринимателя").Error(), http.StatusBadRequest)
+			return
+		}
+
+		idUuid, err := uuid.Parse(id)
+		if err != nil {
+			app.Logger.Infof("%s: преобразование id к uuid: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("преобразование id к uuid: %w", err).Error(), http.StatusBadRequest)
+			return
+		}
+
+		period, err := parseRatingPeriod(app, r)
+		if err != nil {
+			app.Logger.Infof("%s: парсинг периода из URL: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("парсинг периода из URL: %w", err).Error(), http.StatusBadRequest)
+			return
+		}
+
+		rep, err := app.Interactor.GetUserFinancialReport(r.Context(), idUuid, period)
+		if err != nil {
+			app.Logger.Infof("%s: получение финансового отчета предпринимателя: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("получение финансового отчета предпринимателя: %w", err).Error(), http.StatusInternalServerError)
+			return
+		}
+
+		successResponse(wrappedWriter, http.StatusOK, map[string]decimal.Decimal{
+			"revenue": rep.Revenue(),
+			"costs":   rep.Costs(),
+			"profit":  rep.Profit(),
+			"taxes":   rep.Taxes,
+			"taxLoad": rep.TaxLoad,
+		})
+	}
+}
+
+func CreateSkill(app *app.App) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		prompt := "CreateSkillHandler"
+		start := time.Now()
+
+		wrappedWriter := &statusResponseWriter{ResponseWriter: w, statusCode: http.StatusOK}
+
+		defer func() {
+			observeRequest(time.Since(start), wrappedWriter.StatusCode(), r.Method, prompt)
+		}()
+
+		var req Skill
+		err := json.NewDecoder(r.Body).Decode(&req)
+		if err != nil {
+			app.Logger.Infof("%s: %v", prompt, err)
+			errorResponse(wrappedWriter, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		skill := toSkillModel(&req)
+
+		err = app.SkillSvc.Create(r.Context(), &skill)
+		if err != nil {
+			app.Logger.Infof("%s: создание навыка: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("создание навыка: %w", err).Error(), http.StatusBadRequest)
+			return
+		}
+
+		successResponse(wrappedWriter, http.StatusOK, nil)
+	}
+}
+
+func DeleteSkill(app *app.App) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		prompt := "DeleteSkillHandler"
+		start := time.Now()
+
+		wrappedWriter := &statusResponseWriter{ResponseWriter: w, statusCode: http.StatusOK}
+
+		defer func() {
+			observeRequest(time.Since(start), wrappedWriter.StatusCode(), r.Method, prompt)
+		}()
+
+		idUuid, err := parseUUIDFromURL(r, "id", "skill")
+		if err != nil {
+			app.Logger.Infof("%s: парсинг id навыка из URL: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("парсинг id навыка из URL: %w", err).Error(), http.StatusBadRequest)
+			return
+		}
+
+		err = app.SkillSvc.DeleteById(r.Context(), idUuid)
+		if err != nil {
+			app.Logger.Infof("%s: удаление навыка по id: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("удаление навыка по id: %w", err).Error(), http.StatusInternalServerError)
+			return
+		}
+
+		successResponse(wrappedWriter, http.StatusOK, nil)
+	}
+}
+
+func UpdateSkill(app *app.App) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		prompt := "UpdateSkillHandler"
+		start := time.Now()
+
+		wrappedWriter := &statusResponseWriter{ResponseWriter: w, statusCode: http.StatusOK}
+
+		defer func() {
+			observeRequest(time.Since(start), wrappedWriter.StatusCode(), r.Method, prompt)
+		}()
+
+		idUuid, err := parseUUIDFromURL(r, "id", "skill")
+		if err != nil {
+			app.Logger.Infof("%s: парсинг id навыка из URL: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("парсинг id навыка из URL: %w", err).Error(), http.StatusBadRequest)
+			return
+		}
+
+		var req Skill
+
+		err = json.NewDecoder(r.Body).Decode(&req)
+		if err != nil {
+			app.Logger.Infof("%s: %v", prompt, err)
+			errorResponse(wrappedWriter, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		req.ID = idUuid
+		model := toSkillModel(&req)
+
+		err = app.SkillSvc.Update(r.Context(), &model)
+		if err != nil {
+			app.Logger.Infof("%s: обновление информации о навыке: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("обновление информации о навыке: %w", err).Error(), http.StatusInternalServerError)
+			return
+		}
+
+		successResponse(wrappedWriter, http.StatusOK, nil)
+	}
+}
+
+func GetSkill(app *app.App) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		prompt := "GetSkillHandler"
+		start := time.Now()
+
+		wrappedWriter := &statusResponseWriter{ResponseWriter: w, statusCode: http.StatusOK}
+
+		defer func() {
+			observeRequest(time.Since(start), wrappedWriter.StatusCode(), r.Method, prompt)
+		}()
+
+		idUuid, err := parseUUIDFromURL(r, "id", "skill")
+		if err != nil {
+			app.Logger.Infof("%s: парсинг id навыка из URL: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("парсинг id навыка из URL: %w", err).Error(), http.StatusBadRequest)
+			return
+		}
+
+		skill, err := app.SkillSvc.GetById(r.Context(), idUuid)
+		if err != nil {
+			app.Logger.Infof("%s: получение навыка по id: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("получение навыка по id: %w", err).Error(), http.StatusInternalServerError)
+			return
+		}
+
+		successResponse(wrappedWriter, http.StatusOK, map[string]interface{}{"skill": toSkillTransport(skill)})
+	}
+}
+
+func ListSkills(app *app.App) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		prompt := "ListSkillsHandler"
+		start := time.Now()
+
+		wrappedWriter := &statusResponseWriter{ResponseWriter: w, statusCode: http.StatusOK}
+
+		defer func() {
+			observeRequest(time.Since(start), wrappedWriter.StatusCode(), r.Method, prompt)
+		}()
+
+		var paginated bool
+		var pageInt int
+		var err error
+
+		page := r.URL.Query().Get("page")
+		if page != "" {
+			paginated = true
+
+			pageInt, err = strconv.Atoi(page)
+			if err != nil {
+				app.Logger.Infof("%s: преобразование страницы к int: %v", prompt, err)
+				errorResponse(wrappedWriter, fmt.Errorf("преобразование страницы к int: %w", err).Error(), http.StatusBadRequest)
+				return
+			}
+		}
+
+		skills, numPages, err := app.SkillSvc.GetAll(r.Context(), pageInt, paginated)
+		if err != nil {
+			app.Logger.Infof("%s: получение списка навыков: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("получение списка навыков: %w", err).Error(), http.StatusInternalServerError)
+			return
+		}
+
+		skillsTransport := make([]Skill, len(skills))
+		for i, skill := range skills {
+			skillsTransport[i] = toSkillTransport(skill)
+		}
+
+		successResponse(wrappedWriter, http.StatusOK, map[string]interface{}{"skills": skillsTransport, "num_pages": numPages})
+	}
+}
+
+func AddEntrepreneurSkill(app *app.App) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		prompt := "AddEntrepreneurSkillHandler"
+		start := time.Now()
+
+		wrappedWriter := &statusResponseWriter{ResponseWriter: w, statusCode: http.StatusOK}
+
+		defer func() {
+			observeRequest(time.Since(start), wrappedWriter.StatusCode(), r.Method, prompt)
+		}()
+
+		userIdStr, err := getStringClaimFromJWT(r.Context(), "sub")
+		if err != nil {
+			app.Logger.Infof("%s: получение записей из JWT: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("получение записей из JWT: %w", err).Error(), http.StatusBadRequest)
+			return
+		}
+
+		userIdUuid, err := uuid.Parse(userIdStr)
+		if err != nil {
+			app.Logger.Infof("%s: преобразование строки к uuid: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("преобразование строки к uuid: %w", err).Error(), http.StatusInternalServerError)
+			return
+		}
+
+		type Req struct {
+			SkillID uuid.UUID `json:"skillId"`
+		}
+		var req Req
+
+		err = json.NewDecoder(r.Body).Decode(&req)
+		if err != nil {
+			app.Logger.Infof("%s: %v", prompt, err)
+			errorResponse(wrappedWriter, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		err = app.SkillSvc.AddToUser(r.Context(), userIdUuid, req.SkillID)
+		if err != nil {
+			app.Logger.Infof("%s: добавление навыка предпринимателю: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("добавление навыка предпринимателю: %w", err).Error(), http.StatusBadRequest)
+			return
+		}
+
+		successResponse(wrappedWriter, http.StatusOK, nil)
+	}
+}
+
+func DeleteEntrepreneurSkill(app *app.App) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		prompt := "DeleteEntrepreneurSkillHandler"
+		start := time.Now()
+
+		wrappedWriter := &statusResponseWriter{ResponseWriter: w, statusCode: http.StatusOK}
+
+		defer func() {
+			observeRequest(time.Since(start), wrappedWriter.StatusCode(), r.Method, prompt)
+		}()
+
+		userIdStr, err := getStringClaimFromJWT(r.Context(), "sub")
+		if err != nil {
+			app.Logger.Infof("%s: получение записей из JWT: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("получение записей из JWT: %w", err).Error(), http.StatusBadRequest)
+			return
+		}
+
+		userIdUuid, err := uuid.Parse(userIdStr)
+		if err != nil {
+			app.Logger.Infof("%s: преобразование строки к uuid: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("преобразование строки к uuid: %w", err).Error(), http.StatusInternalServerError)
+			return
+		}
+
+		skillIdUuid, err := parseUUIDFromURL(r, "id", "skill")
+		if err != nil {
+			app.Logger.Infof("%s: парсинг id навыка из URL: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("парсинг id навыка из URL: %w", err).Error(), http.StatusBadRequest)
+			return
+		}
+
+		err = app.SkillSvc.DeleteFromUser(r.Context(), userIdUuid, skillIdUuid)
+		if err != nil {
+			app.Logger.Infof("%s: удаление навыка у предпринимателя: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("удаление навыка у предпринимателя: %w", err).Error(), http.StatusInternalServerError)
+			return
+		}
+
+		successResponse(wrappedWriter, http.StatusOK, nil)
+	}
+}
+
+func ListEntrepreneurReviews(app *app.App) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		prompt := "ListEntrepreneurReviewsHandler"
+		start := time.Now()
+
+		wrappedWriter := &statusResponseWriter{ResponseWriter: w, statusCode: http.StatusOK}
+
+		defer func() {
+			observeRequest(time.Since(start), wrappedWriter.StatusCode(), r.Method, prompt)
+		}()
+
+		entIdUuid, err := parseUUIDFromURL(r, "id", "entrepreneur")
+		if err != nil {
+			app.Logger.Infof("%s: парсинг id предпринимателя из URL: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("парсинг id предпринимателя из URL: %w", err).Error(), http.StatusBadRequest)
+			return
+		}
+
+		page := r.URL.Query().Get("page")
+		if page == "" {
+			app.Logger.Infof("%s: пустой номер страницы", prompt)
+			errorResponse(wrappedWriter, fmt.Errorf("пустой номер страницы").Error(), http.StatusBadRequest)
+			return
+		}
+
+		pageInt, err := strconv.Atoi(page)
+		if err != nil {
+			app.Logger.Infof("%s: преобразование номера страницы к int: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("преобразование номера страницы к int: %w", err).Error(), http.StatusBadRequest)
+			return
+		}
+
+		reviews, numPages, err := app.ReviewSvc.GetByTarget(r.Context(), entIdUuid, pageInt)
+		if err != nil {
+			app.Logger.Infof("%s: получение отзывов о предпринимателе: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("получение отзывов о предпринимателе: %w", err).Error(), http.StatusInternalServerError)
+			return
+		}
+
+		reviewsTransport := make([]Review, len(reviews))
+		for i, review := range reviews {
+			reviewsTransport[i] = toReviewTransport(review)
+		}
+
+		successResponse(wrappedWriter, http.StatusOK, map[string]interface{}{"entrepreneur_id": entIdUuid, "reviews": reviewsTransport, "num_pages": numPages})
+	}
+}
+
+func CreateReview(app *app.App) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		prompt := "CreateReviewHandler"
+		start := time.Now()
+
+		wrappedWriter := &statusResponseWriter{ResponseWriter: w, statusCode: http.StatusOK}
+
+		defer func() {
+			observeRequest(time.Since(start), wrappedWriter.StatusCode(), r.Method, prompt)
+		}()
+
+		userIdStr, err := getStringClaimFromJWT(r.Context(), "sub")
+		if err != nil {
+			app.Logger.Infof("%s: получение записей из JWT: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("получение записей из JWT: %w", err).Error(), http.StatusBadRequest)
+			return
+		}
+
+		userIdUuid, err := uuid.Parse(userIdStr)
+		if err != nil {
+			app.Logger.Infof("%s: преобразование строки к uuid: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("преобразование строки к uuid: %w", err).Error(), http.StatusInternalServerError)
+			return
+		}
+
+		entIdUuid, err := parseUUIDFromURL(r, "id", "entrepreneur")
+		if err != nil {
+			app.Logger.Infof("%s: парсинг id предпринимателя из URL: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("парсинг id предпринимателя из URL: %w", err).Error(), http.StatusBadRequest)
+			return
+		}
+
+		var req Review
+		err = json.NewDecoder(r.Body).Decode(&req)
+		if err != nil {
+			app.Logger.Infof("%s: %v", prompt, err)
+			errorResponse(wrappedWriter, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		review := toReviewModel(&req)
+		review.TargetID = entIdUuid
+		review.ReviewerID = userIdUuid
+
+		err = app.ReviewSvc.Create(r.Context(), &review)
+		if err != nil {
+			app.Logger.Infof("%s: создание отзыва: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("создание отзыва: %w", err).Error(), http.StatusBadRequest)
+			return
+		}
+
+		successResponse(wrappedWriter, http.StatusOK, nil)
+	}
+}
+
+func UpdateReview(app *app.App) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		prompt := "UpdateReviewHandler"
+		start := time.Now()
+
+		wrappedWriter := &statusResponseWriter{ResponseWriter: w, statusCode: http.StatusOK}
+
+		defer func() {
+			observeRequest(time.Since(start), wrappedWriter.StatusCode(), r.Method, prompt)
+		}()
+
+		userIdStr, err := getStringClaimFromJWT(r.Context(), "sub")
+		if err != nil {
+			app.Logger.Infof("%s: получение записей из JWT: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("получение записей из JWT: %w", err).Error(), http.StatusBadRequest)
+			return
+		}
+
+		userIdUuid, err := uuid.Parse(userIdStr)
+		if err != nil {
+			app.Logger.Infof("%s: преобразование строки к uuid: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("преобразование строки к uuid: %w", err).Error(), http.StatusInternalServerError)
+			return
+		}
+
+		targetIdUuid, err := parseUUIDFromURL(r, "id", "entrepreneur")
+		if err != nil {
+			app.Logger.Infof("%s: парсинг id предпринимателя из URL: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("парсинг id предпринимателя из URL: %w", err).Error(), http.StatusBadRequest)
+			return
+		}
+
+		reviewIdUuid, err := parseUUIDFromURL(r, "reviewId", "review")
+		if err != nil {
+			app.Logger.Infof("%s: парсинг id отзыва из URL: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("парсинг id отзыва из URL: %w", err).Error(), http.StatusBadRequest)
+			return
+		}
+
+		var req Review
+
+		err = json.NewDecoder(r.Body).Decode(&req)
+		if err != nil {
+			app.Logger.Infof("%s: %v", prompt, err)
+			errorResponse(wrappedWriter, err.Error(), http.StatusBadRequest)
+			return
+		}
+		req.ID = reviewIdUuid
+		req.TargetID = targetIdUuid
+		model := toReviewModel(&req)
+
+		err = app.ReviewSvc.Update(r.Context(), &model, userIdUuid)
+		if err != nil {
+			app.Logger.Infof("%s: обновление отзыва: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("обновление отзыва: %w", err).Error(), reviewErrorStatus(err))
+			return
+		}
+
+		successResponse(wrappedWriter, http.StatusOK, nil)
+	}
+}
+
+func DeleteReview(app *app.App) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		prompt := "DeleteReviewHandler"
+		start := time.Now()
+
+		wrappedWriter := &statusResponseWriter{ResponseWriter: w, statusCode: http.StatusOK}
+
+		defer func() {
+			observeRequest(time.Since(start), wrappedWriter.StatusCode(), r.Method, prompt)
+		}()
+
+		userIdStr, err := getStringClaimFromJWT(r.Context(), "sub")
+		if err != nil {
+			app.Logger.Infof("%s: получение записей из JWT: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("получение записей из JWT: %w", err).Error(), http.StatusBadRequest)
+			return
+		}
+
+		userIdUuid, err := uuid.Parse(userIdStr)
+		if err != nil {
+			app.Logger.Infof("%s: преобразование строки к uuid: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("преобразование строки к uuid: %w", err).Error(), http.StatusInternalServerError)
+			return
+		}
+
+		targetIdUuid, err := parseUUIDFromURL(r, "id", "entrepreneur")
+		if err != nil {
+			app.Logger.Infof("%s: парсинг id предпринимателя из URL: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("парсинг id предпринимателя из URL: %w", err).Error(), http.StatusBadRequest)
+			return
+		}
+
+		reviewIdUuid, err := parseUUIDFromURL(r, "reviewId", "review")
+		if err != nil {
+			app.Logger.Infof("%s: парсинг id отзыва из URL: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("парсинг id отзыва из URL: %w", err).Error(), http.StatusBadRequest)
+			return
+		}
+
+		err = app.ReviewSvc.DeleteById(r.Context(), reviewIdUuid, targetIdUuid, userIdUuid)
+		if err != nil {
+			app.Logger.Infof("%s: удаление отзыва по id: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("удаление отзыва по id: %w", err).Error(), reviewErrorStatus(err))
+			return
+		}
+
+		successResponse(wrappedWriter, http.StatusOK, nil)
+	}
+}
+
+func CreateTaxSchedule(app *app.App) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		prompt := "CreateTaxScheduleHandler"
+		start := time.Now()
+
+		wrappedWriter := &statusResponseWriter{ResponseWriter: w, statusCode: http.StatusOK}
+
+		defer func() {
+			observeRequest(time.Since(start), wrappedWriter.StatusCode(), r.Method, prompt)
+		}()
+
+		var req TaxSchedule
+		err := json.NewDecoder(r.Body).Decode(&req)
+		if err != nil {
+			app.Logger.Infof("%s: %v", prompt, err)
+			errorResponse(wrappedWriter, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		schedule := toTaxScheduleModel(&req)
+
+		err = app.TaxSvc.Create(r.Context(), &schedule)
+		if err != nil {
+			app.Logger.Infof("%s: создание налоговой шкалы: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("создание налоговой шкалы: %w", err).Error(), http.StatusBadRequest)
+			return
+		}
+
+		successResponse(wrappedWriter, http.StatusOK, nil)
+	}
+}
+
+func UpdateTaxSchedule(app *app.App) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		prompt := "UpdateTaxScheduleHandler"
+		start := time.Now()
+
+		wrappedWriter := &statusResponseWriter{ResponseWriter: w, statusCode: http.StatusOK}
+
+		defer func() {
+			observeRequest(time.Since(start), wrappedWriter.StatusCode(), r.Method, prompt)
+		}()
+
+		idUuid, err := parseUUIDFromURL(r, "id", "tax schedule")
+		if err != nil {
+			app.Logger.Infof("%s: парсинг id налоговой шкалы из URL: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("парсинг id налоговой шкалы из URL: %w", err).Error(), http.StatusBadRequest)
+			return
+		}
+
+		var req TaxSchedule
+
+		err = json.NewDecoder(r.Body).Decode(&req)
+		if err != nil {
+			app.Logger.Infof("%s: %v", prompt, err)
+			errorResponse(wrappedWriter, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		req.ID = idUuid
+		model := toTaxScheduleModel(&req)
+
+		err = app.TaxSvc.Update(r.Context(), &model)
+		if err != nil {
+			app.Logger.Infof("%s: обновление налоговой шкалы: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("обновление налоговой шкалы: %w", err).Error(), http.StatusBadRequest)
+			return
+		}
+
+		successResponse(wrappedWriter, http.StatusOK, nil)
+	}
+}
+
+func DeleteTaxSchedule(app *app.App) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		prompt := "DeleteTaxScheduleHandler"
+		start := time.Now()
+
+		wrappedWriter := &statusResponseWriter{ResponseWriter: w, statusCode: http.StatusOK}
+
+		defer func() {
+			observeRequest(time.Since(start), wrappedWriter.StatusCode(), r.Method, prompt)
+		}()
+
+		idUuid, err := parseUUIDFromURL(r, "id", "tax schedule")
+		if err != nil {
+			app.Logger.Infof("%s: парсинг id налоговой шкалы из URL: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("парсинг id налоговой шкалы из URL: %w", err).Error(), http.StatusBadRequest)
+			return
+		}
+
+		err = app.TaxSvc.DeleteById(r.Context(), idUuid)
+		if err != nil {
+			app.Logger.Infof("%s: удаление налоговой шкалы по id: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("удаление налоговой шкалы по id: %w", err).Error(), http.StatusInternalServerError)
+			return
+		}
+
+		successResponse(wrappedWriter, http.StatusOK, nil)
+	}
+}
+
+func GetTaxSchedule(app *app.App) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		prompt := "GetTaxScheduleHandler"
+		start := time.Now()
+
+		wrappedWriter := &statusResponseWriter{ResponseWriter: w, statusCode: http.StatusOK}
+
+		defer func() {
+			observeRequest(time.Since(start), wrappedWriter.StatusCode(), r.Method, prompt)
+		}()
+
+		idUuid, err := parseUUIDFromURL(r, "id", "tax schedule")
+		if err != nil {
+			app.Logger.Infof("%s: парсинг id налоговой шкалы из URL: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("парсинг id налоговой шкалы из URL: %w", err).Error(), http.StatusBadRequest)
+			return
+		}
+
+		schedule, err := app.TaxSvc.GetById(r.Context(), idUuid)
+		if err != nil {
+			app.Logger.Infof("%s: получение налоговой шкалы по id: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("получение налоговой шкалы по id: %w", err).Error(), http.StatusInternalServerError)
+			return
+		}
+
+		successResponse(wrappedWriter, http.StatusOK, map[string]interface{}{"tax_schedule": toTaxScheduleTransport(schedule)})
+	}
+}
+
+func ListTaxSchedules(app *app.App) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		prompt := "ListTaxSchedulesHandler"
+		start := time.Now()
+
+		wrappedWriter := &statusResponseWriter{ResponseWriter: w, statusCode: http.StatusOK}
+
+		defer func() {
+			observeRequest(time.Since(start), wrappedWriter.StatusCode(), r.Method, prompt)
+		}()
+
+		schedules, err := app.TaxSvc.GetAll(r.Context())
+		if err != nil {
+			app.Logger.Infof("%s: получение списка налоговых шкал: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("получение списка налоговых шкал: %w", err).Error(), http.StatusInternalServerError)
+			return
+		}
+
+		schedulesTransport := make([]TaxSchedule, len(schedules))
+		for i, schedule := range schedules {
+			schedulesTransport[i] = toTaxScheduleTransport(schedule)
+		}
+
+		successResponse(wrappedWriter, http.StatusOK, map[string]interface{}{"tax_schedules": schedulesTransport})
+	}
+}
+
+func ListRoles(app *app.App) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		prompt := "ListRolesHandler"
+		start := time.Now()
+
+		wrappedWriter := &statusResponseWriter{ResponseWriter: w, statusCode: http.StatusOK}
+
+		defer func() {
+			observeRequest(time.Since(start), wrappedWriter.StatusCode(), r.Method, prompt)
+		}()
+
+		roles, err := app.RoleSvc.GetAll(r.Context())
+		if err != nil {
+			app.Logger.Infof("%s: получение списка ролей: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("получение списка ролей: %w", err).Error(), http.StatusInternalServerError)
+			return
+		}
+
+		rolesTransport := make([]Role, len(roles))
+		for i, role := range roles {
+			rolesTransport[i] = toRoleTransport(role)
+		}
+
+		successResponse(wrappedWriter, http.StatusOK, map[string]interface{}{"roles": rolesTransport})
+	}
+}
+
+func AssignEntrepreneurRole(app *app.App) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		prompt := "AssignEntrepreneurRoleHandler"
+		start := time.Now()
+
+		wrappedWriter := &statusResponseWriter{ResponseWriter: w, statusCode: http.StatusOK}
+
+		defer func() {
+			observeRequest(time.Since(start), wrappedWriter.StatusCode(), r.Method, prompt)
+		}()
+
+		adminId, err := getStringClaimFromJWT(r.Context(), "sub")
+		if err != nil {
+			app.Logger.Infof("%s: получение id пользователя из JWT: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("получение id пользователя из JWT: %w", err).Error(), http.StatusBadRequest)
+			return
+		}
+
+		adminIdUuid, err := uuid.Parse(adminId)
+		if err != nil {
+			app.Logger.Infof("%s: преобразование id к uuid: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("преобразование id к uuid: %w", err).Error(), http.StatusBadRequest)
+			return
+		}
+
+		userId, err := parseUUIDFromURL(r, "id", "entrepreneur")
+		if err != nil {
+			app.Logger.Infof("%s: парсинг id предпринимателя из URL: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("парсинг id предпринимателя из URL: %w", err).Error(), http.StatusBadRequest)
+			return
+		}
+
+		type Req struct {
+			Role string `json:"role"`
+		}
+		var req Req
+
+		err = json.NewDecoder(r.Body).Decode(&req)
+		if err != nil {
+			app.Logger.Infof("%s: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("%s: %w", prompt, err).Error(), http.StatusBadRequest)
+			return
+		}
+
+		err = app.RoleSvc.AssignRole(r.Context(), userId, req.Role, adminIdUuid)
+		if err != nil {
+			app.Logger.Infof("%s: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("%s: %w", prompt, err).Error(), http.StatusBadRequest)
+			return
+		}
+
+		successResponse(wrappedWriter, http.StatusOK, nil)
+	}
+}
+
+func ListReports(app *app.App) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		prompt := "ListReportsHandler"
+		start := time.Now()
+
+		wrappedWriter := &statusResponseWriter{ResponseWriter: w, statusCode: http.StatusOK}
+
+		defer func() {
+			observeRequest(time.Since(start), wrappedWriter.StatusCode(), r.Method, prompt)
+		}()
+
+		filter := new(domain.FinancialReportFilter)
+
+		var err error
+		if r.URL.Query().Get("start-year") != "" {
+			filter.Period, err = parsePeriodFromURL(r)
+			if err != nil {
+				app.Logger.Infof("%s: парсинг периода из URL: %v", prompt, err)
+				errorResponse(wrappedWriter, fmt.Errorf("парсинг периода из URL: %w", err).Error(), http.StatusBadRequest)
+				return
+			}
+		}
+
+		if companyId := r.URL.Query().Get("company-id"); companyId != "" {
+			filter.CompanyID, err = uuid.Parse(companyId)
+			if err != nil {
+				app.Logger.Infof("%s: преобразование id компании к uuid: %v", prompt, err)
+				errorResponse(wrappedWriter, fmt.Errorf("преобразование id компании к uuid: %w", err).Error(), http.StatusBadRequest)
+				return
+			}
+		}
+
+		filter.OnlyUnverified = r.URL.Query().Get("unverified") == "true"
+
+		page := r.URL.Query().Get("page")
+		if page == "" {
+			page = "1"
+		}
+
+		pageInt, err := strconv.Atoi(page)
+		if err != nil {
+			app.Logger.Infof("%s: преобразование страницы к int: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("преобразование страницы к int: %w", err).Error(), http.StatusBadRequest)
+			return
+		}
+
+		reports, numPages, err := app.FinSvc.GetAll(r.Context(), filter, pageInt)
+		if err != nil {
+			app.Logger.Infof("%s: получение финансовых отчетов: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("получение финансовых отчетов: %w", err).Error(), http.StatusBadRequest)
+			return
+		}
+
+		reportsTransport := make([]FinancialReport, len(reports))
+		for i, rep := range reports {
+			reportsTransport[i] = toFinReportTransport(rep)
+		}
+
+		successResponse(wrappedWriter, http.StatusOK, map[string]interface{}{"reports": reportsTransport, "num_pages": numPages})
+	}
+}
+
+func VerifyReport(app *app.App) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		prompt := "VerifyReportHandler"
+		start := time.Now()
+
+		wrappedWriter := &statusResponseWriter{ResponseWriter: w, statusCode: http.StatusOK}
+
+		defer func() {
+			observeRequest(time.Since(start), wrappedWriter.StatusCode(), r.Method, prompt)
+		}()
+
+		verifierId, err := getStringClaimFromJWT(r.Context(), "sub")
+		if err != nil {
+			app.Logger.Infof("%s: получение id пользователя из JWT: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("получение id пользователя из JWT: %w", err).Error(), http.StatusBadRequest)
+			return
+		}
+
+		verifierIdUuid, err := uuid.Parse(verifierId)
+		if err != nil {
+			app.Logger.Infof("%s: преобразование id к uuid: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("преобразование id к uuid: %w", err).Error(), http.StatusBadRequest)
+			return
+		}
+
+		reportId, err := parseUUIDFromURL(r, "id", "report")
+		if err != nil {
+			app.Logger.Infof("%s: парсинг id отчета из URL: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("парсинг id отчета из URL: %w", err).Error(), http.StatusBadRequest)
+			return
+		}
+
+		err = app.FinSvc.Verify(r.Context(), reportId, verifierIdUuid)
+		if err != nil {
+			app.Logger.Infof("%s: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("%s: %w", prompt, err).Error(), http.StatusBadRequest)
+			return
+		}
+
+		successResponse(wrappedWriter, http.StatusOK, nil)
+	}
+}
+
+// JWKSHandler публикует открытые ключи, которыми можно проверить выданные токены.
+// Ответ не оборачивается в SuccessResponse: формат JWKS задан RFC 7517.
+func JWKSHandler(app *app.App) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		prompt := "JWKSHandler"
+		start := time.Now()
+
+		wrappedWriter := &statusResponseWriter{ResponseWriter: w, statusCode: http.StatusOK}
+
+		defer func() {
+			observeRequest(time.Since(start), wrappedWriter.StatusCode(), r.Method, prompt)
+		}()
+
+		keys := app.Keys.VerificationKeys()
+		jwks := make([]map[string]string, len(keys))
+		for i, key := range keys {
+			jwks[i] = key.JWK()
+		}
+
+		wrappedWriter.Header().Set("Content-Type", "application/json")
+		wrappedWriter.Header().Set("Cache-Control", "public, max-age=300")
+		wrappedWriter.WriteHeader(http.StatusOK)
+		json.NewEncoder(wrappedWriter).Encode(map[string]interface{}{"keys": jwks})
+	}
+}
+
+func CreateApiKey(app *app.App) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		prompt := "CreateApiKeyHandler"
+		start := time.Now()
+
+		wrappedWriter := &statusResponseWriter{ResponseWriter: w, statusCode: http.StatusOK}
+
+		defer func() {
+			observeRequest(time.Since(start), wrappedWriter.StatusCode(), r.Method, prompt)
+		}()
+
+		userId, err := getStringClaimFromJWT(r.Context(), "sub")
+		if err != nil {
+			app.Logger.Infof("%s: получение id пользователя из JWT: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("получение id пользователя из JWT: %w", err).Error(), http.StatusBadRequest)
+			return
+		}
+
+		userIdUuid, err := uuid.Parse(userId)
+		if err != nil {
+			app.Logger.Infof("%s: преобразование id к uuid: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("преобразование id к uuid: %w", err).Error(), http.StatusBadRequest)
+			return
+		}
+
+		type Req struct {
+			Name      string     `json:"name"`
+			Scopes    []string   `json:"scopes"`
+			ExpiresAt *time.Time `json:"expiresAt"`
+		}
+		var req Req
+
+		err = json.NewDecoder(r.Body).Decode(&req)
+		if err != nil {
+			app.Logger.Infof("%s: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("%s: %w", prompt, err).Error(), http.StatusBadRequest)
+			return
+		}
+
+		key := &domain.ApiKey{
+			UserID: userIdUuid,
+			Name:   req.Name,
+			Scopes: req.Scopes,
+		}
+		if req.ExpiresAt != nil {
+			key.ExpiresAt = *req.ExpiresAt
+		}
+
+		apiKey, err := app.ApiKeySvc.Create(r.Context(), key)
+		if err != nil {
+			app.Logger.Infof("%s: создание API-ключа: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("создание API-ключа: %w", err).Error(), http.StatusBadRequest)
+			return
+		}
+
+		successResponse(wrappedWriter, http.StatusCreated, map[string]interface{}{
+			"key":    apiKey,
+			"apiKey": toApiKeyTransport(key),
+		})
+	}
+}
+
+func ListApiKeys(app *app.App) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		prompt := "ListApiKeysHandler"
+		start := time.Now()
+
+		wrappedWriter := &statusResponseWriter{ResponseWriter: w, statusCode: http.StatusOK}
+
+		defer func() {
+			observeRequest(time.Since(start), wrappedWriter.StatusCode(), r.Method, prompt)
+		}()
+
+		userId, err := getStringClaimFromJWT(r.Context(), "sub")
+		if err != nil {
+			app.Logger.Infof("%s: получение id пользователя из JWT: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("получение id пользователя из JWT: %w", err).Error(), http.StatusBadRequest)
+			return
+		}
+
+		userIdUuid, err := uuid.Parse(userId)
+		if err != nil {
+			app.Logger.Infof("%s: преобразование id к uuid: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("преобразование id к uuid: %w", err).Error(), http.StatusBadRequest)
+			return
+		}
+
+		keys, err := app.ApiKeySvc.GetByUserId(r.Context(), userIdUuid)
+		if err != nil {
+			app.Logger.Infof("%s: получение списка API-ключей: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("получение списка API-ключей: %w", err).Error(), http.StatusInternalServerError)
+			return
+		}
+
+		keysTransport := make([]ApiKey, len(keys))
+		for i, key := range keys {
+			keysTransport[i] = toApiKeyTransport(key)
+		}
+
+		successResponse(wrappedWriter, http.StatusOK, map[string]interface{}{"apiKeys": keysTransport})
+	}
+}
+
+func RevokeApiKey(app *app.App) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		prompt := "RevokeApiKeyHandler"
+		start := time.Now()
+
+		wrappedWriter := &statusResponseWriter{ResponseWriter: w, statusCode: http.StatusOK}
+
+		defer func() {
+			observeRequest(time.Since(start), wrappedWriter.StatusCode(), r.Method, prompt)
+		}()
+
+		userId, err := getStringClaimFromJWT(r.Context(), "sub")
+		if err != nil {
+			app.Logger.Infof("%s: получение id пользователя из JWT: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("получение id пользователя из JWT: %w", err).Error(), http.StatusBadRequest)
+			return
+		}
+
+		userIdUuid, err := uuid.Parse(userId)
+		if err != nil {
+			app.Logger.Infof("%s: преобразование id к uuid: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("преобразование id к uuid: %w", err).Error(), http.StatusBadRequest)
+			return
+		}
+
+		keyId, err := parseUUIDFromURL(r, "id", "api key")
+		if err != nil {
+			app.Logger.Infof("%s: %v", prompt, err)
+			errorResponse(wrappedWriter, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		err = app.ApiKeySvc.Revoke(r.Context(), keyId, userIdUuid)
+		if err != nil {
+			app.Logger.Infof("%s: отзыв API-ключа: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("отзыв API-ключа: %w", err).Error(), http.StatusNotFound)
+			return
+		}
+
+		successResponse(wrappedWriter, http.StatusOK, nil)
 	}
 }
 
-func CreateReport(app *app.App) http.HandlerFunc {
+func ListCompanyOwners(app *app.App) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		prompt := "CreateReportHandler"
+		prompt := "ListCompanyOwnersHandler"
 		start := time.Now()
 
 		wrappedWriter := &statusResponseWriter{ResponseWriter: w, statusCode: http.StatusOK}
@@ -946,72 +3152,99 @@ func CreateReport(app *app.App) http.HandlerFunc {
 			observeRequest(time.Since(start), wrappedWriter.StatusCode(), r.Method, prompt)
 		}()
 
-		userIdStr, err := getStringClaimFromJWT(r.Context(), "sub")
+		compIdUuid, err := parseUUIDFromURL(r, "id", "company")
 		if err != nil {
-			app.Logger.Infof("%s: получение списка компаний: %v", prompt, err)
-			errorResponse(wrappedWriter, fmt.Errorf("получение записей из JWT: %w", err).Error(), http.StatusBadRequest)
+			app.Logger.Infof("%s: парсинг id компании из URL: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("парсинг id компании из URL: %w", err).Error(), http.StatusBadRequest)
 			return
 		}
 
-		userIdUuid, err := uuid.Parse(userIdStr)
+		owners, err := app.CompSvc.GetOwners(r.Context(), compIdUuid)
 		if err != nil {
-			app.Logger.Infof("%s: преобразование строки к uuid: %v", prompt, err)
-			errorResponse(wrappedWriter, fmt.Errorf("преобразование строки к uuid: %w", err).Error(), http.StatusInternalServerError)
+			app.Logger.Infof("%s: получение владельцев компании: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("получение владельцев компании: %w", err).Error(), http.StatusInternalServerError)
 			return
 		}
 
-		compIdStr := chi.URLParam(r, "id")
-		if compIdStr == "" {
-			app.Logger.Infof("%s: пустой id компании", prompt)
-			errorResponse(wrappedWriter, fmt.Errorf("пустой id компании").Error(), http.StatusBadRequest)
-			return
+		ownersTransport := make([]CompanyOwner, len(owners))
+		for i, owner := range owners {
+			ownersTransport[i] = toCompanyOwnerTransport(owner)
 		}
 
-		compIdUuid, err := uuid.Parse(compIdStr)
+		successResponse(wrappedWriter, http.StatusOK, map[string]interface{}{"owners": ownersTransport})
+	}
+}
+
+func InviteCompanyOwner(app *app.App) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		prompt := "InviteCompanyOwnerHandler"
+		start := time.Now()
+
+		wrappedWriter := &statusResponseWriter{ResponseWriter: w, statusCode: http.StatusOK}
+
+		defer func() {
+			observeRequest(time.Since(start), wrappedWriter.StatusCode(), r.Method, prompt)
+		}()
+
+		userId, err := getStringClaimFromJWT(r.Context(), "sub")
 		if err != nil {
-			app.Logger.Infof("%s: преобразование строки к uuid: %v", prompt, err)
-			errorResponse(wrappedWriter, fmt.Errorf("преобразование строки к uuid: %w", err).Error(), http.StatusInternalServerError)
+			app.Logger.Infof("%s: получение id пользователя из JWT: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("получение id пользователя из JWT: %w", err).Error(), http.StatusBadRequest)
 			return
 		}
 
-		company, err := app.CompSvc.GetById(r.Context(), compIdUuid)
+		userIdUuid, err := uuid.Parse(userId)
 		if err != nil {
-			app.Logger.Infof("%s: создание финансового отчета: %v", prompt, err)
-			errorResponse(wrappedWriter, fmt.Errorf("создание финансового отчета: %w", err).Error(), http.StatusInternalServerError)
+			app.Logger.Infof("%s: преобразование id к uuid: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("преобразование id к uuid: %w", err).Error(), http.StatusBadRequest)
 			return
 		}
 
-		if company.OwnerID != userIdUuid {
-			app.Logger.Infof("%s: только владелец компании может добавлять финансовые отчеты", prompt)
-			errorResponse(wrappedWriter, fmt.Errorf("только владелец компании может добавлять финансовые отчеты").Error(), http.StatusInternalServerError)
+		compIdUuid, err := parseUUIDFromURL(r, "id", "company")
+		if err != nil {
+			app.Logger.Infof("%s: парсинг id компании из URL: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("парсинг id компании из URL: %w", err).Error(), http.StatusBadRequest)
 			return
 		}
 
-		var req FinancialReport
+		type Req struct {
+			UserID uuid.UUID       `json:"userId"`
+			Share  decimal.Decimal `json:"share"`
+		}
+		var req Req
+
 		err = json.NewDecoder(r.Body).Decode(&req)
 		if err != nil {
-			app.Logger.Infof("%s: %м", prompt, err)
-			errorResponse(wrappedWriter, err.Error(), http.StatusBadRequest)
+			app.Logger.Infof("%s: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("%s: %w", prompt, err).Error(), http.StatusBadRequest)
 			return
 		}
 
-		report := toFinReportModel(&req)
-		report.CompanyID = compIdUuid
-
-		err = app.FinSvc.Create(r.Context(), &report)
+		err = app.CompSvc.InviteOwner(r.Context(), &domain.CompanyOwner{
+			CompanyID: compIdUuid,
+			UserID:    req.UserID,
+			Share:     req.Share,
+			InvitedBy: userIdUuid,
+		})
 		if err != nil {
-			app.Logger.Infof("%s: создание финансового отчета: %v", prompt, err)
-			errorResponse(wrappedWriter, fmt.Errorf("создание финансового отчета: %w", err).Error(), http.StatusInternalServerError)
+			app.Logger.Infof("%s: приглашение совладельца: %v", prompt, err)
+
+			status := http.StatusBadRequest
+			if errors.Is(err, domain.ErrSharesExceed) {
+				status = http.StatusConflict
+			}
+
+			errorResponse(wrappedWriter, fmt.Errorf("приглашение совладельца: %w", err).Error(), status)
 			return
 		}
 
-		successResponse(wrappedWriter, http.StatusOK, nil)
+		successResponse(wrappedWriter, http.StatusCreated, nil)
 	}
 }
 
-func DeleteFinReport(app *app.App) http.HandlerFunc {
+func AcceptCompanyOwnership(app *app.App) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		prompt := "DeleteFinReportHandler"
+		prompt := "AcceptCompanyOwnershipHandler"
 		start := time.Now()
 
 		wrappedWriter := &statusResponseWriter{ResponseWriter: w, statusCode: http.StatusOK}
@@ -1020,38 +3253,31 @@ func DeleteFinReport(app *app.App) http.HandlerFunc {
 			observeRequest(time.Since(start), wrappedWriter.StatusCode(), r.Method, prompt)
 		}()
 
-		userIdStr, err := getStringClaimFromJWT(r.Context(), "sub")
+		userId, err := getStringClaimFromJWT(r.Context(), "sub")
 		if err != nil {
-			app.Logger.Infof("%s: получение записей из JWT: %v", prompt, err)
-			errorResponse(wrappedWriter, fmt.Errorf("получение записей из JWT: %w", err).Error(), http.StatusBadRequest)
+			app.Logger.Infof("%s: получение id пользователя из JWT: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("получение id пользователя из JWT: %w", err).Error(), http.StatusBadRequest)
 			return
 		}
 
-		userIdUuid, err := uuid.Parse(userIdStr)
+		userIdUuid, err := uuid.Parse(userId)
 		if err != nil {
-			app.Logger.Infof("%s: преобразование строки к uuid: %v", prompt, err)
-			errorResponse(wrappedWriter, fmt.Errorf("преобразование строки к uuid: %w", err).Error(), http.StatusInternalServerError)
-			return
-		}
-
-		reportIdStr := chi.URLParam(r, "id")
-		if reportIdStr == "" {
-			app.Logger.Infof("%s: пустой id отчета", prompt)
-			errorResponse(wrappedWriter, fmt.Errorf("пустой id отчета").Error(), http.StatusBadRequest)
+			app.Logger.Infof("%s: преобразование id к uuid: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("преобразование id к uuid: %w", err).Error(), http.StatusBadRequest)
 			return
 		}
 
-		reportIdUuid, err := uuid.Parse(reportIdStr)
+		compIdUuid, err := parseUUIDFromURL(r, "id", "company")
 		if err != nil {
-			app.Logger.Infof("%s: преобразование строки к uuid: %v", prompt, err)
-			errorResponse(wrappedWriter, fmt.Errorf("преобразование строки к uuid: %w", err).Error(), http.StatusInternalServerError)
+			app.Logger.Infof("%s: парсинг id компании из URL: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("парсинг id компании из URL: %w", err).Error(), http.StatusBadRequest)
 			return
 		}
 
-		err = app.FinSvc.DeleteById(r.Context(), reportIdUuid, userIdUuid)
+		err = app.CompSvc.AcceptOwnership(r.Context(), compIdUuid, userIdUuid)
 		if err != nil {
-			app.Logger.Infof("%s: только владелец компании может удалять финансовые отчеты", prompt)
-			errorResponse(wrappedWriter, fmt.Errorf("удаление финансового отчета по id: %w", err).Error(), http.StatusInternalServerError)
+			app.Logger.Infof("%s: принятие приглашения в совладельцы: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("принятие приглашения в совладельцы: %w", err).Error(), http.StatusBadRequest)
 			return
 		}
 
@@ -1059,9 +3285,9 @@ func DeleteFinReport(app *app.App) http.HandlerFunc {
 	}
 }
 
-func UpdateFinReport(app *app.App) http.HandlerFunc {
+func UpdateCompanyOwnerShare(app *app.App) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		prompt := "UpdateFinReportHandler"
+		prompt := "UpdateCompanyOwnerShareHandler"
 		start := time.Now()
 
 		wrappedWriter := &statusResponseWriter{ResponseWriter: w, statusCode: http.StatusOK}
@@ -1070,49 +3296,60 @@ func UpdateFinReport(app *app.App) http.HandlerFunc {
 			observeRequest(time.Since(start), wrappedWriter.StatusCode(), r.Method, prompt)
 		}()
 
-		userIdStr, err := getStringClaimFromJWT(r.Context(), "sub")
+		userId, err := getStringClaimFromJWT(r.Context(), "sub")
 		if err != nil {
-			app.Logger.Infof("%s: получение записей из JWT: %v", prompt, err)
-			errorResponse(wrappedWriter, fmt.Errorf("получение записей из JWT: %w", err).Error(), http.StatusBadRequest)
+			app.Logger.Infof("%s: получение id пользователя из JWT: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("получение id пользователя из JWT: %w", err).Error(), http.StatusBadRequest)
 			return
 		}
 
-		userIdUuid, err := uuid.Parse(userIdStr)
+		userIdUuid, err := uuid.Parse(userId)
 		if err != nil {
-			app.Logger.Infof("%s: преобразование строки к uuid: %v", prompt, err)
-			errorResponse(wrappedWriter, fmt.Errorf("преобразование строки к uuid: %w", err).Error(), http.StatusInternalServerError)
+			app.Logger.Infof("%s: преобразование id к uuid: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("преобразование id к uuid: %w", err).Error(), http.StatusBadRequest)
 			return
 		}
 
-		reportIdStr := chi.URLParam(r, "id")
-		if reportIdStr == "" {
-			app.Logger.Infof("%s: пустой id отчета", prompt)
-			errorResponse(wrappedWriter, fmt.Errorf("пустой id отчета").Error(), http.StatusBadRequest)
+		compIdUuid, err := parseUUIDFromURL(r, "id", "company")
+		if err != nil {
+			app.Logger.Infof("%s: парсинг id компании из URL: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("парсинг id компании из URL: %w", err).Error(), http.StatusBadRequest)
 			return
 		}
 
-		reportIdUuid, err := uuid.Parse(reportIdStr)
+		ownerIdUuid, err := parseUUIDFromURL(r, "userId", "owner")
 		if err != nil {
-			app.Logger.Infof("%s: преобразование строки к uuid: %v", prompt, err)
-			errorResponse(wrappedWriter, fmt.Errorf("преобразование строки к uuid: %w", err).Error(), http.StatusInternalServerError)
+			app.Logger.Infof("%s: парсинг id совладельца из URL: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("парсинг id совладельца из URL: %w", err).Error(), http.StatusBadRequest)
 			return
 		}
 
-		var req FinancialReport
+		type Req struct {
+			Share decimal.Decimal `json:"share"`
+		}
+		var req Req
 
 		err = json.NewDecoder(r.Body).Decode(&req)
 		if err != nil {
 			app.Logger.Infof("%s: %v", prompt, err)
-			errorResponse(wrappedWriter, err.Error(), http.StatusBadRequest)
+			errorResponse(wrappedWriter, fmt.Errorf("%s: %w", prompt, err).Error(), http.StatusBadRequest)
 			return
 		}
-		req.ID = reportIdUuid
-		model := toFinReportModel(&req)
 
-		err = app.FinSvc.Update(r.Context(), &model, userIdUuid)
+		err = app.CompSvc.SetOwnerShare(r.Context(), &domain.CompanyOwner{
+			CompanyID: compIdUuid,
+			UserID:    ownerIdUuid,
+			Share:     req.Share,
+		}, userIdUuid)
 		if err != nil {
-			app.Logger.Infof("%s: обновление информации о финансовом отчете: %v", prompt, err)
-			errorResponse(wrappedWriter, fmt.Errorf("обновление информации о финансовом отчете: %w", err).Error(), http.StatusInternalServerError)
+			app.Logger.Infof("%s: изменение доли совладельца: %v", prompt, err)
+
+			status := http.StatusBadRequest
+			if errors.Is(err, domain.ErrSharesExceed) {
+				status = http.StatusConflict
+			}
+
+			errorResponse(wrappedWriter, fmt.Errorf("изменение доли совладельца: %w", err).Error(), status)
 			return
 		}
 
@@ -1120,9 +3357,9 @@ func UpdateFinReport(app *app.App) http.HandlerFunc {
 	}
 }
 
-func GetFinReport(app *app.App) http.HandlerFunc {
+func InitiateCompanyTransfer(app *app.App) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		prompt := "GetFinReportHandler"
+		prompt := "InitiateCompanyTransferHandler"
 		start := time.Now()
 
 		wrappedWriter := &statusResponseWriter{ResponseWriter: w, statusCode: http.StatusOK}
@@ -1131,34 +3368,59 @@ func GetFinReport(app *app.App) http.HandlerFunc {
 			observeRequest(time.Since(start), wrappedWriter.StatusCode(), r.Method, prompt)
 		}()
 
-		id := chi.URLParam(r, "id")
-		if id == "" {
-			app.Logger.Infof("%s: пустой id", prompt)
-			errorResponse(wrappedWriter, fmt.Errorf("пустой id").Error(), http.StatusBadRequest)
+		userId, err := getStringClaimFromJWT(r.Context(), "sub")
+		if err != nil {
+			app.Logger.Infof("%s: получение id пользователя из JWT: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("получение id пользователя из JWT: %w", err).Error(), http.StatusBadRequest)
 			return
 		}
 
-		idUuid, err := uuid.Parse(id)
+		userIdUuid, err := uuid.Parse(userId)
 		if err != nil {
 			app.Logger.Infof("%s: преобразование id к uuid: %v", prompt, err)
 			errorResponse(wrappedWriter, fmt.Errorf("преобразование id к uuid: %w", err).Error(), http.StatusBadRequest)
 			return
 		}
 
-		report, err := app.FinSvc.GetById(r.Context(), idUuid)
+		compIdUuid, err := parseUUIDFromURL(r, "id", "company")
 		if err != nil {
-			app.Logger.Infof("%s: получение финансового отчета по id: %v", prompt, err)
-			errorResponse(wrappedWriter, fmt.Errorf("получение финансового отчета по id: %w", err).Error(), http.StatusInternalServerError)
+			app.Logger.Infof("%s: парсинг id компании из URL: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("парсинг id компании из URL: %w", err).Error(), http.StatusBadRequest)
 			return
 		}
 
-		successResponse(wrappedWriter, http.StatusOK, map[string]interface{}{"financial_report": toFinReportTransport(report)})
+		type Req struct {
+			ToUserID uuid.UUID `json:"toUserId"`
+		}
+		var req Req
+
+		err = json.NewDecoder(r.Body).Decode(&req)
+		if err != nil {
+			app.Logger.Infof("%s: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("%s: %w", prompt, err).Error(), http.StatusBadRequest)
+			return
+		}
+
+		transfer := &domain.CompanyTransfer{
+			CompanyID:  compIdUuid,
+			FromUserID: userIdUuid,
+			ToUserID:   req.ToUserID,
+		}
+
+		err = app.TransferSvc.Initiate(r.Context(), transfer)
+		if err != nil {
+			app.Logger.Infof("%s: начало передачи компании: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("начало передачи компании: %w", err).Error(), http.StatusBadRequest)
+			return
+		}
+
+		successResponse(wrappedWriter, http.StatusCreated, map[string]interface{}{"transfer": toCompanyTransferTransport(transfer)})
 	}
 }
 
-func ListCompanyReports(app *app.App) http.HandlerFunc {
+func ListCompanyTransfers(app *app.App) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		prompt := "ListCompanyReportsHandler"
+		prompt := "ListCompanyTransfersHandler"
 		start := time.Now()
 
 		wrappedWriter := &statusResponseWriter{ResponseWriter: w, statusCode: http.StatusOK}
@@ -1167,47 +3429,73 @@ func ListCompanyReports(app *app.App) http.HandlerFunc {
 			observeRequest(time.Since(start), wrappedWriter.StatusCode(), r.Method, prompt)
 		}()
 
-		period, err := parsePeriodFromURL(r)
+		compIdUuid, err := parseUUIDFromURL(r, "id", "company")
 		if err != nil {
-			app.Logger.Infof("%s: парсинг периода из URL: %v", prompt, err)
-			errorResponse(wrappedWriter, fmt.Errorf("парсинг периода из URL: %w", err).Error(), http.StatusBadRequest)
+			app.Logger.Infof("%s: парсинг id компании из URL: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("парсинг id компании из URL: %w", err).Error(), http.StatusBadRequest)
 			return
 		}
 
-		compIdUuid, err := parseUUIDFromURL(r, "id", "company")
+		transfers, err := app.TransferSvc.GetHistory(r.Context(), compIdUuid)
 		if err != nil {
-			app.Logger.Infof("%s: парсинг id компании из URL: %v", prompt, err)
-			errorResponse(wrappedWriter, fmt.Errorf("парсинг id компании из URL: %w", err).Error(), http.StatusBadRequest)
+			app.Logger.Infof("%s: получение истории передач компании: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("получение истории передач компании: %w", err).Error(), http.StatusInternalServerError)
 			return
 		}
 
-		reports, err := app.FinSvc.GetByCompany(r.Context(), compIdUuid, period)
+		transfersTransport := make([]CompanyTransfer, len(transfers))
+		for i, transfer := range transfers {
+			transfersTransport[i] = toCompanyTransferTransport(transfer)
+		}
+
+		successResponse(wrappedWriter, http.StatusOK, map[string]interface{}{"transfers": transfersTransport})
+	}
+}
+
+func ListIncomingTransfers(app *app.App) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		prompt := "ListIncomingTransfersHandler"
+		start := time.Now()
+
+		wrappedWriter := &statusResponseWriter{ResponseWriter: w, statusCode: http.StatusOK}
+
+		defer func() {
+			observeRequest(time.Since(start), wrappedWriter.StatusCode(), r.Method, prompt)
+		}()
+
+		userId, err := getStringClaimFromJWT(r.Context(), "sub")
 		if err != nil {
-			app.Logger.Infof("%s: получение отчетов компании: %v", prompt, err)
-			errorResponse(wrappedWriter, fmt.Errorf("получение отчетов компании: %w", err).Error(), http.StatusInternalServerError)
+			app.Logger.Infof("%s: получение id пользователя из JWT: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("получение id пользователя из JWT: %w", err).Error(), http.StatusBadRequest)
 			return
 		}
 
-		reportsTransport := make([]FinancialReport, len(reports.Reports))
-		for i, rep := range reports.Reports {
-			reportsTransport[i] = toFinReportTransport(&rep)
+		userIdUuid, err := uuid.Parse(userId)
+		if err != nil {
+			app.Logger.Infof("%s: преобразование id к uuid: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("преобразование id к uuid: %w", err).Error(), http.StatusBadRequest)
+			return
 		}
 
-		successResponse(wrappedWriter, http.StatusOK,
-			map[string]interface{}{
-				"company_id": compIdUuid,
-				"period":     toPeriodTransport(period),
-				"revenue":    reports.Revenue(),
-				"costs":      reports.Costs(),
-				"profit":     reports.Profit(),
-				"reports":    reportsTransport},
-		)
+		transfers, err := app.TransferSvc.GetIncoming(r.Context(), userIdUuid)
+		if err != nil {
+			app.Logger.Infof("%s: получение входящих передач компаний: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("получение входящих передач компаний: %w", err).Error(), http.StatusInternalServerError)
+			return
+		}
+
+		transfersTransport := make([]CompanyTransfer, len(transfers))
+		for i, transfer := range transfers {
+			transfersTransport[i] = toCompanyTransferTransport(transfer)
+		}
+
+		successResponse(wrappedWriter, http.StatusOK, map[string]interface{}{"transfers": transfersTransport})
 	}
 }
 
-func CalculateRating(app *app.App) http.HandlerFunc {
+func AcceptCompanyTransfer(app *app.App) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		prompt := "CalculateRatingHandler"
+		prompt := "AcceptCompanyTransferHandler"
 		start := time.Now()
 
 		wrappedWriter := &statusResponseWriter{ResponseWriter: w, statusCode: http.StatusOK}
@@ -1216,34 +3504,41 @@ func CalculateRating(app *app.App) http.HandlerFunc {
 			observeRequest(time.Since(start), wrappedWriter.StatusCode(), r.Method, prompt)
 		}()
 
-		id := chi.URLParam(r, "id")
-		if id == "" {
-			app.Logger.Infof("%s: пустой id", prompt)
-			errorResponse(wrappedWriter, fmt.Errorf("пустой id").Error(), http.StatusBadRequest)
+		userId, err := getStringClaimFromJWT(r.Context(), "sub")
+		if err != nil {
+			app.Logger.Infof("%s: получение id пользователя из JWT: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("получение id пользователя из JWT: %w", err).Error(), http.StatusBadRequest)
 			return
 		}
 
-		idUuid, err := uuid.Parse(id)
+		userIdUuid, err := uuid.Parse(userId)
 		if err != nil {
 			app.Logger.Infof("%s: преобразование id к uuid: %v", prompt, err)
 			errorResponse(wrappedWriter, fmt.Errorf("преобразование id к uuid: %w", err).Error(), http.StatusBadRequest)
 			return
 		}
 
-		rating, err := app.Interactor.CalculateUserRating(r.Context(), idUuid)
+		transferIdUuid, err := parseUUIDFromURL(r, "id", "transfer")
 		if err != nil {
-			app.Logger.Infof("%s: вычисление рейтинга предпринимателя: %v", prompt, err)
-			errorResponse(wrappedWriter, fmt.Errorf("вычисление рейтинга предпринимателя: %w", err).Error(), http.StatusInternalServerError)
+			app.Logger.Infof("%s: парсинг id передачи из URL: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("парсинг id передачи из URL: %w", err).Error(), http.StatusBadRequest)
+			return
+		}
+
+		err = app.TransferSvc.Accept(r.Context(), transferIdUuid, userIdUuid)
+		if err != nil {
+			app.Logger.Infof("%s: принятие передачи компании: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("принятие передачи компании: %w", err).Error(), http.StatusBadRequest)
 			return
 		}
 
-		successResponse(wrappedWriter, http.StatusOK, map[string]float32{"rating": rating})
+		successResponse(wrappedWriter, http.StatusOK, nil)
 	}
 }
 
-func GetEntrepreneurFinancials(app *app.App) http.HandlerFunc {
+func DeclineCompanyTransfer(app *app.App) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		prompt := "GetEntrepreneurFinancials"
+		prompt := "DeclineCompanyTransferHandler"
 		start := time.Now()
 
 		wrappedWriter := &statusResponseWriter{ResponseWriter: w, statusCode: http.StatusOK}
@@ -1252,41 +3547,34 @@ func GetEntrepreneurFinancials(app *app.App) http.HandlerFunc {
 			observeRequest(time.Since(start), wrappedWriter.StatusCode(), r.Method, prompt)
 		}()
 
-		id := r.URL.Query().Get("entrepreneur-id")
-		if id == "" {
-			app.Logger.Infof("%s: пустой id предпринимателя", prompt)
-			errorResponse(wrappedWriter, fmt.Errorf("пустой id предпринимателя").Error(), http.StatusBadRequest)
+		userId, err := getStringClaimFromJWT(r.Context(), "sub")
+		if err != nil {
+			app.Logger.Infof("%s: получение id пользователя из JWT: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("получение id пользователя из JWT: %w", err).Error(), http.StatusBadRequest)
 			return
 		}
 
-		idUuid, err := uuid.Parse(id)
+		userIdUuid, err := uuid.Parse(userId)
 		if err != nil {
 			app.Logger.Infof("%s: преобразование id к uuid: %v", prompt, err)
 			errorResponse(wrappedWriter, fmt.Errorf("преобразование id к uuid: %w", err).Error(), http.StatusBadRequest)
 			return
 		}
 
-		prevYear := time.Now().AddDate(-1, 0, 0).Year()
-		period := &domain.Period{
-			StartYear:    prevYear,
-			EndYear:      prevYear,
-			StartQuarter: 1,
-			EndQuarter:   4,
+		transferIdUuid, err := parseUUIDFromURL(r, "id", "transfer")
+		if err != nil {
+			app.Logger.Infof("%s: парсинг id передачи из URL: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("парсинг id передачи из URL: %w", err).Error(), http.StatusBadRequest)
+			return
 		}
 
-		rep, err := app.Interactor.GetUserFinancialReport(r.Context(), idUuid, period)
+		err = app.TransferSvc.Decline(r.Context(), transferIdUuid, userIdUuid)
 		if err != nil {
-			app.Logger.Infof("%s: получение финансового отчета предпринимателя: %v", prompt, err)
-			errorResponse(wrappedWriter, fmt.Errorf("получение финансового отчета предпринимателя: %w", err).Error(), http.StatusInternalServerError)
+			app.Logger.Infof("%s: отклонение передачи компании: %v", prompt, err)
+			errorResponse(wrappedWriter, fmt.Errorf("отклонение передачи компании: %w", err).Error(), http.StatusBadRequest)
 			return
 		}
 
-		successResponse(wrappedWriter, http.StatusOK, map[string]float32{
-			"revenue": rep.Revenue(),
-			"costs":   rep.Costs(),
-			"profit":  rep.Profit(),
-			"taxes":   rep.Taxes,
-			"taxLoad": rep.TaxLoad,
-		})
+		successResponse(wrappedWriter, http.StatusOK, nil)
 	}
 }
@@ -17,3 +17,14 @@ var requestMetrics = promauto.NewSummaryVec(prometheus.SummaryOpts{
 func observeRequest(d time.Duration, status int, method, handlerName string) {
 	requestMetrics.WithLabelValues(handlerName, strconv.Itoa(status), method).Observe(d.Seconds())
 }
+
+var failedLogins = promauto.NewCounterVec(prometheus.CounterOpts{
+	Namespace: "entrepreneurs",
+	Subsystem: "auth",
+	Name:      "failed_logins_total",
+	Help:      "Количество неудачных попыток входа по причинам.",
+}, []string{"reason"})
+
+func observeFailedLogin(reason string) {
+	failedLogins.WithLabelValues(reason).Inc()
+}
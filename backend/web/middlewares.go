@@ -1,54 +1,220 @@
 package web
 
 import (
+	"crypto/subtle"
+	"errors"
 	"fmt"
 	"net/http"
+	"ppo/domain"
+	"ppo/internal/app"
+	"ppo/pkg/base"
+	"slices"
+	"strings"
 
 	"github.com/go-chi/jwtauth/v5"
+	"github.com/google/uuid"
+	"github.com/lestrrat-go/jwx/v2/jwt"
 )
 
-func ValidateAdminRoleJWT(next http.Handler) http.Handler {
-	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		_, claims, err := jwtauth.FromContext(r.Context())
-		if err != nil {
-			errorResponse(w, fmt.Errorf("getting claims from JWT: %w", err).Error(), http.StatusBadRequest)
-			return
-		}
+const (
+	apiKeyScheme = "ApiKey "
+	// claim с id API-ключа, по которому был аутентифицирован запрос
+	apiKeyClaim = "akid"
+)
 
-		role, ok := claims["role"]
-		if !ok {
-			errorResponse(w, fmt.Errorf("получение 'role' claim`а из JWT").Error(), http.StatusBadRequest)
-			return
-		}
+var errCsrfTokenMismatch = errors.New("CSRF-токен отсутствует или не совпадает с cookie")
+
+// Verifier заменяет jwtauth.Verifier: токен проверяется ключом, указанным в его заголовке kid.
+// Как и в jwtauth, результат проверки только кладется в контекст, отказом занимается Authenticator.
+// Если переданы scopes, вместо токена принимается и API-ключ ("Authorization: ApiKey ..."),
+// которому выдана хотя бы одна из этих областей действия.
+func Verifier(app *app.App, scopes ...string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			token, err := verifyRequestToken(app, r, scopes)
+			next.ServeHTTP(w, r.WithContext(jwtauth.NewContext(r.Context(), token, err)))
+		})
+	}
+}
+
+func verifyRequestToken(app *app.App, r *http.Request, scopes []string) (token jwt.Token, err error) {
+	apiKey := apiKeyFromHeader(r)
+	if apiKey != "" {
+		return verifyApiKey(app, r, apiKey, scopes)
+	}
+
+	tokenString := jwtauth.TokenFromHeader(r)
+	if tokenString == "" {
+		tokenString = tokenFromCookie(r)
 
-		if role != "admin" {
-			errorResponse(w, fmt.Errorf("только администраторы могут делать это").Error(), http.StatusForbidden)
-			return
+		// cookie браузер отправляет сам, в том числе в запросах со сторонних сайтов
+		if tokenString != "" {
+			err = checkCsrfToken(r)
+			if err != nil {
+				return nil, err
+			}
 		}
+	}
+	if tokenString == "" {
+		return nil, jwtauth.ErrNoTokenFound
+	}
 
-		next.ServeHTTP(w, r)
-	})
+	_, err = base.VerifyAuthToken(tokenString, app.Keys)
+	if err != nil {
+		return nil, err
+	}
+
+	// подпись и срок действия уже проверены, токен разбирается только ради claim`ов
+	return jwt.ParseString(tokenString, jwt.WithVerify(false), jwt.WithValidate(false))
+}
+
+func tokenFromCookie(r *http.Request) string {
+	cookie, err := r.Cookie(accessTokenCookie)
+	if err != nil {
+		return ""
+	}
+
+	return cookie.Value
+}
+
+// checkCsrfToken проверяет изменяющие запросы по схеме double-submit: прочитать CSRF-cookie и
+// повторить ее значение в заголовке может только скрипт с origin приложения.
+func checkCsrfToken(r *http.Request) (err error) {
+	switch r.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return nil
+	}
+
+	cookie, err := r.Cookie(csrfTokenCookie)
+	if err != nil || cookie.Value == "" {
+		return errCsrfTokenMismatch
+	}
+
+	if subtle.ConstantTimeCompare([]byte(cookie.Value), []byte(r.Header.Get(csrfTokenHeader))) != 1 {
+		return errCsrfTokenMismatch
+	}
+
+	return nil
+}
+
+func apiKeyFromHeader(r *http.Request) string {
+	header := r.Header.Get("Authorization")
+	if len(header) > len(apiKeyScheme) && strings.EqualFold(header[:len(apiKeyScheme)], apiKeyScheme) {
+		return header[len(apiKeyScheme):]
+	}
+
+	return ""
 }
 
-func ValidateUserRoleJWT(next http.Handler) http.Handler {
-	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		_, claims, err := jwtauth.FromContext(r.Context())
+// verifyApiKey строит по ключу токен с теми же claim`ами, что и у выданных при входе, чтобы
+// обработчики не различали способы аутентификации. Токен не подписывается: он живет только в контексте запроса.
+func verifyApiKey(app *app.App, r *http.Request, apiKey string, scopes []string) (token jwt.Token, err error) {
+	if len(scopes) == 0 {
+		return nil, domain.ErrApiKeyScope
+	}
+
+	key, userAuth, err := app.ApiKeySvc.Authenticate(r.Context(), apiKey)
+	if err != nil {
+		return nil, err
+	}
+
+	allowed := slices.ContainsFunc(scopes, func(scope string) bool {
+		return slices.Contains(key.Scopes, scope)
+	})
+	if !allowed {
+		return nil, domain.ErrApiKeyScope
+	}
+
+	token = jwt.New()
+	for claim, value := range map[string]string{
+		"sub":       userAuth.ID.String(),
+		"role":      userAuth.Role,
+		apiKeyClaim: key.ID.String(),
+	} {
+		err = token.Set(claim, value)
 		if err != nil {
-			errorResponse(w, fmt.Errorf("getting claims from JWT: %w", err).Error(), http.StatusBadRequest)
-			return
+			return nil, fmt.Errorf("формирование claim`ов API-ключа: %w", err)
 		}
+	}
 
-		role, ok := claims["role"]
-		if !ok {
-			errorResponse(w, fmt.Errorf("получение 'role' claim`а из JWT").Error(), http.StatusBadRequest)
-			return
-		}
+	return token, nil
+}
 
-		if role != "user" && role != "admin" {
-			errorResponse(w, fmt.Errorf("вам нужно авторизоваться, прежде чем сделать это").Error(), http.StatusUnauthorized)
-			return
-		}
+// Authenticator заменяет jwtauth.Authenticator: помимо валидности токена проверяет,
+// что сессия, которой он выдан, не отозвана.
+func Authenticator(app *app.App) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			token, claims, err := jwtauth.FromContext(r.Context())
+			if errors.Is(err, domain.ErrApiKeyScope) || errors.Is(err, errCsrfTokenMismatch) {
+				errorResponse(w, err.Error(), http.StatusForbidden)
+				return
+			}
 
-		next.ServeHTTP(w, r)
-	})
+			if err != nil || token == nil {
+				errorResponse(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+				return
+			}
+
+			// API-ключ не привязан к сессии, его действительность проверена в Verifier
+			if _, ok := claims[apiKeyClaim]; ok {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			sid, ok := claims["sid"].(string)
+			if !ok {
+				errorResponse(w, fmt.Errorf("получение 'sid' claim`а из JWT").Error(), http.StatusUnauthorized)
+				return
+			}
+
+			sessionId, err := uuid.Parse(sid)
+			if err != nil {
+				errorResponse(w, fmt.Errorf("преобразование id сессии к uuid: %w", err).Error(), http.StatusUnauthorized)
+				return
+			}
+
+			active, err := app.AuthSvc.IsSessionActive(r.Context(), sessionId)
+			if err != nil {
+				app.Logger.Infof("Authenticator: %v", err)
+				errorResponse(w, fmt.Errorf("проверка сессии: %w", err).Error(), http.StatusInternalServerError)
+				return
+			}
+
+			if !active {
+				errorResponse(w, fmt.Errorf("сессия завершена, авторизуйтесь повторно").Error(), http.StatusUnauthorized)
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// RequirePermission пропускает запрос, только если роли из токена выдано разрешение permission.
+// Должен стоять после Authenticator.
+func RequirePermission(app *app.App, permission string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			role, err := getStringClaimFromJWT(r.Context(), "role")
+			if err != nil {
+				errorResponse(w, fmt.Errorf("получение 'role' claim`а из JWT: %w", err).Error(), http.StatusBadRequest)
+				return
+			}
+
+			ok, err := app.RoleSvc.HasPermission(r.Context(), role, permission)
+			if err != nil {
+				app.Logger.Infof("RequirePermission: %v", err)
+				errorResponse(w, fmt.Errorf("проверка прав доступа: %w", err).Error(), http.StatusForbidden)
+				return
+			}
+
+			if !ok {
+				errorResponse(w, fmt.Errorf("недостаточно прав: требуется разрешение '%s'", permission).Error(), http.StatusForbidden)
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
 }
@@ -0,0 +1,189 @@
+package web
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"ppo/domain"
+	"ppo/internal/app"
+	"ppo/internal/keyring"
+	"ppo/mocks"
+	"ppo/pkg/base"
+	"ppo/pkg/logger"
+	"testing"
+	"time"
+)
+
+func TestCheckCsrfToken(t *testing.T) {
+	testCases := []struct {
+		name    string
+		method  string
+		cookie  string
+		header  string
+		wantErr bool
+	}{
+		{
+			name:   "безопасный метод без токена",
+			method: http.MethodGet,
+		},
+		{
+			name:   "OPTIONS без токена",
+			method: http.MethodOptions,
+		},
+		{
+			name:   "токен в заголовке совпадает с cookie",
+			method: http.MethodPost,
+			cookie: "csrf",
+			header: "csrf",
+		},
+		{
+			name:    "нет cookie",
+			method:  http.MethodPost,
+			header:  "csrf",
+			wantErr: true,
+		},
+		{
+			name:    "нет заголовка",
+			method:  http.MethodDelete,
+			cookie:  "csrf",
+			wantErr: true,
+		},
+		{
+			name:    "заголовок не совпадает с cookie",
+			method:  http.MethodPut,
+			cookie:  "csrf",
+			header:  "other",
+			wantErr: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := httptest.NewRequest(tc.method, "/", nil)
+			if tc.cookie != "" {
+				r.AddCookie(&http.Cookie{Name: csrfTokenCookie, Value: tc.cookie})
+			}
+			if tc.header != "" {
+				r.Header.Set(csrfTokenHeader, tc.header)
+			}
+
+			err := checkCsrfToken(r)
+
+			if tc.wantErr {
+				require.True(t, errors.Is(err, errCsrfTokenMismatch))
+			} else {
+				require.Nil(t, err)
+			}
+		})
+	}
+}
+
+// newTestKeys возвращает набор ключей с единственным ключом подписи.
+func newTestKeys(t *testing.T, ctrl *gomock.Controller) *keyring.Keyring {
+	key, err := base.GenerateJwtKey(base.AlgEdDSA)
+	require.Nil(t, err)
+	der, err := base.MarshalJwtPrivateKey(key)
+	require.Nil(t, err)
+
+	repo := mocks.NewMockISigningKeyRepository(ctrl)
+	repo.EXPECT().
+		GetValid(gomock.Any()).
+		Return([]*domain.SigningKey{{ID: key.ID, Algorithm: key.Algorithm, PrivateKey: der, CreatedAt: time.Now()}}, nil)
+
+	keys := keyring.NewKeyring(repo, base.AlgEdDSA, 0, 0, logger.NewLogger(logger.InfoLevel, io.Discard))
+	require.Nil(t, keys.Load(context.Background()))
+
+	return keys
+}
+
+// CSRF-токен нужен только запросам, аутентифицированным cookie: заголовок Authorization
+// браузер сам не подставляет.
+func TestVerifyRequestToken_Csrf(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	apiKeySvc := mocks.NewMockIApiKeyService(ctrl)
+	a := &app.App{Keys: newTestKeys(t, ctrl), ApiKeySvc: apiKeySvc}
+
+	userId := uuid.UUID{1}
+	token, err := base.GenerateAuthToken(userId.String(), "user", "session", time.Minute, a.Keys)
+	require.Nil(t, err)
+
+	testCases := []struct {
+		name       string
+		prepare    func(r *http.Request)
+		beforeTest func(apiKeySvc mocks.MockIApiKeyService)
+		scopes     []string
+		wantErr    error
+	}{
+		{
+			name: "токен в заголовке без CSRF-токена",
+			prepare: func(r *http.Request) {
+				r.Header.Set("Authorization", "Bearer "+token)
+			},
+		},
+		{
+			name: "API-ключ без CSRF-токена",
+			prepare: func(r *http.Request) {
+				r.Header.Set("Authorization", apiKeyScheme+"key")
+			},
+			beforeTest: func(apiKeySvc mocks.MockIApiKeyService) {
+				apiKeySvc.EXPECT().
+					Authenticate(gomock.Any(), "key").
+					Return(
+						&domain.ApiKey{ID: uuid.UUID{2}, UserID: userId, Scopes: []string{domain.ScopeReports}},
+						&domain.UserAuth{ID: userId, Role: "user"},
+						nil,
+					)
+			},
+			scopes: []string{domain.ScopeReports},
+		},
+		{
+			name: "токен в cookie без CSRF-токена",
+			prepare: func(r *http.Request) {
+				r.AddCookie(&http.Cookie{Name: accessTokenCookie, Value: token})
+			},
+			wantErr: errCsrfTokenMismatch,
+		},
+		{
+			name: "токен в cookie с несовпадающим CSRF-токеном",
+			prepare: func(r *http.Request) {
+				r.AddCookie(&http.Cookie{Name: accessTokenCookie, Value: token})
+				r.AddCookie(&http.Cookie{Name: csrfTokenCookie, Value: "csrf"})
+				r.Header.Set(csrfTokenHeader, "other")
+			},
+			wantErr: errCsrfTokenMismatch,
+		},
+		{
+			name: "токен в cookie с CSRF-токеном",
+			prepare: func(r *http.Request) {
+				r.AddCookie(&http.Cookie{Name: accessTokenCookie, Value: token})
+				r.AddCookie(&http.Cookie{Name: csrfTokenCookie, Value: "csrf"})
+				r.Header.Set(csrfTokenHeader, "csrf")
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.beforeTest != nil {
+				tc.beforeTest(*apiKeySvc)
+			}
+
+			r := httptest.NewRequest(http.MethodPost, "/", nil)
+			tc.prepare(r)
+
+			verified, err := verifyRequestToken(a, r, tc.scopes)
+
+			if tc.wantErr != nil {
+				require.True(t, errors.Is(err, tc.wantErr))
+			} else {
+				require.Nil(t, err)
+				require.Equal(t, userId.String(), verified.Subject())
+			}
+		})
+	}
+}
@@ -5,8 +5,14 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 )
 
+type TokenPair struct {
+	Token        string `json:"token"`
+	RefreshToken string `json:"refreshToken"`
+}
+
 type User struct {
 	ID       uuid.UUID `json:"id,omitempty"`
 	Username string    `json:"username,omitempty"`
@@ -25,27 +31,127 @@ type Contact struct {
 }
 
 type ActivityField struct {
-	ID          uuid.UUID `json:"id,omitempty"`
-	Name        string    `json:"name,omitempty"`
-	Description string    `json:"description,omitempty"`
-	Cost        float32   `json:"cost,omitempty"`
+	ID          uuid.UUID       `json:"id,omitempty"`
+	ParentID    uuid.UUID       `json:"parentId,omitempty"`
+	Code        string          `json:"code,omitempty"`
+	Name        string          `json:"name,omitempty"`
+	Description string          `json:"description,omitempty"`
+	Cost        decimal.Decimal `json:"cost"`
+}
+
+// ActivityFieldInfluence - влияние предпринимателя в сфере деятельности; Share - доля в выручке сферы в процентах.
+type ActivityFieldInfluence struct {
+	ActivityField ActivityField   `json:"activityField"`
+	Revenue       decimal.Decimal `json:"revenue"`
+	TotalRevenue  decimal.Decimal `json:"totalRevenue"`
+	Share         decimal.Decimal `json:"share"`
+	Rank          int             `json:"rank"`
+	Participants  int             `json:"participants"`
+}
+
+type ActivityFieldNode struct {
+	ActivityField
+	Children []ActivityFieldNode `json:"children"`
 }
 
 type Company struct {
 	ID              uuid.UUID `json:"id,omitempty"`
 	OwnerID         uuid.UUID `json:"ownerId,omitempty"`
 	ActivityFieldId uuid.UUID `json:"activityFieldId,omitempty"`
-	Name            string    `json:"name,omitempty"`
-	City            string    `json:"city,omitempty"`
+	// при обновлении пустой список удаляет дополнительные сферы, отсутствие поля оставляет их как есть
+	SecondaryActivityFieldIds []uuid.UUID `json:"secondaryActivityFieldIds"`
+	Name                      string      `json:"name,omitempty"`
+	City                      string      `json:"city,omitempty"`
+	INN                       string      `json:"inn,omitempty"`
+	OGRN                      string      `json:"ogrn,omitempty"`
+	KPP                       string      `json:"kpp,omitempty"`
+}
+
+// CompanyOwner - доля совладельца в процентах; AcceptedAt пуст, пока приглашение не принято.
+type CompanyOwner struct {
+	CompanyID  uuid.UUID       `json:"companyId"`
+	UserID     uuid.UUID       `json:"userId"`
+	Share      decimal.Decimal `json:"share"`
+	InvitedBy  *uuid.UUID      `json:"invitedBy,omitempty"`
+	AcceptedAt *time.Time      `json:"acceptedAt,omitempty"`
+}
+
+type CompanyTransfer struct {
+	ID         uuid.UUID  `json:"id"`
+	CompanyID  uuid.UUID  `json:"companyId"`
+	FromUserID uuid.UUID  `json:"fromUserId"`
+	ToUserID   uuid.UUID  `json:"toUserId"`
+	Status     string     `json:"status"`
+	CreatedAt  time.Time  `json:"createdAt"`
+	ExpiresAt  time.Time  `json:"expiresAt"`
+	ResolvedAt *time.Time `json:"resolvedAt,omitempty"`
 }
 
 type FinancialReport struct {
-	ID        uuid.UUID `json:"id,omitempty"`
-	CompanyID uuid.UUID `json:"companyId,omitempty"`
-	Revenue   float32   `json:"revenue,omitempty"`
-	Costs     float32   `json:"costs,omitempty"`
-	Year      int       `json:"year,omitempty"`
-	Quarter   int       `json:"quarter,omitempty"`
+	ID        uuid.UUID       `json:"id,omitempty"`
+	CompanyID uuid.UUID       `json:"companyId,omitempty"`
+	Revenue   decimal.Decimal `json:"revenue"`
+	Costs     decimal.Decimal `json:"costs"`
+	Year      int             `json:"year,omitempty"`
+	Quarter   int             `json:"quarter,omitempty"`
+	// VerifiedBy и VerifiedAt заполняются сервером, если отчет проверен
+	VerifiedBy *uuid.UUID `json:"verifiedBy,omitempty"`
+	VerifiedAt *time.Time `json:"verifiedAt,omitempty"`
+}
+
+type Role struct {
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	Permissions []string `json:"permissions"`
+}
+
+// ApiKey - описание ключа без самого ключа: он возвращается один раз, при создании.
+type ApiKey struct {
+	ID         uuid.UUID  `json:"id"`
+	Name       string     `json:"name"`
+	Prefix     string     `json:"prefix"`
+	Scopes     []string   `json:"scopes"`
+	ExpiresAt  *time.Time `json:"expiresAt,omitempty"`
+	CreatedAt  time.Time  `json:"createdAt"`
+	LastUsedAt *time.Time `json:"lastUsedAt,omitempty"`
+}
+
+type Skill struct {
+	ID          uuid.UUID `json:"id,omitempty"`
+	Name        string    `json:"name,omitempty"`
+	Description string    `json:"description,omitempty"`
+}
+
+type Review struct {
+	ID          uuid.UUID `json:"id,omitempty"`
+	TargetID    uuid.UUID `json:"targetId,omitempty"`
+	ReviewerID  uuid.UUID `json:"reviewerId,omitempty"`
+	Pros        string    `json:"pros,omitempty"`
+	Cons        string    `json:"cons,omitempty"`
+	Description string    `json:"description,omitempty"`
+	Rating      int       `json:"rating,omitempty"`
+}
+
+type ReviewStats struct {
+	Count         int     `json:"count"`
+	AverageRating float32 `json:"averageRating"`
+}
+
+type UserRating struct {
+	User   User    `json:"user"`
+	Rating float32 `json:"rating"`
+}
+
+type TaxBracket struct {
+	UpperBound decimal.Decimal `json:"upperBound"`
+	Rate       decimal.Decimal `json:"rate"`
+}
+
+type TaxSchedule struct {
+	ID       uuid.UUID    `json:"id,omitempty"`
+	Year     int          `json:"year,omitempty"`
+	Regime   string       `json:"regime,omitempty"`
+	Brackets []TaxBracket `json:"brackets,omitempty"`
 }
 
 type Period struct {
@@ -55,6 +161,46 @@ type Period struct {
 	EndQuarter   int `json:"endQuarter"`
 }
 
+// RatingBreakdown - рейтинг с составляющими: rating = costContribution + profitabilityContribution.
+// Company отсутствует, если у предпринимателя нет прибыльных компаний.
+type RatingBreakdown struct {
+	Strategy                  string          `json:"strategy"`
+	Period                    Period          `json:"period"`
+	Company                   *Company        `json:"company,omitempty"`
+	Share                     decimal.Decimal `json:"share"`
+	Cost                      decimal.Decimal `json:"cost"`
+	MaxCost                   decimal.Decimal `json:"maxCost"`
+	Revenue                   decimal.Decimal `json:"revenue"`
+	Profit                    decimal.Decimal `json:"profit"`
+	CostContribution          float32         `json:"costContribution"`
+	ProfitabilityContribution float32         `json:"profitabilityContribution"`
+	Rating                    float32         `json:"rating"`
+}
+
+// RatingSnapshot - точка истории рейтинга: рейтинг за четыре квартала, последний из которых - Quarter года Year.
+type RatingSnapshot struct {
+	Year                      int             `json:"year"`
+	Quarter                   int             `json:"quarter"`
+	Strategy                  string          `json:"strategy"`
+	CompanyID                 *uuid.UUID      `json:"companyId,omitempty"`
+	Share                     decimal.Decimal `json:"share"`
+	Cost                      decimal.Decimal `json:"cost"`
+	MaxCost                   decimal.Decimal `json:"maxCost"`
+	Revenue                   decimal.Decimal `json:"revenue"`
+	Profit                    decimal.Decimal `json:"profit"`
+	CostContribution          float32         `json:"costContribution"`
+	ProfitabilityContribution float32         `json:"profitabilityContribution"`
+	Rating                    float32         `json:"rating"`
+	CreatedAt                 time.Time       `json:"createdAt"`
+}
+
+func toTokenPairTransport(tokens *domain.TokenPair) TokenPair {
+	return TokenPair{
+		Token:        tokens.AccessToken,
+		RefreshToken: tokens.RefreshToken,
+	}
+}
+
 func toUserTransport(user *domain.User) User {
 	return User{
 		ID:       user.ID,
@@ -100,6 +246,8 @@ func toContactModel(contact *Contact) domain.Contact {
 func toActFieldTransport(field *domain.ActivityField) ActivityField {
 	return ActivityField{
 		ID:          field.ID,
+		ParentID:    field.ParentID,
+		Code:        field.Code,
 		Name:        field.Name,
 		Description: field.Description,
 		Cost:        field.Cost,
@@ -109,34 +257,103 @@ func toActFieldTransport(field *domain.ActivityField) ActivityField {
 func toActFieldModel(field *ActivityField) domain.ActivityField {
 	return domain.ActivityField{
 		ID:          field.ID,
+		ParentID:    field.ParentID,
+		Code:        field.Code,
 		Name:        field.Name,
 		Description: field.Description,
 		Cost:        field.Cost,
 	}
 }
 
+func toActFieldInfluenceTransport(influence *domain.ActivityFieldInfluence) ActivityFieldInfluence {
+	return ActivityFieldInfluence{
+		ActivityField: toActFieldTransport(influence.ActivityField),
+		Revenue:       influence.Revenue,
+		TotalRevenue:  influence.TotalRevenue,
+		Share:         influence.Share(),
+		Rank:          influence.Rank,
+		Participants:  influence.Participants,
+	}
+}
+
+func toActFieldNodesTransport(nodes []*domain.ActivityFieldNode) []ActivityFieldNode {
+	transport := make([]ActivityFieldNode, len(nodes))
+	for i, node := range nodes {
+		transport[i] = ActivityFieldNode{
+			ActivityField: toActFieldTransport(node.ActivityField),
+			Children:      toActFieldNodesTransport(node.Children),
+		}
+	}
+
+	return transport
+}
+
 func toCompanyTransport(company *domain.Company) Company {
 	return Company{
-		ID:              company.ID,
-		OwnerID:         company.OwnerID,
-		ActivityFieldId: company.ActivityFieldId,
-		Name:            company.Name,
-		City:            company.City,
+		ID:                        company.ID,
+		OwnerID:                   company.OwnerID,
+		ActivityFieldId:           company.ActivityFieldId,
+		SecondaryActivityFieldIds: company.SecondaryActivityFieldIds,
+		Name:                      company.Name,
+		City:                      company.City,
+		INN:                       company.INN,
+		OGRN:                      company.OGRN,
+		KPP:                       company.KPP,
 	}
 }
 
 func toCompanyModel(company *Company) domain.Company {
 	return domain.Company{
-		ID:              company.ID,
-		OwnerID:         company.OwnerID,
-		ActivityFieldId: company.ActivityFieldId,
-		Name:            company.Name,
-		City:            company.City,
+		ID:                        company.ID,
+		OwnerID:                   company.OwnerID,
+		ActivityFieldId:           company.ActivityFieldId,
+		SecondaryActivityFieldIds: company.SecondaryActivityFieldIds,
+		Name:                      company.Name,
+		City:                      company.City,
+		INN:                       company.INN,
+		OGRN:                      company.OGRN,
+		KPP:                       company.KPP,
+	}
+}
+
+func toCompanyOwnerTransport(owner *domain.CompanyOwner) CompanyOwner {
+	transport := CompanyOwner{
+		CompanyID: owner.CompanyID,
+		UserID:    owner.UserID,
+		Share:     owner.Share,
+	}
+
+	if owner.InvitedBy != uuid.Nil {
+		transport.InvitedBy = &owner.InvitedBy
+	}
+
+	if owner.Accepted() {
+		transport.AcceptedAt = &owner.AcceptedAt
+	}
+
+	return transport
+}
+
+func toCompanyTransferTransport(transfer *domain.CompanyTransfer) CompanyTransfer {
+	transport := CompanyTransfer{
+		ID:         transfer.ID,
+		CompanyID:  transfer.CompanyID,
+		FromUserID: transfer.FromUserID,
+		ToUserID:   transfer.ToUserID,
+		Status:     transfer.Status,
+		CreatedAt:  transfer.CreatedAt,
+		ExpiresAt:  transfer.ExpiresAt,
+	}
+
+	if !transfer.ResolvedAt.IsZero() {
+		transport.ResolvedAt = &transfer.ResolvedAt
 	}
+
+	return transport
 }
 
 func toFinReportTransport(finReport *domain.FinancialReport) FinancialReport {
-	return FinancialReport{
+	report := FinancialReport{
 		ID:        finReport.ID,
 		CompanyID: finReport.CompanyID,
 		Revenue:   finReport.Revenue,
@@ -144,6 +361,41 @@ func toFinReportTransport(finReport *domain.FinancialReport) FinancialReport {
 		Year:      finReport.Year,
 		Quarter:   finReport.Quarter,
 	}
+
+	if !finReport.VerifiedAt.IsZero() {
+		report.VerifiedBy = &finReport.VerifiedBy
+		report.VerifiedAt = &finReport.VerifiedAt
+	}
+
+	return report
+}
+
+func toRoleTransport(role *domain.Role) Role {
+	return Role{
+		Name:        role.Name,
+		Description: role.Description,
+		Permissions: role.Permissions,
+	}
+}
+
+func toApiKeyTransport(key *domain.ApiKey) ApiKey {
+	apiKey := ApiKey{
+		ID:        key.ID,
+		Name:      key.Name,
+		Prefix:    key.Prefix,
+		Scopes:    key.Scopes,
+		CreatedAt: key.CreatedAt,
+	}
+
+	if !key.ExpiresAt.IsZero() {
+		apiKey.ExpiresAt = &key.ExpiresAt
+	}
+
+	if !key.LastUsedAt.IsZero() {
+		apiKey.LastUsedAt = &key.LastUsedAt
+	}
+
+	return apiKey
 }
 
 func toFinReportModel(finReport *FinancialReport) domain.FinancialReport {
@@ -165,3 +417,136 @@ func toPeriodTransport(per *domain.Period) Period {
 		EndQuarter:   per.EndQuarter,
 	}
 }
+
+func toRatingBreakdownTransport(breakdown *domain.RatingBreakdown) RatingBreakdown {
+	transport := RatingBreakdown{
+		Strategy:                  breakdown.Strategy,
+		Period:                    toPeriodTransport(breakdown.Period),
+		Share:                     breakdown.Share,
+		Cost:                      breakdown.Cost,
+		MaxCost:                   breakdown.MaxCost,
+		Revenue:                   breakdown.Revenue,
+		Profit:                    breakdown.Profit,
+		CostContribution:          breakdown.CostContribution,
+		ProfitabilityContribution: breakdown.ProfitabilityContribution,
+		Rating:                    breakdown.Rating,
+	}
+
+	if breakdown.Company != nil {
+		company := toCompanyTransport(breakdown.Company)
+		transport.Company = &company
+	}
+
+	return transport
+}
+
+func toRatingSnapshotTransport(snapshot *domain.RatingSnapshot) RatingSnapshot {
+	transport := RatingSnapshot{
+		Year:                      snapshot.Year,
+		Quarter:                   snapshot.Quarter,
+		Strategy:                  snapshot.Strategy,
+		Share:                     snapshot.Share,
+		Cost:                      snapshot.Cost,
+		MaxCost:                   snapshot.MaxCost,
+		Revenue:                   snapshot.Revenue,
+		Profit:                    snapshot.Profit,
+		CostContribution:          snapshot.CostContribution,
+		ProfitabilityContribution: snapshot.ProfitabilityContribution,
+		Rating:                    snapshot.Rating,
+		CreatedAt:                 snapshot.CreatedAt,
+	}
+
+	if snapshot.CompanyID != uuid.Nil {
+		transport.CompanyID = &snapshot.CompanyID
+	}
+
+	return transport
+}
+
+func toSkillTransport(skill *domain.Skill) Skill {
+	return Skill{
+		ID:          skill.ID,
+		Name:        skill.Name,
+		Description: skill.Description,
+	}
+}
+
+func toSkillModel(skill *Skill) domain.Skill {
+	return domain.Skill{
+		ID:          skill.ID,
+		Name:        skill.Name,
+		Description: skill.Description,
+	}
+}
+
+func toReviewTransport(review *domain.Review) Review {
+	return Review{
+		ID:          review.ID,
+		TargetID:    review.TargetID,
+		ReviewerID:  review.ReviewerID,
+		Pros:        review.Pros,
+		Cons:        review.Cons,
+		Description: review.Description,
+		Rating:      review.Rating,
+	}
+}
+
+func toReviewModel(review *Review) domain.Review {
+	return domain.Review{
+		ID:          review.ID,
+		TargetID:    review.TargetID,
+		ReviewerID:  review.ReviewerID,
+		Pros:        review.Pros,
+		Cons:        review.Cons,
+		Description: review.Description,
+		Rating:      review.Rating,
+	}
+}
+
+func toReviewStatsTransport(stats *domain.ReviewStats) ReviewStats {
+	return ReviewStats{
+		Count:         stats.Count,
+		AverageRating: stats.AverageRating,
+	}
+}
+
+func toUserRatingTransport(rating *domain.UserRating) UserRating {
+	return UserRating{
+		User:   toUserTransport(rating.User),
+		Rating: rating.Rating,
+	}
+}
+
+func toTaxScheduleTransport(schedule *domain.TaxSchedule) TaxSchedule {
+	brackets := make([]TaxBracket, len(schedule.Brackets))
+	for i, bracket := range schedule.Brackets {
+		brackets[i] = TaxBracket{
+			UpperBound: bracket.UpperBound,
+			Rate:       bracket.Rate,
+		}
+	}
+
+	return TaxSchedule{
+		ID:       schedule.ID,
+		Year:     schedule.Year,
+		Regime:   schedule.Regime,
+		Brackets: brackets,
+	}
+}
+
+func toTaxScheduleModel(schedule *TaxSchedule) domain.TaxSchedule {
+	var brackets []domain.TaxBracket
+	for _, bracket := range schedule.Brackets {
+		brackets = append(brackets, domain.TaxBracket{
+			UpperBound: bracket.UpperBound,
+			Rate:       bracket.Rate,
+		})
+	}
+
+	return domain.TaxSchedule{
+		ID:       schedule.ID,
+		Year:     schedule.Year,
+		Regime:   schedule.Regime,
+		Brackets: brackets,
+	}
+}
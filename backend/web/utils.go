@@ -3,13 +3,19 @@ package web
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/jwtauth/v5"
 	"github.com/google/uuid"
+	"net"
 	"net/http"
 	"ppo/domain"
+	"ppo/internal/app"
+	"ppo/pkg/base"
 	"strconv"
+	"strings"
+	"time"
 )
 
 const (
@@ -19,6 +25,15 @@ const (
 
 const eps = 1e-6
 
+const (
+	accessTokenCookie  = "access_token"
+	refreshTokenCookie = "refresh_token"
+	csrfTokenCookie    = "csrf_token"
+	csrfTokenHeader    = "X-CSRF-Token"
+	// refresh-токен нужен только для обновления, в остальные запросы браузер его не отправляет
+	refreshTokenCookiePath = "/api/v1/refresh"
+)
+
 type statusResponseWriter struct {
 	http.ResponseWriter
 	statusCode int
@@ -55,6 +70,63 @@ func successResponse(w http.ResponseWriter, statusCode int, data interface{}) {
 	json.NewEncoder(w).Encode(SuccessResponse{Status: successMsg, Data: data})
 }
 
+// clientIP - адрес клиента без порта. Заголовки прокси не учитываются: их может подделать сам клиент.
+func clientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+
+	return host
+}
+
+// sameSiteMode переводит значение из конфига в режим SameSite; по умолчанию используется Lax.
+func sameSiteMode(mode string) http.SameSite {
+	switch strings.ToLower(mode) {
+	case "strict":
+		return http.SameSiteStrictMode
+	case "none":
+		return http.SameSiteNoneMode
+	default:
+		return http.SameSiteLaxMode
+	}
+}
+
+func newSessionCookie(app *app.App, name, value, path string, ttl time.Duration, httpOnly bool) *http.Cookie {
+	return &http.Cookie{
+		Name:     name,
+		Value:    value,
+		Path:     path,
+		MaxAge:   int(ttl.Seconds()),
+		Secure:   app.Config.Server.Cookie.Secure,
+		HttpOnly: httpOnly,
+		SameSite: sameSiteMode(app.Config.Server.Cookie.SameSite),
+	}
+}
+
+// setSessionCookies сохраняет токены в HttpOnly cookie и выдает новый CSRF-токен. CSRF-токен
+// доступен скриптам: фронтенд возвращает его в заголовке X-CSRF-Token (double-submit).
+func setSessionCookies(w http.ResponseWriter, app *app.App, tokens *domain.TokenPair) (err error) {
+	csrfToken, _, err := base.GenerateToken()
+	if err != nil {
+		return fmt.Errorf("генерация CSRF-токена: %w", err)
+	}
+
+	refreshTTL := app.Config.Server.RefreshTokenTTL
+	http.SetCookie(w, newSessionCookie(app, accessTokenCookie, tokens.AccessToken, "/", app.Config.Server.AccessTokenTTL, true))
+	http.SetCookie(w, newSessionCookie(app, refreshTokenCookie, tokens.RefreshToken, refreshTokenCookiePath, refreshTTL, true))
+	http.SetCookie(w, newSessionCookie(app, csrfTokenCookie, csrfToken, "/", refreshTTL, false))
+
+	return nil
+}
+
+func clearSessionCookies(w http.ResponseWriter, app *app.App) {
+	// отрицательный MaxAge удаляет cookie
+	http.SetCookie(w, newSessionCookie(app, accessTokenCookie, "", "/", -time.Second, true))
+	http.SetCookie(w, newSessionCookie(app, refreshTokenCookie, "", refreshTokenCookiePath, -time.Second, true))
+	http.SetCookie(w, newSessionCookie(app, csrfTokenCookie, "", "/", -time.Second, false))
+}
+
 func getStringClaimFromJWT(ctx context.Context, claim string) (strVal string, err error) {
 	_, claims, err := jwtauth.FromContext(ctx)
 	if err != nil {
@@ -102,7 +174,7 @@ func parsePeriodFromURL(r *http.Request) (period *domain.Period, err error) {
 
 	quarterStart, err := strconv.Atoi(quarterStartStr)
 	if err != nil {
-		return nil, fmt.Errorf("converting start quarter to int: %w, err")
+		return nil, fmt.Errorf("converting start quarter to int: %w", err)
 	}
 
 	quarterEndStr := r.URL.Query().Get("end-quarter")
@@ -122,9 +194,89 @@ func parsePeriodFromURL(r *http.Request) (period *domain.Period, err error) {
 		EndQuarter:   quarterEnd,
 	}
 
+	err = period.Validate()
+	if err != nil {
+		return nil, err
+	}
+
 	return period, nil
 }
 
+// parseRatingPeriod читает период из параметров start-year, start-quarter, end-year и end-quarter,
+// а если они не указаны - выбирает его способом из параметра period (domain.PeriodPrevYear по умолчанию).
+func parseRatingPeriod(app *app.App, r *http.Request) (period *domain.Period, err error) {
+	if r.URL.Query().Get("start-year") != "" {
+		return parsePeriodFromURL(r)
+	}
+
+	return app.Interactor.ResolvePeriod(r.URL.Query().Get("period"))
+}
+
+// ratingErrorStatus - код ответа на ошибку вычисления рейтинга: неизвестная стратегия из параметра strategy
+// - ошибка запроса, неизвестный предприниматель - не найден, остальные - ошибки сервера.
+func ratingErrorStatus(err error) int {
+	if errors.Is(err, domain.ErrUnknownRatingStrategy) {
+		return http.StatusBadRequest
+	}
+
+	if errors.Is(err, domain.ErrUserNotFound) {
+		return http.StatusNotFound
+	}
+
+	return http.StatusInternalServerError
+}
+
+// reviewErrorStatus - код ответа на ошибку изменения отзыва: отзыв о другом предпринимателе не найден
+// по адресу из запроса, остальные ошибки - ошибки запроса.
+func reviewErrorStatus(err error) int {
+	if errors.Is(err, domain.ErrReviewNotFound) {
+		return http.StatusNotFound
+	}
+
+	return http.StatusBadRequest
+}
+
+func parseUserFilterFromURL(r *http.Request) (filter *domain.UserFilter, err error) {
+	query := r.URL.Query()
+
+	filter = &domain.UserFilter{
+		City:   query.Get("city"),
+		Gender: query.Get("gender"),
+		Name:   query.Get("name"),
+		SortBy: query.Get("sort"),
+	}
+
+	if minAge := query.Get("min-age"); minAge != "" {
+		filter.MinAge, err = strconv.Atoi(minAge)
+		if err != nil {
+			return nil, fmt.Errorf("converting min age to int: %w", err)
+		}
+	}
+
+	if maxAge := query.Get("max-age"); maxAge != "" {
+		filter.MaxAge, err = strconv.Atoi(maxAge)
+		if err != nil {
+			return nil, fmt.Errorf("converting max age to int: %w", err)
+		}
+	}
+
+	if fieldId := query.Get("activity-field-id"); fieldId != "" {
+		filter.ActivityFieldId, err = uuid.Parse(fieldId)
+		if err != nil {
+			return nil, fmt.Errorf("converting activity field id to uuid: %w", err)
+		}
+	}
+
+	if skillId := query.Get("skill-id"); skillId != "" {
+		filter.SkillId, err = uuid.Parse(skillId)
+		if err != nil {
+			return nil, fmt.Errorf("converting skill id to uuid: %w", err)
+		}
+	}
+
+	return filter, nil
+}
+
 func parseUUIDFromURL(r *http.Request, key, entityName string) (val uuid.UUID, err error) {
 	compIdStr := chi.URLParam(r, key)
 	if compIdStr == "" {
@@ -0,0 +1,39 @@
+package web
+
+import (
+	"errors"
+	"fmt"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"ppo/domain"
+	"testing"
+)
+
+func TestRatingErrorStatus(t *testing.T) {
+	testCases := []struct {
+		name     string
+		err      error
+		expected int
+	}{
+		{
+			name:     "неизвестная стратегия",
+			err:      fmt.Errorf("%w: magic", domain.ErrUnknownRatingStrategy),
+			expected: http.StatusBadRequest,
+		},
+		{
+			name:     "неизвестный предприниматель",
+			err:      fmt.Errorf("получение влияния в сферах деятельности: %w", domain.ErrUserNotFound),
+			expected: http.StatusNotFound,
+		},
+		{
+			name:     "ошибка базы данных",
+			err:      errors.New("получение влияния в сферах деятельности: sql error"),
+			expected: http.StatusInternalServerError,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, ratingErrorStatus(tc.err))
+		})
+	}
+}